@@ -1,10 +1,12 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"sync"
+	"sync/atomic"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -35,10 +37,113 @@ type Config struct {
 	// UserAgent for API Client
 	UserAgent string
 
+	// Email domains users are allowed to be created with, any domain is
+	// allowed when empty
+	AllowedEmailDomains []string
+
+	// Whether the service data source only matches services named exactly
+	// as its name by default
+	ServiceDataSourceExactMatch bool
+
+	// Teams of a team-scoped token, looked up on demand
+	scope *tokenScope
+
 	client      *pagerduty.Client
 	slackClient *pagerduty.Client
 }
 
+// tokenScope holds the teams a team-scoped token is limited to. They are only
+// looked up once an account-level listing is forbidden, and failed lookups are
+// made again by the next caller instead of being cached.
+type tokenScope struct {
+	mu      sync.Mutex
+	done    bool
+	teamIDs []string
+}
+
+func (c *Config) tokenScope() *tokenScope {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.scope == nil {
+		c.scope = &tokenScope{}
+	}
+	return c.scope
+}
+
+// tokenTeamIDs returns the IDs of the teams the token can access, used to
+// narrow account-level listings down to team-scoped ones.
+func (c *Config) tokenTeamIDs() ([]string, error) {
+	client, err := c.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	s := c.tokenScope()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.done {
+		return s.teamIDs, nil
+	}
+
+	var teamIDs []string
+	o := &pagerduty.ListTeamsOptions{Limit: 100}
+	for {
+		resp, _, err := client.Teams.List(o)
+		if err != nil {
+			return nil, fmt.Errorf("the PagerDuty token appears to be team-scoped, but its teams can't be listed: %s", err)
+		}
+		for _, t := range resp.Teams {
+			teamIDs = append(teamIDs, t.ID)
+		}
+		if !resp.More {
+			break
+		}
+		o.Offset += o.Limit
+	}
+
+	if len(teamIDs) == 0 {
+		return nil, fmt.Errorf("the PagerDuty token appears to be team-scoped, but it can't access any team")
+	}
+	log.Printf("[INFO] PagerDuty token is team-scoped, narrowing listings to teams %v", teamIDs)
+
+	s.teamIDs = teamIDs
+	s.done = true
+
+	return s.teamIDs, nil
+}
+
+type apiCallCounterKey struct{}
+
+// withAPICallCounter returns a context whose requests made through the
+// provider's clients are counted into calls.
+func withAPICallCounter(ctx context.Context, calls *int64) context.Context {
+	return context.WithValue(ctx, apiCallCounterKey{}, calls)
+}
+
+// countingTransport counts the requests made with a context carrying a
+// counter, so concurrent operations sharing a client are counted apart.
+type countingTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if calls, ok := req.Context().Value(apiCallCounterKey{}).(*int64); ok {
+		atomic.AddInt64(calls, 1)
+	}
+	return t.transport.RoundTrip(req)
+}
+
+func (c *Config) httpClient() *http.Client {
+	var httpClient *http.Client
+	httpClient = http.DefaultClient
+	httpClient.Transport = &countingTransport{
+		transport: logging.NewTransport("PagerDuty", http.DefaultTransport),
+	}
+	return httpClient
+}
+
 const invalidCreds = `
 
 No valid credentials found for PagerDuty provider.
@@ -61,9 +166,7 @@ func (c *Config) Client() (*pagerduty.Client, error) {
 		return nil, fmt.Errorf(invalidCreds)
 	}
 
-	var httpClient *http.Client
-	httpClient = http.DefaultClient
-	httpClient.Transport = logging.NewTransport("PagerDuty", http.DefaultTransport)
+	httpClient := c.httpClient()
 
 	var apiUrl = c.ApiUrl
 	if c.ApiUrlOverride != "" {
@@ -112,9 +215,7 @@ func (c *Config) SlackClient() (*pagerduty.Client, error) {
 		return nil, fmt.Errorf(invalidCreds)
 	}
 
-	var httpClient *http.Client
-	httpClient = http.DefaultClient
-	httpClient.Transport = logging.NewTransport("PagerDuty", http.DefaultTransport)
+	httpClient := c.httpClient()
 
 	config := &pagerduty.Config{
 		BaseURL:    c.AppUrl,
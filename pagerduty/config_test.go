@@ -1,7 +1,11 @@
 package pagerduty
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 // Test config with an empty token
@@ -65,3 +69,56 @@ func TestConfigCustomAppUrl(t *testing.T) {
 		t.Fatalf("error: expected the client to not fail: %v", err)
 	}
 }
+
+// Test a failed lookup of the token's teams isn't cached
+func TestConfigTokenTeamIDsRetriesFailedLookup(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error":{"code":2000,"message":"Internal Server Error"}}`))
+			return
+		}
+		w.Write([]byte(`{"teams":[{"id":"PTEAM01","name":"foo"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: server.URL, SkipCredsValidation: true}
+
+	if _, err := config.tokenTeamIDs(); err == nil {
+		t.Fatal("expected the first lookup to fail")
+	}
+
+	for i := 0; i < 2; i++ {
+		ids, err := config.tokenTeamIDs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ids) != 1 || ids[0] != "PTEAM01" {
+			t.Errorf("expected teams [PTEAM01], got %v", ids)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("expected the teams to be listed twice, got %d", calls)
+	}
+}
+
+// Test only the Access Denied error is taken for a team-scoped token
+func TestIsTeamScopedTokenError(t *testing.T) {
+	forbidden := func(code int) error {
+		return &pagerduty.Error{
+			ErrorResponse: &pagerduty.Response{Response: &http.Response{StatusCode: http.StatusForbidden}},
+			Code:          code,
+		}
+	}
+
+	if !isTeamScopedTokenError(forbidden(2010)) {
+		t.Error("expected a 403 with code 2010 to be a team-scoped token error")
+	}
+	if isTeamScopedTokenError(forbidden(2100)) {
+		t.Error("expected a 403 with another code not to be a team-scoped token error")
+	}
+}
@@ -0,0 +1,92 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePagerDutyAbilities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyAbilitiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"required": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Abilities the account must have, failing the read otherwise",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"abilities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyAbilitiesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty abilities")
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.Abilities.List()
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		abilities := append([]string{}, resp.Abilities...)
+		sort.Strings(abilities)
+
+		if missing := missingAbilities(abilities, expandStringList(d.Get("required").([]interface{}))); len(missing) > 0 {
+			return resource.NonRetryableError(
+				fmt.Errorf("The PagerDuty account doesn't have the required abilities: %s. They may not be available on its plan", strings.Join(missing, ", ")),
+			)
+		}
+
+		// Since this data doesn't have an unique ID, this force this data to be
+		// refreshed in every Terraform apply
+		d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+		d.Set("abilities", abilities)
+
+		return nil
+	})
+}
+
+func missingAbilities(abilities, required []string) []string {
+	has := make(map[string]bool)
+	for _, a := range abilities {
+		has[a] = true
+	}
+
+	var missing []string
+	for _, r := range required {
+		if !has[r] {
+			missing = append(missing, r)
+		}
+	}
+
+	return missing
+}
@@ -0,0 +1,37 @@
+package pagerduty
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyAbilities_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyAbilitiesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_abilities.all", "abilities.0"),
+				),
+			},
+			{
+				Config:      testAccDataSourcePagerDutyAbilitiesConfigRequired,
+				ExpectError: regexp.MustCompile("doesn't have the required abilities: tf_unknown_ability"),
+			},
+		},
+	})
+}
+
+const testAccDataSourcePagerDutyAbilitiesConfig = `
+data "pagerduty_abilities" "all" {}
+`
+
+const testAccDataSourcePagerDutyAbilitiesConfigRequired = `
+data "pagerduty_abilities" "all" {
+  required = ["tf_unknown_ability"]
+}
+`
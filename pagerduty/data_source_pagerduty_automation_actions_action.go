@@ -1,11 +1,13 @@
 package pagerduty
 
 import (
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 func dataSourcePagerDutyAutomationActionsAction() *schema.Resource {
@@ -14,12 +16,16 @@ func dataSourcePagerDutyAutomationActionsAction() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -88,6 +94,22 @@ func dataSourcePagerDutyAutomationActionsAction() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			"only_invocable_on_unresolved_incidents": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"allow_invocation_manually": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"allow_invocation_from_event_orchestration": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"map_to_all_services": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -101,7 +123,12 @@ func dataSourcePagerDutyAutomationActionsActionRead(d *schema.ResourceData, meta
 	log.Printf("[INFO] Reading PagerDuty AutomationActionsAction")
 
 	return resource.Retry(1*time.Minute, func() *resource.RetryError {
-		automationActionsAction, _, err := client.AutomationActionsAction.Get(d.Get("id").(string))
+		var automationActionsAction *pagerduty.AutomationActionsAction
+		if id, ok := d.GetOk("id"); ok {
+			automationActionsAction, _, err = client.AutomationActionsAction.Get(id.(string))
+		} else {
+			automationActionsAction, err = findPagerDutyAutomationActionsActionByName(client, d.Get("name").(string))
+		}
 		if err != nil {
 			// Delaying retry by 30s as recommended by PagerDuty
 			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
@@ -109,6 +136,12 @@ func dataSourcePagerDutyAutomationActionsActionRead(d *schema.ResourceData, meta
 			return resource.RetryableError(err)
 		}
 
+		if automationActionsAction == nil {
+			return resource.NonRetryableError(
+				fmt.Errorf("unable to locate any automation actions action with name: %s", d.Get("name").(string)),
+			)
+		}
+
 		d.SetId(automationActionsAction.ID)
 		d.Set("name", automationActionsAction.Name)
 		d.Set("type", automationActionsAction.Type)
@@ -140,6 +173,25 @@ func dataSourcePagerDutyAutomationActionsActionRead(d *schema.ResourceData, meta
 			d.Set("action_classification", &automationActionsAction.ActionClassification)
 		}
 
+		flattenAutomationActionsActionInvocationSettings(d, automationActionsAction)
+
 		return nil
 	})
 }
+
+// The name filter of the API matches the actions whose name contains it, so
+// the action with the exact name is picked from the results.
+func findPagerDutyAutomationActionsActionByName(client *pagerduty.Client, name string) (*pagerduty.AutomationActionsAction, error) {
+	actions, err := client.AutomationActionsAction.ListAll(&pagerduty.ListAutomationActionsActionsOptions{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, action := range actions {
+		if action.Name == name {
+			return action, nil
+		}
+	}
+
+	return nil, nil
+}
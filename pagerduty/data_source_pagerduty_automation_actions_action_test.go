@@ -2,6 +2,7 @@ package pagerduty
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -20,8 +21,13 @@ func TestAccDataSourcePagerDutyAutomationActionsAction_Basic(t *testing.T) {
 				Config: testAccDataSourcePagerDutyAutomationActionsActionConfig(name),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourcePagerdutyAutomationActionsAction("pagerduty_automation_actions_action.test", "data.pagerduty_automation_actions_action.foo"),
+					testAccDataSourcePagerdutyAutomationActionsAction("pagerduty_automation_actions_action.test", "data.pagerduty_automation_actions_action.by_name"),
 				),
 			},
+			{
+				Config:      testAccDataSourcePagerDutyAutomationActionsActionNotFoundConfig(name),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("unable to locate any automation actions action with name: %s-missing", name)),
+			},
 		},
 	})
 }
@@ -79,5 +85,17 @@ resource "pagerduty_automation_actions_action" "test" {
 data "pagerduty_automation_actions_action" "foo" {
   id = pagerduty_automation_actions_action.test.id
 }
+
+data "pagerduty_automation_actions_action" "by_name" {
+  name = pagerduty_automation_actions_action.test.name
+}
 `, actionName, actionName)
 }
+
+func testAccDataSourcePagerDutyAutomationActionsActionNotFoundConfig(actionName string) string {
+	return fmt.Sprintf(`
+data "pagerduty_automation_actions_action" "foo" {
+  name = "%s-missing"
+}
+`, actionName)
+}
@@ -38,6 +38,13 @@ func dataSourcePagerDutyAutomationActionsRunner() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -48,6 +55,13 @@ func dataSourcePagerDutyAutomationActionsRunner() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			"teams": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -87,6 +101,14 @@ func dataSourcePagerDutyAutomationActionsRunnerRead(d *schema.ResourceData, meta
 			d.Set("last_seen", &runner.LastSeenTime)
 		}
 
+		if err := d.Set("permissions", flattenAutomationActionsPrivileges(runner.Privileges)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if err := d.Set("teams", flattenTeams(runner.Teams)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
 		return nil
 	})
 }
@@ -20,6 +20,7 @@ func TestAccDataSourcePagerDutyAutomationActionsRunner_Basic(t *testing.T) {
 				Config: testAccDataSourcePagerDutyAutomationActionsRunnerConfig(name),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourcePagerdutyAutomationActionsRunner("pagerduty_automation_actions_runner.test", "data.pagerduty_automation_actions_runner.foo"),
+					resource.TestCheckTypeSetElemAttrPair("data.pagerduty_automation_actions_runner.foo", "teams.*", "pagerduty_team.test", "id"),
 				),
 			},
 		},
@@ -42,7 +43,7 @@ func testAccDataSourcePagerdutyAutomationActionsRunner(src, n string) resource.T
 			return fmt.Errorf("No Runner ID is set")
 		}
 
-		testAtts := []string{"id", "name", "type", "runner_type", "creation_time", "last_seen", "description", "runbook_base_uri"}
+		testAtts := []string{"id", "name", "type", "runner_type", "creation_time", "last_seen", "description", "runbook_base_uri", "teams.#"}
 
 		for _, att := range testAtts {
 			if dsA[att] != srcA[att] {
@@ -56,12 +57,17 @@ func testAccDataSourcePagerdutyAutomationActionsRunner(src, n string) resource.T
 
 func testAccDataSourcePagerDutyAutomationActionsRunnerConfig(name string) string {
 	return fmt.Sprintf(`
+resource "pagerduty_team" "test" {
+  name = "%[1]s"
+}
+
 resource "pagerduty_automation_actions_runner" "test" {
-  name = "%s"
+  name = "%[1]s"
   description = "Runner created by TF"
   runner_type = "runbook"
   runbook_base_uri = "cat-cat"
   runbook_api_key = "secret"
+  teams = [pagerduty_team.test.id]
 }
 
 data "pagerduty_automation_actions_runner" "foo" {
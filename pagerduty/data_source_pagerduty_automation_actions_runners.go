@@ -0,0 +1,132 @@
+package pagerduty
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyAutomationActionsRunners() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyAutomationActionsRunnersRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"runners": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of runners matching the name filter",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"runner_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_seen": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"teams": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyAutomationActionsRunnersRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty automation actions runners")
+
+	o := &pagerduty.ListAutomationActionsRunnersOptions{
+		Name: d.Get("name").(string),
+	}
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, err := client.AutomationActionsRunner.ListAll(o)
+		if err != nil {
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+		d.Set("runners", flattenAutomationActionsRunners(resp))
+
+		return nil
+	})
+}
+
+func flattenAutomationActionsRunners(runners []*pagerduty.AutomationActionsRunner) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, runner := range runners {
+		var teams []string
+		for _, team := range runner.Teams {
+			teams = append(teams, team.ID)
+		}
+
+		r := map[string]interface{}{
+			"id":            runner.ID,
+			"name":          runner.Name,
+			"type":          runner.Type,
+			"runner_type":   runner.RunnerType,
+			"creation_time": runner.CreationTime,
+			"teams":         teams,
+		}
+		if runner.Description != nil {
+			r["description"] = *runner.Description
+		}
+		if runner.LastSeenTime != nil {
+			r["last_seen"] = *runner.LastSeenTime
+		}
+		if runner.Status != nil {
+			r["status"] = *runner.Status
+		}
+
+		result = append(result, r)
+	}
+
+	return result
+}
@@ -0,0 +1,83 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAccDataSourcePagerDutyAutomationActionsRunners_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyAutomationActionsRunnersConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_automation_actions_runners.foo", "runners.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_automation_actions_runners.foo", "runners.0.id", "pagerduty_automation_actions_runner.test", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_automation_actions_runners.foo", "runners.0.name", name),
+					resource.TestCheckResourceAttr("data.pagerduty_automation_actions_runners.foo", "runners.0.runner_type", "runbook"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_automation_actions_runners.foo", "runners.0.creation_time"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenAutomationActionsRunners(t *testing.T) {
+	description := "foo"
+	lastSeen := "2023-01-01T00:00:00.000Z"
+	runners := []*pagerduty.AutomationActionsRunner{
+		{
+			ID:           "P1",
+			Name:         "sidecar",
+			Type:         "runner",
+			RunnerType:   "sidecar",
+			Description:  &description,
+			LastSeenTime: &lastSeen,
+			Teams:        []*pagerduty.TeamReference{{ID: "PT1"}},
+		},
+		{
+			ID:         "P2",
+			Name:       "runbook",
+			Type:       "runner",
+			RunnerType: "runbook",
+		},
+	}
+
+	result := flattenAutomationActionsRunners(runners)
+	if len(result) != 2 {
+		t.Fatalf("Expected 2 runners, got %d", len(result))
+	}
+	if result[0]["last_seen"] != lastSeen || result[0]["description"] != description {
+		t.Errorf("Unexpected flattened runner: %v", result[0])
+	}
+	if teams := result[0]["teams"].([]string); len(teams) != 1 || teams[0] != "PT1" {
+		t.Errorf("Unexpected teams of flattened runner: %v", teams)
+	}
+	if _, ok := result[1]["last_seen"]; ok {
+		t.Errorf("Expected a runner never seen to have no last_seen, got: %v", result[1])
+	}
+}
+
+func testAccDataSourcePagerDutyAutomationActionsRunnersConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_automation_actions_runner" "test" {
+  name = "%[1]s"
+  description = "Runner created by TF"
+  runner_type = "runbook"
+  runbook_base_uri = "cat-cat"
+  runbook_api_key = "secret"
+}
+
+data "pagerduty_automation_actions_runners" "foo" {
+  name = pagerduty_automation_actions_runner.test.name
+}
+`, name)
+}
@@ -0,0 +1,113 @@
+package pagerduty
+
+import (
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyBusinessServices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyBusinessServicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"business_services": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of business services matching the name filter",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"point_of_contact": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"team": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyBusinessServicesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty business services")
+
+	searchName := d.Get("name").(string)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.BusinessServices.List()
+		if err != nil {
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		// Since this data doesn't have an unique ID, this force this data to be
+		// refreshed in every Terraform apply
+		d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+		d.Set("business_services", flattenBusinessServices(resp.BusinessServices, searchName))
+
+		return nil
+	})
+}
+
+// flattenBusinessServices returns the business services whose name contains
+// the searched name, as the API doesn't filter them.
+func flattenBusinessServices(businessServices []*pagerduty.BusinessService, searchName string) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, bs := range businessServices {
+		if !strings.Contains(strings.ToLower(bs.Name), strings.ToLower(searchName)) {
+			continue
+		}
+
+		var team string
+		if bs.Team != nil {
+			team = bs.Team.ID
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":               bs.ID,
+			"name":             bs.Name,
+			"type":             bs.Type,
+			"description":      bs.Description,
+			"point_of_contact": bs.PointOfContact,
+			"team":             team,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,83 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestFlattenBusinessServices(t *testing.T) {
+	businessServices := []*pagerduty.BusinessService{
+		{ID: "P1", Name: "Payments API", PointOfContact: "payments@foo.test", Team: &pagerduty.BusinessServiceTeam{ID: "PT1"}},
+		{ID: "P2", Name: "Checkout"},
+		{ID: "P3", Name: "Legacy payments"},
+	}
+
+	result := flattenBusinessServices(businessServices, "payments")
+	if len(result) != 2 {
+		t.Fatalf("Expected 2 business services, got %d: %v", len(result), result)
+	}
+	if result[0]["id"] != "P1" || result[0]["team"] != "PT1" || result[0]["point_of_contact"] != "payments@foo.test" {
+		t.Errorf("Unexpected flattened business service: %v", result[0])
+	}
+	if result[1]["id"] != "P3" || result[1]["team"] != "" {
+		t.Errorf("Unexpected flattened business service: %v", result[1])
+	}
+
+	if result := flattenBusinessServices(businessServices, ""); len(result) != 3 {
+		t.Errorf("Expected all the business services without a name filter, got %d", len(result))
+	}
+}
+
+func TestAccDataSourcePagerDutyBusinessServices_Basic(t *testing.T) {
+	prefix := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyBusinessServicesConfig(prefix, team),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_business_services.by_name", "business_services.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pagerduty_business_services.by_name", "business_services.*", map[string]string{
+						"name":             fmt.Sprintf("%s-one", prefix),
+						"point_of_contact": "PagerDuty Admin",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("data.pagerduty_business_services.by_name", "business_services.*.team", "pagerduty_team.foo", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pagerduty_business_services.by_name", "business_services.*", map[string]string{
+						"name": fmt.Sprintf("%s-two", prefix),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyBusinessServicesConfig(prefix, team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+  name = "%[2]s"
+}
+
+resource "pagerduty_business_service" "one" {
+  name             = "%[1]s-one"
+  point_of_contact = "PagerDuty Admin"
+  team             = pagerduty_team.foo.id
+}
+
+resource "pagerduty_business_service" "two" {
+  name = "%[1]s-two"
+}
+
+data "pagerduty_business_services" "by_name" {
+  name = "%[1]s"
+
+  depends_on = [pagerduty_business_service.one, pagerduty_business_service.two]
+}
+`, prefix, team)
+}
@@ -19,6 +19,53 @@ func dataSourcePagerDutyEscalationPolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"num_loops": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"teams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"escalation_delay_in_minutes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"target": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -39,7 +86,16 @@ func dataSourcePagerDutyEscalationPolicyRead(d *schema.ResourceData, meta interf
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		resp, _, err := client.EscalationPolicies.List(o)
+		if retry, scopeErr := narrowToTokenTeams(meta.(*Config), err, &o.TeamIDs); scopeErr != nil {
+			return resource.NonRetryableError(teamScopedTokenError("escalation policy", "name", searchName, scopeErr))
+		} else if retry {
+			resp, _, err = client.EscalationPolicies.List(o)
+		}
 		if err != nil {
+			if isTeamScopedTokenError(err) {
+				return resource.NonRetryableError(teamScopedTokenError("escalation policy", "name", searchName, err))
+			}
+
 			// Delaying retry by 30s as recommended by PagerDuty
 			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
 			time.Sleep(30 * time.Second)
@@ -63,6 +119,16 @@ func dataSourcePagerDutyEscalationPolicyRead(d *schema.ResourceData, meta interf
 
 		d.SetId(found.ID)
 		d.Set("name", found.Name)
+		d.Set("description", found.Description)
+		d.Set("teams", flattenTeams(found.Teams))
+
+		if found.NumLoops != nil {
+			d.Set("num_loops", *found.NumLoops)
+		}
+
+		if err := d.Set("rule", flattenEscalationRules(found.EscalationRules)); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
 		return nil
 	})
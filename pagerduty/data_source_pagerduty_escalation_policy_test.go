@@ -2,6 +2,8 @@ package pagerduty
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -9,6 +11,34 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestDataSourcePagerDutyEscalationPolicy_TeamScopedToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/teams":
+			w.Write([]byte(`{"teams":[{"id":"PTEAM01","name":"foo"}]}`))
+		case r.URL.Query().Get("team_ids[]") == "PTEAM01":
+			w.Write([]byte(`{"escalation_policies":[{"id":"PEP0001","name":"foo"}]}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error":{"code":2010,"message":"Access Denied"}}`))
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: server.URL, SkipCredsValidation: true}
+
+	d := dataSourcePagerDutyEscalationPolicy().TestResourceData()
+	d.Set("name", "foo")
+
+	if err := dataSourcePagerDutyEscalationPolicyRead(d, config); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "PEP0001" {
+		t.Errorf("expected escalation policy PEP0001, got %q", d.Id())
+	}
+}
+
 func TestAccDataSourcePagerDutyEscalationPolicy_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -41,7 +71,7 @@ func testAccDataSourcePagerDutyEscalationPolicy(src, n string) resource.TestChec
 			return fmt.Errorf("Expected to get a escalation policy ID from PagerDuty")
 		}
 
-		testAtts := []string{"id", "name"}
+		testAtts := []string{"id", "name", "description", "num_loops", "rule.#", "rule.0.escalation_delay_in_minutes", "rule.0.target.0.id"}
 
 		for _, att := range testAtts {
 			if a[att] != srcA[att] {
@@ -0,0 +1,49 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyEventOrchestrationGlobalCacheVariable_Basic(t *testing.T) {
+	team := fmt.Sprintf("tf-name-%s", acctest.RandString(5))
+	orchestration := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
+	configuration := `
+		type        = "trigger_event_count"
+		ttl_seconds = 300
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationGlobalCacheVariableConfig(team, orchestration, configuration) + `
+data "pagerduty_event_orchestration_global_cache_variable" "by_name" {
+	event_orchestration = pagerduty_event_orchestration.orch.id
+	name                = pagerduty_event_orchestration_global_cache_variable.cv.name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.pagerduty_event_orchestration_global_cache_variable.by_name", "id", "pagerduty_event_orchestration_global_cache_variable.cv", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_event_orchestration_global_cache_variable.by_name", "condition.0.expression", "event.severity matches 'critical'"),
+					resource.TestCheckResourceAttr("data.pagerduty_event_orchestration_global_cache_variable.by_name", "configuration.0.type", "trigger_event_count"),
+					resource.TestCheckResourceAttr("data.pagerduty_event_orchestration_global_cache_variable.by_name", "configuration.0.ttl_seconds", "300"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationGlobalCacheVariableConfig(team, orchestration, configuration) + `
+data "pagerduty_event_orchestration_global_cache_variable" "by_name" {
+	event_orchestration = pagerduty_event_orchestration.orch.id
+	name                = "does_not_exist"
+}
+`,
+				ExpectError: regexp.MustCompile("Unable to locate any global cache variable with the name: does_not_exist"),
+			},
+		},
+	})
+}
@@ -0,0 +1,107 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyEventOrchestrationPathRaw() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyEventOrchestrationPathRawRead,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validateValueFunc([]string{
+					pagerduty.PathTypeGlobal,
+					pagerduty.PathTypeRouter,
+					pagerduty.PathTypeService,
+					pagerduty.PathTypeUnrouted,
+				}),
+			},
+			"parent": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyEventOrchestrationPathRawRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	pathType := d.Get("type").(string)
+	parent := d.Get("parent").(string)
+
+	log.Printf("[INFO] Reading PagerDuty Event Orchestration Path of type %s for %s", pathType, parent)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		raw, _, err := client.EventOrchestrationPaths.GetRaw(parent, pathType)
+		if err != nil {
+			if isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		path, err := eventOrchestrationPathRawDefinition(raw)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		d.SetId(parent)
+		d.Set("path", path)
+
+		return nil
+	})
+}
+
+// eventOrchestrationPathRawDefinition strips the fields set by PagerDuty from
+// a path document, including the IDs of its rules, so it can be applied to
+// another path, e.g. of another account.
+func eventOrchestrationPathRawDefinition(raw json.RawMessage) (string, error) {
+	var path map[string]interface{}
+	if err := json.Unmarshal(raw, &path); err != nil {
+		return "", fmt.Errorf("Error decoding Event Orchestration Path: %s", err)
+	}
+
+	for _, f := range eventOrchestrationPathRawServerFields {
+		delete(path, f)
+	}
+
+	sets, _ := path["sets"].([]interface{})
+	for _, set := range sets {
+		s, _ := set.(map[string]interface{})
+		rules, _ := s["rules"].([]interface{})
+		for _, rule := range rules {
+			if r, ok := rule.(map[string]interface{}); ok {
+				delete(r, "id")
+			}
+		}
+	}
+
+	b, err := json.Marshal(path)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,27 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEventOrchestrationPathRawDefinition(t *testing.T) {
+	raw := json.RawMessage(`{
+		"type": "global",
+		"self": "https://api.pagerduty.com/event_orchestrations/E1/global",
+		"parent": {"id": "E1", "type": "event_orchestration_reference"},
+		"version": "abc",
+		"sets": [{"id": "start", "rules": [{"id": "a1b2c3", "label": "drop", "actions": {"drop_event": true}}]}],
+		"catch_all": {"actions": {}}
+	}`)
+
+	path, err := eventOrchestrationPathRawDefinition(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"catch_all":{"actions":{}},"sets":[{"id":"start","rules":[{"actions":{"drop_event":true},"label":"drop"}]}]}`
+	if path != expected {
+		t.Errorf("expected %s, got %s", expected, path)
+	}
+}
@@ -0,0 +1,334 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyEventOrchestrationRulesetMigration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyEventOrchestrationRulesetMigrationRead,
+
+		Schema: map[string]*schema.Schema{
+			"ruleset": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"global_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"router_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"warnings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyEventOrchestrationRulesetMigrationRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	rulesetID := d.Get("ruleset").(string)
+
+	log.Printf("[INFO] Reading PagerDuty ruleset rules of %s to migrate them to an Event Orchestration", rulesetID)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.Rulesets.ListRules(rulesetID)
+		if err != nil {
+			if isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		global, router, warnings := convertRulesetRulesToEventOrchestration(resp.Rules)
+
+		globalPath, err := json.Marshal(global)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		routerPath, err := json.Marshal(router)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		d.SetId(rulesetID)
+		d.Set("global_path", string(globalPath))
+		d.Set("router_path", string(routerPath))
+		d.Set("warnings", warnings)
+
+		return nil
+	})
+}
+
+// convertRulesetRulesToEventOrchestration translates the rules of a ruleset
+// into the definition of a Global Orchestration and of a Router, in the format
+// of pagerduty_event_orchestration_path_raw. Every rule keeps its position in
+// the "start" set of the Global Orchestration, so the first matching rule is
+// still the only one applied, while route actions become Router rules. What
+// can't be translated is left out and described in the returned warnings, as
+// are Router rules that may now route events an earlier rule without a route
+// matched first.
+func convertRulesetRulesToEventOrchestration(rules []*pagerduty.RulesetRule) (global, router map[string]interface{}, warnings []string) {
+	globalRules := []interface{}{}
+	routerRules := []interface{}{}
+	globalCatchAll := map[string]interface{}{}
+	routerCatchAll := map[string]interface{}{"route_to": "unrouted"}
+	var unrouted []string
+
+	for _, r := range rules {
+		actions, routeTo, actionWarnings := convertRulesetRuleActions(r)
+		for _, w := range actionWarnings {
+			warnings = append(warnings, fmt.Sprintf("rule %s: %s", r.ID, w))
+		}
+
+		if r.CatchAll {
+			globalCatchAll = actions
+			if routeTo != "" {
+				routerCatchAll["route_to"] = routeTo
+			}
+			continue
+		}
+
+		conditions, disabled, conditionWarnings := convertRulesetRuleConditions(r)
+		for _, w := range conditionWarnings {
+			warnings = append(warnings, fmt.Sprintf("rule %s: %s", r.ID, w))
+		}
+
+		label := fmt.Sprintf("Migrated from ruleset rule %s", r.ID)
+		globalRules = append(globalRules, map[string]interface{}{
+			"label":      label,
+			"conditions": conditions,
+			"actions":    actions,
+			"disabled":   disabled,
+		})
+
+		if routeTo == "" {
+			if !disabled {
+				unrouted = append(unrouted, r.ID)
+			}
+			continue
+		}
+
+		// The Router doesn't stop at the rules without a route, so events
+		// they matched first in the Ruleset can now be routed by this rule
+		if len(unrouted) > 0 {
+			warnings = append(warnings, fmt.Sprintf("rule %s: events matched first by rules %s, which have no route, are now routed by this rule", r.ID, strings.Join(unrouted, ", ")))
+		}
+		routerRules = append(routerRules, map[string]interface{}{
+			"label":      label,
+			"conditions": conditions,
+			"actions":    map[string]interface{}{"route_to": routeTo},
+			"disabled":   disabled,
+		})
+	}
+
+	global = map[string]interface{}{
+		"sets":      []interface{}{map[string]interface{}{"id": "start", "rules": globalRules}},
+		"catch_all": map[string]interface{}{"actions": globalCatchAll},
+	}
+	router = map[string]interface{}{
+		"sets":      []interface{}{map[string]interface{}{"id": "start", "rules": routerRules}},
+		"catch_all": map[string]interface{}{"actions": routerCatchAll},
+	}
+
+	return global, router, warnings
+}
+
+// rulesetWeekdays maps the ISO weekdays of a ruleset rule time frame to the
+// day names of PCL time windows.
+var rulesetWeekdays = map[int]string{1: "Mon", 2: "Tue", 3: "Wed", 4: "Thu", 5: "Fri", 6: "Sat", 7: "Sun"}
+
+func convertRulesetRuleConditions(r *pagerduty.RulesetRule) (conditions []*pagerduty.EventOrchestrationPathRuleCondition, disabled bool, warnings []string) {
+	disabled = r.Disabled
+
+	if r.Conditions != nil {
+		var expressions []string
+		for _, sc := range r.Conditions.RuleSubconditions {
+			expression, err := convertRulesetSubcondition(sc)
+			if err != nil {
+				warnings = append(warnings, err.Error())
+				disabled = true
+				continue
+			}
+			expressions = append(expressions, expression)
+		}
+
+		if r.Conditions.Operator == "or" {
+			for _, e := range expressions {
+				conditions = append(conditions, &pagerduty.EventOrchestrationPathRuleCondition{Expression: e})
+			}
+		} else if len(expressions) > 0 {
+			conditions = append(conditions, &pagerduty.EventOrchestrationPathRuleCondition{Expression: strings.Join(expressions, " and ")})
+		}
+	}
+
+	if r.TimeFrame != nil && r.TimeFrame.ScheduledWeekly != nil {
+		sw := r.TimeFrame.ScheduledWeekly
+		var days []interface{}
+		for _, wd := range sw.Weekdays {
+			days = append(days, rulesetWeekdays[wd])
+		}
+		conditions = expandEventOrchestrationPathActiveBetween(conditions, []interface{}{
+			map[string]interface{}{
+				"days":       days,
+				"start_time": formatRulesetTimeOfDay(sw.StartTime),
+				"end_time":   formatRulesetTimeOfDay(sw.StartTime + sw.Duration),
+				"time_zone":  sw.Timezone,
+			},
+		})
+	}
+
+	if r.TimeFrame != nil && r.TimeFrame.ActiveBetween != nil {
+		warnings = append(warnings, "active_between time frames have no equivalent in Event Orchestrations, the rule is disabled")
+		disabled = true
+	}
+
+	if conditions == nil {
+		conditions = []*pagerduty.EventOrchestrationPathRuleCondition{}
+	}
+
+	return conditions, disabled, warnings
+}
+
+// formatRulesetTimeOfDay formats a number of milliseconds since midnight, as
+// used by ruleset rule time frames, wrapping around the day.
+func formatRulesetTimeOfDay(ms int) string {
+	seconds := ms / 1000 % (24 * 3600)
+	return fmt.Sprintf("%02d:%02d:%02d", seconds/3600, seconds%3600/60, seconds%60)
+}
+
+func convertRulesetSubcondition(sc *pagerduty.RuleSubcondition) (string, error) {
+	if sc.Parameters == nil {
+		return "", fmt.Errorf("subcondition %q has no parameters, the rule is disabled", sc.Operator)
+	}
+
+	path := convertRulesetPath(sc.Parameters.Path)
+	value := quotePCLString(sc.Parameters.Value)
+
+	switch sc.Operator {
+	case "exists":
+		return fmt.Sprintf("%s exists", path), nil
+	case "nexists":
+		return fmt.Sprintf("not %s exists", path), nil
+	case "equals":
+		return fmt.Sprintf("%s matches %s", path, value), nil
+	case "nequals":
+		return fmt.Sprintf("not %s matches %s", path, value), nil
+	case "contains":
+		return fmt.Sprintf("%s matches part %s", path, value), nil
+	case "ncontains":
+		return fmt.Sprintf("not %s matches part %s", path, value), nil
+	case "matches":
+		return fmt.Sprintf("%s matches regex %s", path, value), nil
+	case "nmatches":
+		return fmt.Sprintf("not %s matches regex %s", path, value), nil
+	}
+
+	return "", fmt.Errorf("subcondition operator %q has no equivalent in PCL, the rule is disabled", sc.Operator)
+}
+
+// convertRulesetPath translates a path of the event sent to a ruleset, e.g.
+// payload.summary, into a PCL path, e.g. event.summary.
+func convertRulesetPath(path string) string {
+	if strings.HasPrefix(path, "event.") || strings.HasPrefix(path, "variables.") {
+		return path
+	}
+	return "event." + strings.TrimPrefix(path, "payload.")
+}
+
+func quotePCLString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+func convertRulesetRuleActions(r *pagerduty.RulesetRule) (actions map[string]interface{}, routeTo string, warnings []string) {
+	actions = map[string]interface{}{}
+
+	if len(r.Variables) > 0 {
+		var variables []interface{}
+		for _, v := range r.Variables {
+			if v.Parameters == nil {
+				continue
+			}
+			variables = append(variables, map[string]interface{}{
+				"name":  v.Name,
+				"path":  convertRulesetPath(v.Parameters.Path),
+				"type":  v.Type,
+				"value": v.Parameters.Value,
+			})
+		}
+		actions["variables"] = variables
+	}
+
+	a := r.Actions
+	if a == nil {
+		return actions, "", warnings
+	}
+
+	if a.Route != nil {
+		routeTo = a.Route.Value
+	}
+	if a.Suppress != nil && a.Suppress.Value {
+		if a.Suppress.ThresholdValue > 0 {
+			warnings = append(warnings, "suppress thresholds have no equivalent in Event Orchestrations, the suppress action is left out")
+		} else {
+			actions["suppress"] = true
+		}
+	}
+	if a.Suspend != nil && a.Suspend.Value > 0 {
+		actions["suspend"] = a.Suspend.Value
+	}
+	if a.Priority != nil && a.Priority.Value != "" {
+		actions["priority"] = a.Priority.Value
+	}
+	if a.Annotate != nil && a.Annotate.Value != "" {
+		actions["annotate"] = a.Annotate.Value
+	}
+	if a.Severity != nil && a.Severity.Value != "" {
+		actions["severity"] = a.Severity.Value
+	}
+	if a.EventAction != nil && a.EventAction.Value != "" {
+		actions["event_action"] = a.EventAction.Value
+	}
+
+	if len(a.Extractions) > 0 {
+		var extractions []interface{}
+		for _, e := range a.Extractions {
+			extraction := map[string]interface{}{
+				"target": convertRulesetPath(e.Target),
+			}
+			if e.Template != "" {
+				extraction["template"] = e.Template
+			} else {
+				extraction["regex"] = e.Regex
+				extraction["source"] = convertRulesetPath(e.Source)
+			}
+			extractions = append(extractions, extraction)
+		}
+		actions["extractions"] = extractions
+	}
+
+	return actions, routeTo, warnings
+}
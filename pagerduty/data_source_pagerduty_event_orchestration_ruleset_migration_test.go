@@ -0,0 +1,109 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestConvertRulesetRulesToEventOrchestration(t *testing.T) {
+	rules := []*pagerduty.RulesetRule{
+		{
+			ID: "R1",
+			Conditions: &pagerduty.RuleConditions{
+				Operator: "and",
+				RuleSubconditions: []*pagerduty.RuleSubcondition{
+					{Operator: "contains", Parameters: &pagerduty.ConditionParameter{Path: "payload.summary", Value: "db's"}},
+					{Operator: "nexists", Parameters: &pagerduty.ConditionParameter{Path: "payload.custom_details.ignore"}},
+				},
+			},
+			TimeFrame: &pagerduty.RuleTimeFrame{
+				ScheduledWeekly: &pagerduty.ScheduledWeekly{
+					Weekdays:  []int{1, 5},
+					Timezone:  "Europe/Paris",
+					StartTime: 9 * 3600 * 1000,
+					Duration:  8 * 3600 * 1000,
+				},
+			},
+			Actions: &pagerduty.RuleActions{
+				Severity: &pagerduty.RuleActionParameter{Value: "critical"},
+				Route:    &pagerduty.RuleActionParameter{Value: "PSVC001"},
+			},
+		},
+		{
+			ID:        "R2",
+			TimeFrame: &pagerduty.RuleTimeFrame{ActiveBetween: &pagerduty.ActiveBetween{StartTime: 1, EndTime: 2}},
+			Actions: &pagerduty.RuleActions{
+				Suppress: &pagerduty.RuleActionSuppress{Value: true, ThresholdValue: 3},
+			},
+		},
+		{
+			ID:       "R3",
+			CatchAll: true,
+			Actions: &pagerduty.RuleActions{
+				Route: &pagerduty.RuleActionParameter{Value: "PSVC002"},
+			},
+		},
+	}
+
+	global, router, warnings := convertRulesetRulesToEventOrchestration(rules)
+
+	globalPath, _ := json.Marshal(global)
+	expectedGlobal := `{"catch_all":{"actions":{}},"sets":[{"id":"start","rules":[` +
+		`{"actions":{"severity":"critical"},"conditions":[{"expression":"(event.summary matches part 'db\\'s' and not event.custom_details.ignore exists) and (now in Mon,Fri 09:00:00 to 17:00:00 Europe/Paris)"}],"disabled":false,"label":"Migrated from ruleset rule R1"},` +
+		`{"actions":{},"conditions":[],"disabled":true,"label":"Migrated from ruleset rule R2"}]}]}`
+	if string(globalPath) != expectedGlobal {
+		t.Errorf("expected global path %s, got %s", expectedGlobal, globalPath)
+	}
+
+	routerPath, _ := json.Marshal(router)
+	expectedRouter := `{"catch_all":{"actions":{"route_to":"PSVC002"}},"sets":[{"id":"start","rules":[` +
+		`{"actions":{"route_to":"PSVC001"},"conditions":[{"expression":"(event.summary matches part 'db\\'s' and not event.custom_details.ignore exists) and (now in Mon,Fri 09:00:00 to 17:00:00 Europe/Paris)"}],"disabled":false,"label":"Migrated from ruleset rule R1"}]}]}`
+	if string(routerPath) != expectedRouter {
+		t.Errorf("expected router path %s, got %s", expectedRouter, routerPath)
+	}
+
+	if len(warnings) != 2 {
+		t.Errorf("expected warnings for the suppress threshold and the active_between time frame, got %v", warnings)
+	}
+
+	for _, r := range global["sets"].([]interface{})[0].(map[string]interface{})["rules"].([]interface{}) {
+		for _, c := range r.(map[string]interface{})["conditions"].([]*pagerduty.EventOrchestrationPathRuleCondition) {
+			if err := validatePCLCondition(c.Expression); err != nil {
+				t.Errorf("expected %q to be a valid PCL condition: %s", c.Expression, err)
+			}
+		}
+	}
+}
+
+func TestConvertRulesetRulesToEventOrchestration_RouteAfterUnroutedRule(t *testing.T) {
+	rules := []*pagerduty.RulesetRule{
+		{
+			ID: "R1",
+			Actions: &pagerduty.RuleActions{
+				Severity: &pagerduty.RuleActionParameter{Value: "info"},
+			},
+		},
+		{
+			ID:       "R2",
+			Disabled: true,
+			Actions: &pagerduty.RuleActions{
+				Severity: &pagerduty.RuleActionParameter{Value: "warning"},
+			},
+		},
+		{
+			ID: "R3",
+			Actions: &pagerduty.RuleActions{
+				Route: &pagerduty.RuleActionParameter{Value: "PSVC001"},
+			},
+		},
+	}
+
+	_, _, warnings := convertRulesetRulesToEventOrchestration(rules)
+
+	expected := "rule R3: events matched first by rules R1, which have no route, are now routed by this rule"
+	if len(warnings) != 1 || warnings[0] != expected {
+		t.Errorf("expected warnings [%s], got %v", expected, warnings)
+	}
+}
@@ -0,0 +1,10 @@
+package pagerduty
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyEventOrchestrationServiceCacheVariable() *schema.Resource {
+	return buildEventOrchestrationCacheVariableDataSource(pagerduty.CacheVariableTypeService, "service")
+}
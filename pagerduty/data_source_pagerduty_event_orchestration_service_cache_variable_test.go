@@ -0,0 +1,35 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyEventOrchestrationServiceCacheVariable_Basic(t *testing.T) {
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationServiceCacheVariableConfig(escalationPolicy, service, false) + `
+data "pagerduty_event_orchestration_service_cache_variable" "by_name" {
+	service = pagerduty_service.bar.id
+	name    = pagerduty_event_orchestration_service_cache_variable.cv.name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.pagerduty_event_orchestration_service_cache_variable.by_name", "id", "pagerduty_event_orchestration_service_cache_variable.cv", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_event_orchestration_service_cache_variable.by_name", "configuration.0.type", "external_data"),
+					resource.TestCheckResourceAttr("data.pagerduty_event_orchestration_service_cache_variable.by_name", "configuration.0.data_type", "number"),
+					resource.TestCheckResourceAttr("data.pagerduty_event_orchestration_service_cache_variable.by_name", "disabled", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,132 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourcePagerDutyEventOrchestrations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyEventOrchestrationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_filter": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"event_orchestrations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of Event Orchestrations whose name matches the name filter",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"integration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"label": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"parameters": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"routing_key": {
+													Type:      schema.TypeString,
+													Computed:  true,
+													Sensitive: true,
+												},
+												"type": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyEventOrchestrationsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty Event Orchestrations")
+
+	nameFilter := regexp.MustCompile(d.Get("name_filter").(string))
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.EventOrchestrations.List()
+		if err != nil {
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		var orchestrations []map[string]interface{}
+		for _, found := range resp.Orchestrations {
+			if !nameFilter.MatchString(found.Name) {
+				continue
+			}
+
+			// Get each matching orchestration by ID so we can set the integrations
+			// property since the list endpoint does not return it
+			orch, _, err := client.EventOrchestrations.Get(found.ID)
+			if err != nil {
+				time.Sleep(30 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			orchestrations = append(orchestrations, map[string]interface{}{
+				"id":          orch.ID,
+				"name":        orch.Name,
+				"integration": flattenEventOrchestrationIntegrations(orch.Integrations),
+			})
+		}
+
+		if len(orchestrations) == 0 {
+			return resource.NonRetryableError(
+				fmt.Errorf("Unable to locate any Event Orchestration matching the expression: %s", nameFilter),
+			)
+		}
+
+		// Since this data doesn't have an unique ID, this force this data to be
+		// refreshed in every Terraform apply
+		d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+		d.Set("event_orchestrations", orchestrations)
+
+		return nil
+	})
+}
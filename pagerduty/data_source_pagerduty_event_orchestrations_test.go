@@ -0,0 +1,65 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyEventOrchestrations_Basic(t *testing.T) {
+	prefix := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	name1 := fmt.Sprintf("%s-one", prefix)
+	name2 := fmt.Sprintf("%s-two", prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyEventOrchestrationsConfig(name1, name2, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_event_orchestrations.by_prefix", "event_orchestrations.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_event_orchestrations.by_name", "event_orchestrations.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_event_orchestrations.by_name", "event_orchestrations.0.id",
+						"pagerduty_event_orchestration.one", "id"),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_event_orchestrations.by_name", "event_orchestrations.0.name", name1),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_event_orchestrations.by_name", "event_orchestrations.0.integration.0.parameters.0.routing_key",
+						"pagerduty_event_orchestration.one", "integration.0.parameters.0.routing_key"),
+				),
+			},
+			{
+				Config:      testAccDataSourcePagerDutyEventOrchestrationsConfig(name1, name2, "tf-no-such-orchestration"),
+				ExpectError: regexp.MustCompile("Unable to locate any Event Orchestration matching the expression"),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyEventOrchestrationsConfig(name1, name2, filter string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_event_orchestration" "one" {
+  name = "%s"
+}
+
+resource "pagerduty_event_orchestration" "two" {
+  name = "%s"
+}
+
+data "pagerduty_event_orchestrations" "by_prefix" {
+  name_filter = "^%s"
+  depends_on  = [pagerduty_event_orchestration.one, pagerduty_event_orchestration.two]
+}
+
+data "pagerduty_event_orchestrations" "by_name" {
+  name_filter = "^${pagerduty_event_orchestration.one.name}$"
+}
+`, name1, name2, filter)
+}
@@ -0,0 +1,97 @@
+package pagerduty
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyExtensionSchemas() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyExtensionSchemasRead,
+
+		Schema: map[string]*schema.Schema{
+			"extension_schemas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"send_types": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyExtensionSchemasRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty Extension Schemas")
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.ExtensionSchemas.List(&pagerduty.ListExtensionSchemasOptions{})
+		if err != nil {
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		// Since this data doesn't have an unique ID, this force this data to be
+		// refreshed in every Terraform apply
+		d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+		d.Set("extension_schemas", flattenExtensionSchemas(resp.ExtensionSchemas))
+
+		return nil
+	})
+}
+
+func flattenExtensionSchemas(extensionSchemas []*pagerduty.ExtensionSchema) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, s := range extensionSchemas {
+		result = append(result, map[string]interface{}{
+			"id":          s.ID,
+			"name":        s.Label,
+			"key":         s.Key,
+			"type":        s.Type,
+			"description": s.Description,
+			"send_types":  s.SendTypes,
+		})
+	}
+
+	return result
+}
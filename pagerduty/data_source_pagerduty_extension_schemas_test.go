@@ -0,0 +1,34 @@
+package pagerduty
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyExtensionSchemas_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyExtensionSchemasConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair("data.pagerduty_extension_schemas.all", "extension_schemas.*.id", "data.pagerduty_extension_schema.webhook", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pagerduty_extension_schemas.all", "extension_schemas.*", map[string]string{
+						"name": "Generic V2 Webhook",
+						"type": "extension_schema",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourcePagerDutyExtensionSchemasConfig = `
+data "pagerduty_extension_schemas" "all" {}
+
+data "pagerduty_extension_schema" "webhook" {
+  name = "Generic V2 Webhook"
+}
+`
@@ -0,0 +1,72 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyIncidentCustomField(t *testing.T) {
+	fieldName := fmt.Sprintf("tf_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckIncidentCustomFields(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyIncidentCustomFieldConfig(fieldName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_incident_custom_field.test", "id", "pagerduty_incident_custom_field.input", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_incident_custom_field.test", "name", fieldName),
+					resource.TestCheckResourceAttr("data.pagerduty_incident_custom_field.test", "display_name", "Region"),
+					resource.TestCheckResourceAttr("data.pagerduty_incident_custom_field.test", "data_type", "string"),
+					resource.TestCheckResourceAttr("data.pagerduty_incident_custom_field.test", "field_type", "multi_value"),
+					resource.TestCheckResourceAttr("data.pagerduty_incident_custom_field.test", "default_value", `["us-east"]`),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyIncidentCustomFieldConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_incident_custom_field" "input" {
+  name          = "%s"
+  display_name  = "Region"
+  data_type     = "string"
+  field_type    = "multi_value"
+  default_value = jsonencode(["us-east"])
+}
+
+data "pagerduty_incident_custom_field" "test" {
+  name = pagerduty_incident_custom_field.input.name
+}
+`, name)
+}
+
+func TestAccDataSourcePagerDutyIncidentCustomField_Missing(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckIncidentCustomFields(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "pagerduty_incident_custom_field" "test" {
+  name = "tf_missing"
+}
+`,
+				ExpectError: regexp.MustCompile("unable to locate any incident custom field with name: tf_missing"),
+			},
+		},
+	})
+}
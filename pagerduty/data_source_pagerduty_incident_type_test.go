@@ -0,0 +1,50 @@
+package pagerduty
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyIncidentType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "pagerduty_incident_type" "base" {
+  display_name = "Base Incident"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_incident_type.base", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_incident_type.base", "name", "incident_default"),
+					resource.TestCheckResourceAttr("data.pagerduty_incident_type.base", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourcePagerDutyIncidentType_Missing(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "pagerduty_incident_type" "missing" {
+  display_name = "tf-missing"
+}
+`,
+				ExpectError: regexp.MustCompile("unable to locate any incident type with display_name: tf-missing"),
+			},
+		},
+	})
+}
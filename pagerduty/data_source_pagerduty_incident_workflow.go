@@ -25,6 +25,46 @@ func dataSourcePagerDutyIncidentWorkflow() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"team": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"step": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"input": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -40,7 +80,7 @@ func dataSourcePagerDutyIncidentWorkflowRead(ctx context.Context, d *schema.Reso
 	searchName := d.Get("name").(string)
 
 	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
-		resp, _, err := client.IncidentWorkflows.ListContext(ctx, &pagerduty.ListIncidentWorkflowOptions{})
+		resp, _, err := client.IncidentWorkflows.ListContext(ctx, &pagerduty.ListIncidentWorkflowOptions{Query: searchName})
 		if err != nil {
 			// Delaying retry by 30s as recommended by PagerDuty
 			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
@@ -63,10 +103,21 @@ func dataSourcePagerDutyIncidentWorkflowRead(ctx context.Context, d *schema.Reso
 			)
 		}
 
-		err = flattenIncidentWorkflow(d, found, false, nil)
+		// The steps of the workflows aren't part of the list response
+		iw, _, err := client.IncidentWorkflows.GetContext(ctx, found.ID)
+		if err != nil {
+			if isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		err = flattenIncidentWorkflow(d, iw, false, nil)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
+		d.Set("step", flattenIncidentWorkflowDataSourceSteps(iw.Steps))
 
 		return nil
 	})
@@ -75,5 +126,29 @@ func dataSourcePagerDutyIncidentWorkflowRead(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 	return nil
+}
+
+func flattenIncidentWorkflowDataSourceSteps(steps []*pagerduty.IncidentWorkflowStep) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, len(steps))
+	for i, s := range steps {
+		m := map[string]interface{}{
+			"id":   s.ID,
+			"name": s.Name,
+		}
 
+		var inputs []map[string]interface{}
+		if s.Configuration != nil {
+			m["action"] = s.Configuration.ActionID
+			for _, in := range s.Configuration.Inputs {
+				inputs = append(inputs, map[string]interface{}{
+					"name":  in.Name,
+					"value": in.Value,
+				})
+			}
+		}
+		m["input"] = inputs
+
+		flattened[i] = m
+	}
+	return flattened
 }
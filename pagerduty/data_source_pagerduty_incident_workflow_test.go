@@ -25,6 +25,9 @@ func TestAccDataSourcePagerDutyIncidentWorkflow(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
 					resource.TestCheckResourceAttr(dataSourceName, "name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "step.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "step.0.id", "pagerduty_incident_workflow.input", "step.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "step.0.action", "pagerduty.com:incident-workflows:send-status-update:1"),
 				),
 			},
 		},
@@ -35,6 +38,14 @@ func testAccDataSourcePagerDutyIncidentWorkflowConfig(name string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_incident_workflow" "input" {
   name = "%[1]s"
+  step {
+    name   = "Send Status Update"
+    action = "pagerduty.com:incident-workflows:send-status-update:1"
+    input {
+      name  = "Message"
+      value = "Status update sent by %[1]s"
+    }
+  }
 }
 
 data "pagerduty_incident_workflow" "%[1]s" {
@@ -0,0 +1,82 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyJiraCloudAccountMapping() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyJiraCloudAccountMappingRead,
+
+		Schema: map[string]*schema.Schema{
+			"subdomain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"base_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyJiraCloudAccountMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	searchSubdomain := d.Get("subdomain").(string)
+
+	log.Printf("[INFO] Reading PagerDuty Jira Cloud account mapping of %s", searchSubdomain)
+
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.JiraCloud.ListAccountMappingsContext(ctx)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		var found *pagerduty.JiraCloudAccountMapping
+
+		for _, m := range resp.AccountMappings {
+			if m.PagerDuty != nil && m.PagerDuty.Subdomain == searchSubdomain {
+				found = m
+				break
+			}
+		}
+
+		if found == nil {
+			return resource.NonRetryableError(
+				fmt.Errorf("Unable to locate any Jira Cloud account mapping with the subdomain: %s", searchSubdomain),
+			)
+		}
+
+		d.SetId(found.ID)
+		d.Set("subdomain", found.PagerDuty.Subdomain)
+		if found.JiraCloud != nil {
+			d.Set("base_url", found.JiraCloud.BaseURL)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package pagerduty
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyJiraCloudAccountMapping_Basic(t *testing.T) {
+	testAccPreCheckJiraCloud(t)
+
+	accountMapping := os.Getenv("PAGERDUTY_ACC_JIRA_CLOUD_ACCOUNT_MAPPING")
+	subdomain := strings.Split(testAccGetPagerDutyAccountDomain(t), ".")[0]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyJiraCloudAccountMappingConfig(subdomain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_jira_cloud_account_mapping.foo", "id", accountMapping),
+					resource.TestCheckResourceAttr("data.pagerduty_jira_cloud_account_mapping.foo", "subdomain", subdomain),
+					resource.TestCheckResourceAttrSet("data.pagerduty_jira_cloud_account_mapping.foo", "base_url"),
+				),
+			},
+			{
+				Config:      testAccDataSourcePagerDutyJiraCloudAccountMappingConfig("tf-not-found"),
+				ExpectError: regexp.MustCompile("Unable to locate any Jira Cloud account mapping with the subdomain: tf-not-found"),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyJiraCloudAccountMappingConfig(subdomain string) string {
+	return fmt.Sprintf(`
+data "pagerduty_jira_cloud_account_mapping" "foo" {
+  subdomain = "%s"
+}
+`, subdomain)
+}
@@ -0,0 +1,123 @@
+package pagerduty
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyLicenses() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyLicensesRead,
+
+		Schema: map[string]*schema.Schema{
+			"licenses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"summary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"valid_roles": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"current_value": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"allocations_available": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyLicensesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading PagerDuty licenses")
+
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		licenses, _, err := client.Licenses.ListContext(ctx)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		// Since this data doesn't have an unique ID, this force this data to be
+		// refreshed in every Terraform apply
+		d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+		d.Set("licenses", flattenLicenses(licenses))
+
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenLicenses(licenses []*pagerduty.License) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, l := range licenses {
+		result = append(result, map[string]interface{}{
+			"id":                    l.ID,
+			"name":                  l.Name,
+			"description":           l.Description,
+			"summary":               l.Summary,
+			"role_group":            l.RoleGroup,
+			"valid_roles":           l.ValidRoles,
+			"current_value":         l.CurrentValue,
+			"allocations_available": l.AllocationsAvailable,
+			"html_url":              l.HTMLURL,
+		})
+	}
+
+	return result
+}
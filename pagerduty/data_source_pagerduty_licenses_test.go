@@ -0,0 +1,30 @@
+package pagerduty
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyLicenses_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyLicensesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_licenses.all", "licenses.0.id"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_licenses.all", "licenses.0.name"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_licenses.all", "licenses.0.role_group"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_licenses.all", "licenses.0.current_value"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_licenses.all", "licenses.0.allocations_available"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourcePagerDutyLicensesConfig = `
+data "pagerduty_licenses" "all" {}
+`
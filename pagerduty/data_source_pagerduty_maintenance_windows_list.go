@@ -0,0 +1,138 @@
+package pagerduty
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyMaintenanceWindows() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyMaintenanceWindowsRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"team_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "open",
+				ValidateFunc: validateValueFunc([]string{
+					"past",
+					"future",
+					"ongoing",
+					"open",
+					"all",
+				}),
+			},
+			"maintenance_windows": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"services": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyMaintenanceWindowsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty maintenance windows")
+
+	o := &pagerduty.ListMaintenanceWindowsOptions{
+		Filter:     d.Get("filter").(string),
+		ServiceIDs: expandStringList(d.Get("service_ids").([]interface{})),
+		TeamIDs:    expandStringList(d.Get("team_ids").([]interface{})),
+	}
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.MaintenanceWindows.List(o)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		// Since this data doesn't have an unique ID, this force this data to be
+		// refreshed in every Terraform apply
+		d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+		d.Set("maintenance_windows", flattenMaintenanceWindows(resp.MaintenanceWindows))
+
+		return nil
+	})
+}
+
+func flattenMaintenanceWindows(windows []*pagerduty.MaintenanceWindow) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, w := range windows {
+		var services []string
+		for _, s := range w.Services {
+			services = append(services, s.ID)
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":          w.ID,
+			"description": w.Description,
+			"start_time":  w.StartTime,
+			"end_time":    w.EndTime,
+			"services":    services,
+			"html_url":    w.HTMLURL,
+		})
+	}
+
+	return result
+}
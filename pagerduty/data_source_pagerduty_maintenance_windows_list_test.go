@@ -0,0 +1,78 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyMaintenanceWindows_Basic(t *testing.T) {
+	window := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	windowStartTime := timeNowInAccLoc().Add(24 * time.Hour).Format(time.RFC3339)
+	windowEndTime := timeNowInAccLoc().Add(48 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyMaintenanceWindowsConfig(window, windowStartTime, windowEndTime),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_maintenance_windows.future", "maintenance_windows.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_maintenance_windows.future", "maintenance_windows.0.id", "pagerduty_maintenance_window.foo", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_maintenance_windows.future", "maintenance_windows.0.description", window),
+					resource.TestCheckResourceAttrPair("data.pagerduty_maintenance_windows.future", "maintenance_windows.0.services.0", "pagerduty_service.foo", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_maintenance_windows.ongoing", "maintenance_windows.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyMaintenanceWindowsConfig(desc, start, end string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]v"
+  email = "%[1]v@foo.test"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%[1]v"
+  num_loops = 2
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[1]v"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_maintenance_window" "foo" {
+  description = "%[1]v"
+  start_time  = "%[2]v"
+  end_time    = "%[3]v"
+  services    = [pagerduty_service.foo.id]
+}
+
+data "pagerduty_maintenance_windows" "future" {
+  service_ids = [pagerduty_maintenance_window.foo.services[0]]
+  filter      = "future"
+}
+
+data "pagerduty_maintenance_windows" "ongoing" {
+  service_ids = [pagerduty_maintenance_window.foo.services[0]]
+  filter      = "ongoing"
+}
+`, desc, start, end)
+}
@@ -0,0 +1,90 @@
+package pagerduty
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyPriorities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyPrioritiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"priorities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"order": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyPrioritiesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty priorities")
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.Priorities.List()
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		// Since this data doesn't have an unique ID, this force this data to be
+		// refreshed in every Terraform apply
+		d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+		d.Set("priorities", flattenPriorities(resp.Priorities))
+
+		return nil
+	})
+}
+
+// flattenPriorities keeps the priorities in the order returned by the API,
+// from the most to the least severe, and exposes it as their order.
+func flattenPriorities(priorities []*pagerduty.Priority) []map[string]interface{} {
+	var result []map[string]interface{}
+	for i, p := range priorities {
+		result = append(result, map[string]interface{}{
+			"id":          p.ID,
+			"name":        p.Name,
+			"description": p.Description,
+			"order":       i + 1,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,34 @@
+package pagerduty
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyPriorities_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyPrioritiesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair("data.pagerduty_priorities.all", "priorities.*.id", "data.pagerduty_priority.p1", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pagerduty_priorities.all", "priorities.*", map[string]string{
+						"name": "P1",
+					}),
+					resource.TestCheckResourceAttr("data.pagerduty_priorities.all", "priorities.0.order", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourcePagerDutyPrioritiesConfig = `
+data "pagerduty_priorities" "all" {}
+
+data "pagerduty_priority" "p1" {
+  name = "P1"
+}
+`
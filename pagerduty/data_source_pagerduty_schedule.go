@@ -19,6 +19,88 @@ func dataSourcePagerDutySchedule() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"time_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"teams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"layer": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rotation_virtual_start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rotation_turn_length_seconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"users": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"rendered_coverage_percentage": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"restriction": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"start_time_of_day": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"start_day_of_week": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"duration_seconds": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -40,6 +122,10 @@ func dataSourcePagerDutyScheduleRead(d *schema.ResourceData, meta interface{}) e
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		resp, _, err := client.Schedules.List(o)
 		if err != nil {
+			if isTeamScopedTokenError(err) {
+				return resource.NonRetryableError(teamScopedTokenError("schedule", "name", searchName, err))
+			}
+
 			// Delaying retry by 30s as recommended by PagerDuty
 			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
 			time.Sleep(30 * time.Second)
@@ -61,8 +147,28 @@ func dataSourcePagerDutyScheduleRead(d *schema.ResourceData, meta interface{}) e
 			)
 		}
 
-		d.SetId(found.ID)
-		d.Set("name", found.Name)
+		// Get the found schedule by ID so we can set its layers, since the list
+		// endpoint does not return them
+		schedule, _, err := client.Schedules.Get(found.ID, &pagerduty.GetScheduleOptions{})
+		if err != nil {
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		layers, err := flattenScheduleLayers(schedule.ScheduleLayers)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		d.SetId(schedule.ID)
+		d.Set("name", schedule.Name)
+		d.Set("time_zone", schedule.TimeZone)
+		d.Set("description", schedule.Description)
+		d.Set("teams", flattenShedTeams(schedule.Teams))
+
+		if err := d.Set("layer", layers); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
 		return nil
 	})
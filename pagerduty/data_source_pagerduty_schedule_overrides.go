@@ -0,0 +1,129 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyScheduleOverrides() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyScheduleOverridesRead,
+
+		Schema: map[string]*schema.Schema{
+			"schedule_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Start of the time window, defaults to now",
+			},
+			"until": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "End of the time window, defaults to 30 days after since",
+			},
+			"overrides": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyScheduleOverridesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	scheduleID := d.Get("schedule_id").(string)
+
+	since := time.Now().UTC().Truncate(time.Minute)
+	if attr, ok := d.GetOk("since"); ok {
+		since, _ = time.Parse(time.RFC3339, attr.(string))
+	}
+
+	until := since.Add(30 * 24 * time.Hour)
+	if attr, ok := d.GetOk("until"); ok {
+		until, _ = time.Parse(time.RFC3339, attr.(string))
+	}
+
+	if !until.After(since) {
+		return fmt.Errorf("until (%s) must be after since (%s)", until.Format(time.RFC3339), since.Format(time.RFC3339))
+	}
+
+	log.Printf("[INFO] Reading PagerDuty overrides of schedule %s", scheduleID)
+
+	o := &pagerduty.ListOverridesOptions{
+		Since: since.Format(time.RFC3339),
+		Until: until.Format(time.RFC3339),
+	}
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.Schedules.ListOverrides(scheduleID, o)
+		if err != nil {
+			if isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		var overrides []map[string]interface{}
+		for _, override := range resp.Overrides {
+			var user string
+			if override.User != nil {
+				user = override.User.ID
+			}
+
+			overrides = append(overrides, map[string]interface{}{
+				"id":    override.ID,
+				"user":  user,
+				"start": override.Start,
+				"end":   override.End,
+			})
+		}
+
+		d.SetId(fmt.Sprintf("%s:%s:%s", scheduleID, o.Since, o.Until))
+		d.Set("since", o.Since)
+		d.Set("until", o.Until)
+		d.Set("overrides", overrides)
+
+		return nil
+	})
+}
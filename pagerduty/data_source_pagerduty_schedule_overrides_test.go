@@ -0,0 +1,105 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAccDataSourcePagerDutyScheduleOverrides_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	location := "America/New_York"
+	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour)
+	overrideStart := start.Add(48 * time.Hour)
+	overrideEnd := overrideStart.Add(4 * time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyScheduleOverridesConfig(username, email, schedule, location, start.Format(time.RFC3339)),
+				Check: testAccCheckPagerDutyScheduleCreateOverride(
+					"pagerduty_schedule.test", "pagerduty_user.test", overrideStart, overrideEnd),
+			},
+			{
+				Config: testAccDataSourcePagerDutyScheduleOverridesConfig(username, email, schedule, location, start.Format(time.RFC3339)) +
+					testAccDataSourcePagerDutyScheduleOverridesDataConfig(start.Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_schedule_overrides.test", "overrides.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_schedule_overrides.test", "overrides.0.user", "pagerduty_user.test", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.pagerduty_schedule_overrides.test", "overrides.0.id"),
+					resource.TestCheckResourceAttrSet(
+						"data.pagerduty_schedule_overrides.test", "until"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyScheduleCreateOverride(scheduleName, userName string, start, end time.Time) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		schedule, ok := s.RootModule().Resources[scheduleName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", scheduleName)
+		}
+		user, ok := s.RootModule().Resources[userName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", userName)
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		_, _, err := client.Schedules.CreateOverride(schedule.Primary.ID, &pagerduty.Override{
+			Start: start.Format(time.RFC3339),
+			End:   end.Format(time.RFC3339),
+			User: &pagerduty.UserReference{
+				ID:   user.Primary.ID,
+				Type: "user_reference",
+			},
+		})
+
+		return err
+	}
+}
+
+func testAccDataSourcePagerDutyScheduleOverridesConfig(username, email, schedule, location, start string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_schedule" "test" {
+  name      = "%s"
+  time_zone = "%s"
+
+  layer {
+    name                         = "foo"
+    start                        = "%[5]s"
+    rotation_virtual_start       = "%[5]s"
+    rotation_turn_length_seconds = 86400
+    users                        = [pagerduty_user.test.id]
+  }
+}
+`, username, email, schedule, location, start)
+}
+
+func testAccDataSourcePagerDutyScheduleOverridesDataConfig(since string) string {
+	return fmt.Sprintf(`
+data "pagerduty_schedule_overrides" "test" {
+  schedule_id = pagerduty_schedule.test.id
+  since       = "%s"
+}
+`, since)
+}
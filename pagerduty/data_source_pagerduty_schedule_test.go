@@ -45,7 +45,7 @@ func testAccDataSourcePagerDutySchedule(src, n string) resource.TestCheckFunc {
 			return fmt.Errorf("Expected to get a schedule ID from PagerDuty")
 		}
 
-		testAtts := []string{"id", "name"}
+		testAtts := []string{"id", "name", "time_zone", "description", "layer.#", "layer.0.name", "layer.0.users.0", "layer.0.rotation_turn_length_seconds"}
 
 		for _, att := range testAtts {
 			if a[att] != srcA[att] {
@@ -3,10 +3,13 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
@@ -16,13 +19,85 @@ func dataSourcePagerDutyService() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "name_regex"},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regular expression matching the name of exactly one service",
+			},
+			"exact_match": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only match a service whose name is exactly name, defaults to the provider's service_data_source_exact_match",
 			},
 			"type": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"include_metrics": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"metrics_period_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 365),
+			},
+			"metrics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"total_incident_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_incidents_acknowledged": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_incidents_auto_resolved": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_incidents_manual_escalated": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_incidents_timeout_escalated": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_interruptions": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_notifications": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"mean_seconds_to_resolve": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"mean_seconds_to_first_ack": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"auto_resolved_ratio": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -35,40 +110,180 @@ func dataSourcePagerDutyServiceRead(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[INFO] Reading PagerDuty service")
 
-	searchName := d.Get("name").(string)
+	retryErr := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		found, retryErr := findPagerDutyService(d, meta.(*Config), client)
+		if retryErr != nil {
+			return retryErr
+		}
 
+		d.SetId(found.ID)
+		d.Set("name", found.Name)
+		d.Set("type", found.Type)
+
+		return nil
+	})
+	if retryErr != nil {
+		return retryErr
+	}
+
+	if !d.Get("include_metrics").(bool) {
+		return d.Set("metrics", nil)
+	}
+
+	return fetchPagerDutyServiceMetrics(d, client)
+}
+
+// findPagerDutyService looks the service up by name_regex, or by name either
+// exactly or, for compatibility, among the first page of results of the name
+// query.
+func findPagerDutyService(d *schema.ResourceData, config *Config, client *pagerduty.Client) (*pagerduty.Service, *resource.RetryError) {
+	retryable := func(err error) *resource.RetryError {
+		// Delaying retry by 30s as recommended by PagerDuty
+		// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+		time.Sleep(30 * time.Second)
+		return resource.RetryableError(err)
+	}
+
+	// listServices narrows the listing down to the teams of a team-scoped
+	// token when the account-level one is forbidden
+	listServices := func(o *pagerduty.ListServicesOptions, all bool, attr, value string) ([]*pagerduty.Service, *resource.RetryError) {
+		list := func() ([]*pagerduty.Service, error) {
+			if all {
+				return client.Services.ListAll(o)
+			}
+			resp, _, err := client.Services.List(o)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Services, nil
+		}
+
+		services, err := list()
+		if retry, scopeErr := narrowToTokenTeams(config, err, &o.TeamIDs); scopeErr != nil {
+			return nil, resource.NonRetryableError(teamScopedTokenError("service", attr, value, scopeErr))
+		} else if retry {
+			services, err = list()
+		}
+		if err != nil {
+			if isTeamScopedTokenError(err) {
+				return nil, resource.NonRetryableError(teamScopedTokenError("service", attr, value, err))
+			}
+			return nil, retryable(err)
+		}
+		return services, nil
+	}
+
+	if attr, ok := d.GetOk("name_regex"); ok {
+		re := regexp.MustCompile(attr.(string))
+
+		services, retryErr := listServices(&pagerduty.ListServicesOptions{}, true, "name matching", attr.(string))
+		if retryErr != nil {
+			return nil, retryErr
+		}
+
+		var matches []*pagerduty.Service
+		var names []string
+		for _, service := range services {
+			if re.MatchString(service.Name) {
+				matches = append(matches, service)
+				names = append(names, service.Name)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			return nil, resource.NonRetryableError(fmt.Errorf("Unable to locate any service with a name matching: %s", attr.(string)))
+		case 1:
+			return matches[0], nil
+		default:
+			return nil, resource.NonRetryableError(fmt.Errorf("Found %d services with a name matching %s, expected exactly one: %s", len(matches), attr.(string), strings.Join(names, ", ")))
+		}
+	}
+
+	searchName := d.Get("name").(string)
 	o := &pagerduty.ListServicesOptions{
 		Query: searchName,
 	}
 
+	exactMatch := config.ServiceDataSourceExactMatch
+	if raw := d.GetRawConfig(); !raw.IsNull() && !raw.GetAttr("exact_match").IsNull() {
+		exactMatch = d.Get("exact_match").(bool)
+	}
+
+	services, retryErr := listServices(o, exactMatch, "name", searchName)
+	if retryErr != nil {
+		return nil, retryErr
+	}
+
+	for _, service := range services {
+		if service.Name == searchName {
+			return service, nil
+		}
+	}
+
+	return nil, resource.NonRetryableError(fmt.Errorf("Unable to locate any service with the name: %s", searchName))
+}
+
+// fetchPagerDutyServiceMetrics reads the aggregated incident analytics of the
+// service over the last metrics_period_days days.
+func fetchPagerDutyServiceMetrics(d *schema.ResourceData, client *pagerduty.Client) error {
+	end := time.Now().UTC()
+	start := end.AddDate(0, 0, -d.Get("metrics_period_days").(int))
+
+	o := &pagerduty.AnalyticsRequest{
+		Filters: &pagerduty.AnalyticsFilter{
+			CreatedAtStart: start.Format(time.RFC3339),
+			CreatedAtEnd:   end.Format(time.RFC3339),
+			ServiceIDs:     []string{d.Id()},
+		},
+	}
+
+	log.Printf("[INFO] Reading PagerDuty service metrics for %s", d.Id())
+
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
-		resp, _, err := client.Services.List(o)
+		resp, _, err := client.Analytics.GetAggregatedServiceData(o)
 		if err != nil {
-			// Delaying retry by 30s as recommended by PagerDuty
-			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
 			time.Sleep(30 * time.Second)
 			return resource.RetryableError(err)
 		}
 
-		var found *pagerduty.Service
-
-		for _, service := range resp.Services {
-			if service.Name == searchName {
-				found = service
+		metrics := &pagerduty.AnalyticsData{}
+		for _, data := range resp.Data {
+			if data.ServiceID == d.Id() {
+				metrics = data
 				break
 			}
 		}
 
-		if found == nil {
-			return resource.NonRetryableError(
-				fmt.Errorf("Unable to locate any service with the name: %s", searchName),
-			)
+		if err := d.Set("metrics", flattenServiceMetrics(metrics)); err != nil {
+			return resource.NonRetryableError(err)
 		}
 
-		d.SetId(found.ID)
-		d.Set("name", found.Name)
-		d.Set("type", found.Type)
-
 		return nil
 	})
 }
+
+func flattenServiceMetrics(v *pagerduty.AnalyticsData) []interface{} {
+	var autoResolvedRatio float64
+	if v.TotalIncidentCount > 0 {
+		autoResolvedRatio = float64(v.TotalIncidentsAutoResolved) / float64(v.TotalIncidentCount)
+	}
+
+	metrics := map[string]interface{}{
+		"total_incident_count":              v.TotalIncidentCount,
+		"total_incidents_acknowledged":      v.TotalIncidentsAcknowledged,
+		"total_incidents_auto_resolved":     v.TotalIncidentsAutoResolved,
+		"total_incidents_manual_escalated":  v.TotalIncidentsManualEscalated,
+		"total_incidents_timeout_escalated": v.TotalIncidentsTimeoutEscalated,
+		"total_interruptions":               v.TotalInterruptions,
+		"total_notifications":               v.TotalNotifications,
+		"mean_seconds_to_resolve":           v.MeanSecondsToResolve,
+		"mean_seconds_to_first_ack":         v.MeanSecondsToFirstAck,
+		"auto_resolved_ratio":               autoResolvedRatio,
+	}
+
+	return []interface{}{metrics}
+}
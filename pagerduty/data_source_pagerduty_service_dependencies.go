@@ -0,0 +1,78 @@
+package pagerduty
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePagerDutyServiceDependencies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyServiceDependenciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"service_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validateValueFunc([]string{
+					"business_service",
+					"service",
+				}),
+			},
+			"supporting_service": dataSourceServiceDependenciesServiceSchema(),
+			"dependent_service":  dataSourceServiceDependenciesServiceSchema(),
+		},
+	}
+}
+
+func dataSourceServiceDependenciesServiceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyServiceDependenciesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	serviceID := d.Get("service_id").(string)
+	serviceType := d.Get("service_type").(string)
+
+	log.Printf("[INFO] Reading PagerDuty dependencies of %s %s", serviceType, serviceID)
+
+	dependencies, err := fetchServiceDependencies(client, serviceID, serviceType)
+	if err != nil {
+		return err
+	}
+
+	supporting, dependent := flattenServiceDependencies(serviceID, dependencies)
+
+	d.SetId(serviceID)
+	if err := d.Set("supporting_service", supporting); err != nil {
+		return err
+	}
+	if err := d.Set("dependent_service", dependent); err != nil {
+		return err
+	}
+
+	return nil
+}
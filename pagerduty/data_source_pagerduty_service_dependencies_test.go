@@ -0,0 +1,89 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyServiceDependencies_Basic(t *testing.T) {
+	businessService := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyServiceDependenciesConfig(businessService, service, username, email, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_service_dependencies.business", "supporting_service.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_service_dependencies.business", "supporting_service.0.id", "pagerduty_service.foo", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_service_dependencies.business", "supporting_service.0.type", "service"),
+					resource.TestCheckResourceAttr("data.pagerduty_service_dependencies.business", "dependent_service.#", "0"),
+					resource.TestCheckResourceAttr("data.pagerduty_service_dependencies.service", "supporting_service.#", "0"),
+					resource.TestCheckResourceAttr("data.pagerduty_service_dependencies.service", "dependent_service.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_service_dependencies.service", "dependent_service.0.id", "pagerduty_business_service.foo", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_service_dependencies.service", "dependent_service.0.type", "business_service"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyServiceDependenciesConfig(businessService, service, username, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_business_service" "foo" {
+  name = "%s"
+}
+
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 2
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_service_dependencies" "foo" {
+  service_id   = pagerduty_business_service.foo.id
+  service_type = "business_service"
+
+  supporting_service {
+    id   = pagerduty_service.foo.id
+    type = pagerduty_service.foo.type
+  }
+}
+
+data "pagerduty_service_dependencies" "business" {
+  service_id   = pagerduty_service_dependencies.foo.service_id
+  service_type = "business_service"
+}
+
+data "pagerduty_service_dependencies" "service" {
+  service_id   = pagerduty_service.foo.id
+  service_type = "service"
+
+  depends_on = [pagerduty_service_dependencies.foo]
+}
+`, businessService, username, email, escalationPolicy, service)
+}
@@ -21,9 +21,16 @@ func dataSourcePagerDutyServiceIntegration() *schema.Resource {
 				Required: true,
 			},
 			"integration_summary": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "examples 'Amazon CloudWatch', 'New Relic",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "examples 'Amazon CloudWatch', 'New Relic",
+				ExactlyOneOf: []string{"integration_summary", "vendor_name"},
+			},
+			"vendor_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Name of the vendor of the integration, e.g. 'Amazon CloudWatch'",
+				ExactlyOneOf: []string{"integration_summary", "vendor_name"},
 			},
 
 			"integration_key": {
@@ -49,6 +56,9 @@ func dataSourcePagerDutyServiceIntegrationRead(d *schema.ResourceData, meta inte
 		Query: searchName,
 	}
 
+	integrationSummary := d.Get("integration_summary").(string)
+	vendorName := d.Get("vendor_name").(string)
+
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		resp, _, err := client.Services.List(o)
 		if err != nil {
@@ -70,20 +80,33 @@ func dataSourcePagerDutyServiceIntegrationRead(d *schema.ResourceData, meta inte
 			)
 		}
 
-		integrationSummary := d.Get("integration_summary").(string)
 		for _, integration := range found.Integrations {
-			if strings.EqualFold(integration.Summary, integrationSummary) {
-				integrationDetails, _, err := client.Services.GetIntegration(found.ID, integration.ID, &pagerduty.GetIntegrationOptions{})
-				if err != nil {
-					return handleError(err)
-				}
-				d.SetId(integration.ID)
-				d.Set("service_name", found.Name)
-				d.Set("integration_key", integrationDetails.IntegrationKey)
-
-				return nil
+			// Integration references only carry a summary, so the vendor has to
+			// be resolved from the integration details when looking up by vendor
+			if vendorName == "" && !strings.EqualFold(integration.Summary, integrationSummary) {
+				continue
+			}
+
+			integrationDetails, _, err := client.Services.GetIntegration(found.ID, integration.ID, &pagerduty.GetIntegrationOptions{})
+			if err != nil {
+				return handleError(err)
+			}
+
+			if vendorName != "" && (integrationDetails.Vendor == nil || !strings.EqualFold(integrationDetails.Vendor.Summary, vendorName)) {
+				continue
 			}
 
+			d.SetId(integration.ID)
+			d.Set("service_name", found.Name)
+			d.Set("integration_key", integrationDetails.IntegrationKey)
+
+			return nil
+		}
+
+		if vendorName != "" {
+			return resource.NonRetryableError(
+				fmt.Errorf("unable to locate any integration of vendor %s on service %s", vendorName, searchName),
+			)
 		}
 		return resource.NonRetryableError(
 			fmt.Errorf("unable to locate any integration of type %s on service %s", integrationSummary, searchName),
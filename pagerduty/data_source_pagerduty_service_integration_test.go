@@ -38,6 +38,36 @@ func TestAccDataSourcePagerDutyIntegration_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePagerDutyIntegration_VendorName(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	serviceIntegration := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyIntegrationConfigStep1(service, serviceIntegration, email, escalationPolicy),
+			},
+			{
+				Config: testAccDataSourcePagerDutyIntegrationConfigStep1(service, serviceIntegration, email, escalationPolicy) +
+					testAccDataSourcePagerDutyIntegrationVendorNameConfig(service, "Datadog"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_service_integration.by_vendor", "id",
+						"pagerduty_service_integration.service_integration", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_service_integration.by_vendor", "integration_key",
+						"pagerduty_service_integration.service_integration", "integration_key"),
+				),
+			},
+		},
+	})
+}
+
 func verifyOutput(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		ms := s.RootModule()
@@ -106,3 +136,13 @@ output "output_id" {
 }
 `, service, serviceIntegration)
 }
+
+func testAccDataSourcePagerDutyIntegrationVendorNameConfig(service, vendorName string) string {
+	return fmt.Sprintf(`
+
+data "pagerduty_service_integration" "by_vendor" {
+ service_name = "%s"
+ vendor_name = "%s"
+}
+`, service, vendorName)
+}
@@ -0,0 +1,117 @@
+package pagerduty
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyServiceIntegrations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyServiceIntegrationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"integrations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vendor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vendor_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"integration_key": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"integration_email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyServiceIntegrationsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	serviceID := d.Get("service_id").(string)
+
+	log.Printf("[INFO] Reading PagerDuty integrations of service %s", serviceID)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		service, _, err := client.Services.Get(serviceID, &pagerduty.GetServiceOptions{})
+		if err != nil {
+			if isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+			return handleError(err)
+		}
+
+		// Integrations are only referenced from the service, so each one is
+		// fetched to get its vendor and key
+		var integrations []map[string]interface{}
+		for _, ref := range service.Integrations {
+			integration, _, err := client.Services.GetIntegration(serviceID, ref.ID, &pagerduty.GetIntegrationOptions{})
+			if err != nil {
+				return handleError(err)
+			}
+
+			var vendor, vendorName string
+			if integration.Vendor != nil {
+				vendor = integration.Vendor.ID
+				vendorName = integration.Vendor.Summary
+			}
+
+			integrations = append(integrations, map[string]interface{}{
+				"id":                integration.ID,
+				"name":              integration.Name,
+				"type":              integration.Type,
+				"vendor":            vendor,
+				"vendor_name":       vendorName,
+				"integration_key":   integration.IntegrationKey,
+				"integration_email": integration.IntegrationEmail,
+				"html_url":          integration.HTMLURL,
+			})
+		}
+
+		d.SetId(service.ID)
+		d.Set("integrations", integrations)
+
+		return nil
+	})
+}
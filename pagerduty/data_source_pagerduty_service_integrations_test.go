@@ -0,0 +1,44 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyServiceIntegrations_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	serviceIntegration := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyIntegrationConfigStep1(service, serviceIntegration, email, escalationPolicy) + `
+data "pagerduty_service_integrations" "test" {
+  service_id = pagerduty_service_integration.service_integration.service
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_service_integrations.test", "integrations.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_service_integrations.test", "integrations.0.id",
+						"pagerduty_service_integration.service_integration", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_service_integrations.test", "integrations.0.vendor",
+						"data.pagerduty_vendor.datadog", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_service_integrations.test", "integrations.0.integration_key",
+						"pagerduty_service_integration.service_integration", "integration_key"),
+				),
+			},
+		},
+	})
+}
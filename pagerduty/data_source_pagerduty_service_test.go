@@ -2,6 +2,7 @@ package pagerduty
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -29,6 +30,54 @@ func TestAccDataSourcePagerDutyService_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePagerDutyService_Metrics(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyServiceMetricsConfig(username, email, service, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourcePagerDutyService("pagerduty_service.test", "data.pagerduty_service.by_name"),
+					resource.TestCheckResourceAttr("data.pagerduty_service.by_name", "metrics.#", "1"),
+					resource.TestCheckResourceAttr("data.pagerduty_service.by_name", "metrics.0.total_incident_count", "0"),
+					resource.TestCheckResourceAttr("data.pagerduty_service.by_name", "metrics.0.auto_resolved_ratio", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourcePagerDutyService_ExactMatchAndRegex(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyServiceExactMatchAndRegexConfig(username, email, service, escalationPolicy, fmt.Sprintf("^%s$", service)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourcePagerDutyService("pagerduty_service.test", "data.pagerduty_service.exact"),
+					testAccDataSourcePagerDutyService("pagerduty_service.test", "data.pagerduty_service.regex"),
+				),
+			},
+			{
+				Config:      testAccDataSourcePagerDutyServiceExactMatchAndRegexConfig(username, email, service, escalationPolicy, fmt.Sprintf("^%s", service)),
+				ExpectError: regexp.MustCompile("Found 2 services with a name matching"),
+			},
+		},
+	})
+}
+
 func testAccDataSourcePagerDutyService(src, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -86,3 +135,85 @@ data "pagerduty_service" "by_name" {
 }
 `, username, email, service, escalationPolicy)
 }
+
+func testAccDataSourcePagerDutyServiceMetricsConfig(username, email, service, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "test" {
+  name        = "%s"
+  num_loops   = 2
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.test.id
+    }
+  }
+}
+
+resource "pagerduty_service" "test" {
+  name                    = "%s"
+  auto_resolve_timeout    = 14400
+  acknowledgement_timeout = 600
+  escalation_policy       = pagerduty_escalation_policy.test.id
+  alert_creation          = "create_incidents"
+}
+
+data "pagerduty_service" "by_name" {
+  name                = pagerduty_service.test.name
+  include_metrics     = true
+  metrics_period_days = 7
+}
+`, username, email, escalationPolicy, service)
+}
+
+func testAccDataSourcePagerDutyServiceExactMatchAndRegexConfig(username, email, service, escalationPolicy, nameRegex string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "test" {
+  name        = "%s"
+  num_loops   = 2
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.test.id
+    }
+  }
+}
+
+resource "pagerduty_service" "test" {
+  name                    = "%[4]s"
+  auto_resolve_timeout    = 14400
+  acknowledgement_timeout = 600
+  escalation_policy       = pagerduty_escalation_policy.test.id
+  alert_creation          = "create_incidents"
+}
+
+resource "pagerduty_service" "prefixed" {
+  name                    = "%[4]s-prefixed"
+  auto_resolve_timeout    = 14400
+  acknowledgement_timeout = 600
+  escalation_policy       = pagerduty_escalation_policy.test.id
+  alert_creation          = "create_incidents"
+}
+
+data "pagerduty_service" "exact" {
+  name        = pagerduty_service.test.name
+  exact_match = true
+}
+
+data "pagerduty_service" "regex" {
+  name_regex = "%[5]s"
+  depends_on = [pagerduty_service.test, pagerduty_service.prefixed]
+}
+`, username, email, escalationPolicy, service, nameRegex)
+}
@@ -0,0 +1,115 @@
+package pagerduty
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyServices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyServicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"team_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"services": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of services matching the name and team filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"escalation_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"teams": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyServicesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty services")
+
+	o := &pagerduty.ListServicesOptions{
+		Query:   d.Get("name").(string),
+		TeamIDs: expandStringList(d.Get("team_ids").([]interface{})),
+	}
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, err := client.Services.ListAll(o)
+		if err != nil {
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		var services []map[string]interface{}
+		for _, service := range resp {
+			var escalationPolicy string
+			if service.EscalationPolicy != nil {
+				escalationPolicy = service.EscalationPolicy.ID
+			}
+
+			var teams []string
+			for _, team := range service.Teams {
+				teams = append(teams, team.ID)
+			}
+
+			services = append(services, map[string]interface{}{
+				"id":                service.ID,
+				"name":              service.Name,
+				"type":              service.Type,
+				"escalation_policy": escalationPolicy,
+				"teams":             teams,
+			})
+		}
+
+		// Since this data doesn't have an unique ID, this force this data to be
+		// refreshed in every Terraform apply
+		d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+		d.Set("services", services)
+
+		return nil
+	})
+}
@@ -0,0 +1,132 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourcePagerDutyServices_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	prefix := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service1 := fmt.Sprintf("%s-one", prefix)
+	service2 := fmt.Sprintf("%s-two", prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyServicesConfig(username, email, team, escalationPolicy, service1, service2, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourcePagerDutyServicesExists("data.pagerduty_services.by_name"),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_services.by_name", "services.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.pagerduty_services.by_name",
+						"services.*",
+						map[string]string{
+							"name": service1,
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.pagerduty_services.by_name",
+						"services.*",
+						map[string]string{
+							"name": service2,
+						}),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_services.by_team", "services.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_services.by_team", "services.0.name", service1),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_services.by_team", "services.0.id",
+						"pagerduty_service.one", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_services.by_team", "services.0.escalation_policy",
+						"pagerduty_escalation_policy.test", "id"),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_services.by_team", "services.0.teams.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyServicesExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		r := s.RootModule().Resources[n]
+		a := r.Primary.Attributes
+
+		if a["id"] == "" {
+			return fmt.Errorf("Expected to get a services list ID from PagerDuty")
+		}
+
+		return nil
+	}
+}
+
+func testAccDataSourcePagerDutyServicesConfig(username, email, team, escalationPolicy, service1, service2, prefix string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_team" "test" {
+  name = "%s"
+}
+
+resource "pagerduty_escalation_policy" "test" {
+  name      = "%s"
+  num_loops = 2
+  teams     = [pagerduty_team.test.id]
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.test.id
+    }
+  }
+}
+
+resource "pagerduty_escalation_policy" "no_team" {
+  name      = "%[4]s-no-team"
+  num_loops = 2
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.test.id
+    }
+  }
+}
+
+resource "pagerduty_service" "one" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.test.id
+}
+
+resource "pagerduty_service" "two" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.no_team.id
+}
+
+data "pagerduty_services" "by_name" {
+  depends_on = [pagerduty_service.one, pagerduty_service.two]
+  name       = "%s"
+}
+
+data "pagerduty_services" "by_team" {
+  depends_on = [pagerduty_service.one, pagerduty_service.two]
+  name       = "%[7]s"
+  team_ids   = [pagerduty_team.test.id]
+}
+`, username, email, team, escalationPolicy, service1, service2, prefix)
+}
@@ -0,0 +1,141 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutySlackConnection() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutySlackConnectionRead,
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SLACK_CONNECTION_WORKSPACE_ID", nil),
+			},
+			"source_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"source_id", "channel_id", "channel_name"},
+			},
+			"channel_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"channel_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"source_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notification_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"events": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"priorities": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"urgency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutySlackConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).SlackClient()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty slack connection")
+
+	workspaceID := d.Get("workspace_id").(string)
+	sourceID := d.Get("source_id").(string)
+	channelID := d.Get("channel_id").(string)
+	channelName := d.Get("channel_name").(string)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.SlackConnections.List(workspaceID)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) || isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		var found []*pagerduty.SlackConnection
+
+		for _, conn := range resp.SlackConnections {
+			if (sourceID == "" || conn.SourceID == sourceID) &&
+				(channelID == "" || conn.ChannelID == channelID) &&
+				(channelName == "" || conn.ChannelName == channelName) {
+				found = append(found, conn)
+			}
+		}
+
+		if len(found) == 0 {
+			return resource.NonRetryableError(
+				fmt.Errorf("Unable to locate any slack connection in the workspace %s matching the given source_id, channel_id and channel_name", workspaceID),
+			)
+		}
+		if len(found) > 1 {
+			return resource.NonRetryableError(
+				fmt.Errorf("Found %d slack connections in the workspace %s matching the given source_id, channel_id and channel_name, set more of them to select only one", len(found), workspaceID),
+			)
+		}
+
+		conn := found[0]
+		d.SetId(conn.ID)
+		d.Set("workspace_id", workspaceID)
+		d.Set("source_id", conn.SourceID)
+		d.Set("source_name", conn.SourceName)
+		d.Set("source_type", conn.SourceType)
+		d.Set("channel_id", conn.ChannelID)
+		d.Set("channel_name", conn.ChannelName)
+		d.Set("notification_type", conn.NotificationType)
+		d.Set("config", flattenConnectionConfig(conn.Config))
+
+		return nil
+	})
+}
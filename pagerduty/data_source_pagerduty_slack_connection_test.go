@@ -0,0 +1,61 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutySlackConnection_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutySlackConnectionConfig(username, email, escalationPolicy, service, workspaceID, channelID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.pagerduty_slack_connection.by_source", "id", "pagerduty_slack_connection.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_slack_connection.by_source", "channel_name", "pagerduty_slack_connection.foo", "channel_name"),
+					resource.TestCheckResourceAttr("data.pagerduty_slack_connection.by_source", "notification_type", "responder"),
+					resource.TestCheckResourceAttr("data.pagerduty_slack_connection.by_source", "config.0.urgency", "high"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourcePagerDutySlackConnection_NotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "pagerduty_slack_connection" "foo" {
+  workspace_id = "%s"
+  source_id    = "PNOTFOUND"
+}
+`, workspaceID),
+				ExpectError: regexp.MustCompile("Unable to locate any slack connection"),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutySlackConnectionConfig(username, email, escalationPolicy, service, workspaceID, channelID string) string {
+	return testAccCheckPagerDutySlackConnectionConfig(username, email, escalationPolicy, service, workspaceID, channelID) + `
+data "pagerduty_slack_connection" "by_source" {
+  workspace_id = pagerduty_slack_connection.foo.workspace_id
+  source_id    = pagerduty_slack_connection.foo.source_id
+  channel_id   = pagerduty_slack_connection.foo.channel_id
+}
+`
+}
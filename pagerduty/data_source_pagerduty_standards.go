@@ -0,0 +1,149 @@
+package pagerduty
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyStandards() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyStandardsRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateValueFunc([]string{"technical_service"}),
+			},
+			"standards": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"exclusions": dataSourceStandardInclusionExclusionSchema(),
+						"inclusions": dataSourceStandardInclusionExclusionSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStandardInclusionExclusionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyStandardsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading PagerDuty standards")
+
+	o := &pagerduty.ListStandardsOptions{
+		ResourceType: d.Get("resource_type").(string),
+	}
+
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.Standards.ListContext(ctx, o)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		// Since this data doesn't have an unique ID, this force this data to be
+		// refreshed in every Terraform apply
+		d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+		d.Set("standards", flattenStandards(resp.Standards))
+
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenStandards(standards []*pagerduty.Standard) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, s := range standards {
+		result = append(result, map[string]interface{}{
+			"id":            s.ID,
+			"name":          s.Name,
+			"description":   s.Description,
+			"active":        s.Active,
+			"type":          s.Type,
+			"resource_type": s.ResourceType,
+			"exclusions":    flattenStandardInclusionsExclusions(s.Exclusions),
+			"inclusions":    flattenStandardInclusionsExclusions(s.Inclusions),
+		})
+	}
+
+	return result
+}
+
+func flattenStandardInclusionsExclusions(references []*pagerduty.StandardInclusionExclusion) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, r := range references {
+		result = append(result, map[string]interface{}{
+			"id":   r.ID,
+			"type": r.Type,
+		})
+	}
+
+	return result
+}
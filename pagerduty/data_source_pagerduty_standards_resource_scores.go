@@ -0,0 +1,175 @@
+package pagerduty
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyStandardsResourceScores() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyStandardsResourceScoresRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "technical_services",
+				ValidateFunc: validateValueFunc([]string{"technical_services"}),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"score": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"passing": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"total": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"standards": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"active": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"pass": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyStandardsResourceScoresRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceType := d.Get("resource_type").(string)
+	o := &pagerduty.ListResourceScoresOptions{
+		IDs: expandStringList(d.Get("ids").([]interface{})),
+	}
+
+	log.Printf("[INFO] Reading PagerDuty standards scores of %d %s", len(o.IDs), resourceType)
+
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.Standards.ListResourceScoresContext(ctx, resourceType, o)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		// Since this data doesn't have an unique ID, this force this data to be
+		// refreshed in every Terraform apply
+		d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+		d.Set("resources", flattenStandardsResourceScores(resp.Resources))
+
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenStandardsResourceScores(scores []*pagerduty.ResourceStandardScore) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, s := range scores {
+		var score []map[string]interface{}
+		if s.Score != nil {
+			score = append(score, map[string]interface{}{
+				"passing": s.Score.Passing,
+				"total":   s.Score.Total,
+			})
+		}
+
+		var standards []map[string]interface{}
+		for _, standard := range s.Standards {
+			standards = append(standards, map[string]interface{}{
+				"id":          standard.ID,
+				"name":        standard.Name,
+				"description": standard.Description,
+				"active":      standard.Active,
+				"type":        standard.Type,
+				"pass":        standard.Pass,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"resource_id":   s.ResourceID,
+			"resource_type": s.ResourceType,
+			"score":         score,
+			"standards":     standards,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,66 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyStandardsResourceScores_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyStandardsResourceScoresConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_standards_resource_scores.foo", "resources.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("data.pagerduty_standards_resource_scores.foo", "resources.*.resource_id", "pagerduty_service.foo", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.pagerduty_standards_resource_scores.foo", "resources.*.resource_id", "pagerduty_service.bar", "id"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_standards_resource_scores.foo", "resources.0.score.0.total"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyStandardsResourceScoresConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]s"
+  email = "%[1]s@foo.test"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%[1]s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[1]s-foo"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_service" "bar" {
+  name              = "%[1]s-bar"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+data "pagerduty_standards_resource_scores" "foo" {
+  ids = [pagerduty_service.foo.id, pagerduty_service.bar.id]
+}
+`, name)
+}
@@ -0,0 +1,30 @@
+package pagerduty
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyStandards_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyStandardsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_standards.services", "standards.0.id"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_standards.services", "standards.0.name"),
+					resource.TestCheckResourceAttr("data.pagerduty_standards.services", "standards.0.resource_type", "technical_service"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourcePagerDutyStandardsConfig = `
+data "pagerduty_standards" "services" {
+  resource_type = "technical_service"
+}
+`
@@ -0,0 +1,94 @@
+package pagerduty
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyStatusDashboard() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyStatusDashboardRead,
+
+		Schema: map[string]*schema.Schema{
+			"url_slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"business_services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyStatusDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	urlSlug := d.Get("url_slug").(string)
+
+	log.Printf("[INFO] Reading PagerDuty status dashboard %s", urlSlug)
+
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		dashboard, _, err := client.StatusDashboards.GetByURLSlugContext(ctx, urlSlug)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) || isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		businessServices, err := fetchStatusDashboardBusinessServices(ctx, client, dashboard.ID)
+		if err != nil {
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		d.SetId(dashboard.ID)
+		d.Set("url_slug", dashboard.URLSlug)
+		d.Set("name", dashboard.Name)
+		d.Set("business_services", businessServices)
+
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// fetchStatusDashboardBusinessServices returns the IDs of the business
+// services in the scope of the status dashboard.
+func fetchStatusDashboardBusinessServices(ctx context.Context, client *pagerduty.Client, id string) ([]string, error) {
+	resp, _, err := client.StatusDashboards.ListServiceImpactsContext(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	businessServices := []string{}
+	for _, s := range resp.Services {
+		businessServices = append(businessServices, s.ID)
+	}
+
+	return businessServices, nil
+}
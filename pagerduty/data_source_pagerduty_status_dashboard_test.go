@@ -0,0 +1,48 @@
+package pagerduty
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyStatusDashboard_Basic(t *testing.T) {
+	urlSlug := os.Getenv("PAGERDUTY_ACC_STATUS_DASHBOARD_URL_SLUG")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckStatusDashboards(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyStatusDashboardConfig(urlSlug),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_status_dashboard.test", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_status_dashboard.test", "url_slug", urlSlug),
+					resource.TestCheckResourceAttrSet("data.pagerduty_status_dashboard.test", "name"),
+					resource.TestCheckTypeSetElemAttrPair("data.pagerduty_status_dashboards.all", "status_dashboards.*.id", "data.pagerduty_status_dashboard.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckStatusDashboards(t *testing.T) {
+	if v := os.Getenv("PAGERDUTY_ACC_STATUS_DASHBOARD_URL_SLUG"); v == "" {
+		t.Skip("PAGERDUTY_ACC_STATUS_DASHBOARD_URL_SLUG not set. Skipping Status Dashboards-related test")
+	}
+}
+
+func testAccDataSourcePagerDutyStatusDashboardConfig(urlSlug string) string {
+	return fmt.Sprintf(`
+data "pagerduty_status_dashboard" "test" {
+  url_slug = "%s"
+}
+
+data "pagerduty_status_dashboards" "all" {}
+`, urlSlug)
+}
@@ -0,0 +1,98 @@
+package pagerduty
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePagerDutyStatusDashboards() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyStatusDashboardsRead,
+
+		Schema: map[string]*schema.Schema{
+			"status_dashboards": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url_slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"business_services": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyStatusDashboardsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading PagerDuty status dashboards")
+
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.StatusDashboards.ListContext(ctx)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		var dashboards []map[string]interface{}
+		for _, dashboard := range resp.StatusDashboards {
+			businessServices, err := fetchStatusDashboardBusinessServices(ctx, client, dashboard.ID)
+			if err != nil {
+				time.Sleep(30 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			dashboards = append(dashboards, map[string]interface{}{
+				"id":                dashboard.ID,
+				"name":              dashboard.Name,
+				"url_slug":          dashboard.URLSlug,
+				"business_services": businessServices,
+			})
+		}
+
+		// Since this data doesn't have an unique ID, this force this data to be
+		// refreshed in every Terraform apply
+		d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+		d.Set("status_dashboards", dashboards)
+
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyStatusPage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyStatusPageRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status_page_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validateValueFunc([]string{
+					pagerduty.StatusPageTypePublic,
+					pagerduty.StatusPageTypePrivate,
+				}),
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"published_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyStatusPageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading PagerDuty status page")
+
+	searchName := d.Get("name").(string)
+	o := &pagerduty.ListStatusPagesOptions{
+		StatusPageType: d.Get("status_page_type").(string),
+	}
+
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.StatusPages.ListContext(ctx, o)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		var found *pagerduty.StatusPage
+
+		for _, sp := range resp.StatusPages {
+			if sp.Name == searchName {
+				found = sp
+				break
+			}
+		}
+
+		if found == nil {
+			return resource.NonRetryableError(
+				fmt.Errorf("unable to locate any status page with name: %s", searchName),
+			)
+		}
+
+		d.SetId(found.ID)
+		d.Set("name", found.Name)
+		d.Set("status_page_type", found.StatusPageType)
+		d.Set("url", found.URL)
+		d.Set("published_at", found.PublishedAt)
+
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// statusPagePostTypeObjectsSchema returns the schema of the data sources
+// listing the impacts, severities or statuses of a status page, which all
+// describe a state of its posts.
+func statusPagePostTypeObjectsSchema(attr string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"status_page_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"post_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validateValueFunc([]string{
+				pagerduty.StatusPagePostTypeIncident,
+				pagerduty.StatusPagePostTypeMaintenance,
+			}),
+		},
+		attr: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"post_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenStatusPagePostTypeObject(id, description, postType string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          id,
+		"description": description,
+		"post_type":   postType,
+	}
+}
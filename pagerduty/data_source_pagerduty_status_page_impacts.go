@@ -0,0 +1,64 @@
+package pagerduty
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyStatusPageImpacts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyStatusPageImpactsRead,
+		Schema:      statusPagePostTypeObjectsSchema("impacts"),
+	}
+}
+
+func dataSourcePagerDutyStatusPageImpactsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusPageID := d.Get("status_page_id").(string)
+	o := &pagerduty.ListStatusPagePostTypeOptions{
+		PostType: d.Get("post_type").(string),
+	}
+
+	log.Printf("[INFO] Reading PagerDuty impacts of status page %s", statusPageID)
+
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.StatusPages.ListImpactsContext(ctx, statusPageID, o)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) || isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		var impacts []map[string]interface{}
+		for _, v := range resp.Impacts {
+			impacts = append(impacts, flattenStatusPagePostTypeObject(v.ID, v.Description, v.PostType))
+		}
+
+		// Since this data doesn't have an unique ID, this force this data to be
+		// refreshed in every Terraform apply
+		d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+		d.Set("impacts", impacts)
+
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package pagerduty
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyStatusPageImpacts_Basic(t *testing.T) {
+	statusPage := os.Getenv("PAGERDUTY_ACC_STATUS_PAGE_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckStatusPages(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyStatusPageImpactsConfig(statusPage),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_status_page_impacts.all", "impacts.0.id"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_status_page_impacts.maintenance", "impacts.0.id"),
+					resource.TestCheckResourceAttr("data.pagerduty_status_page_impacts.maintenance", "impacts.0.post_type", "maintenance"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyStatusPageImpactsConfig(statusPage string) string {
+	return fmt.Sprintf(`
+data "pagerduty_status_page" "test" {
+  name = "%s"
+}
+
+data "pagerduty_status_page_impacts" "all" {
+  status_page_id = data.pagerduty_status_page.test.id
+}
+
+data "pagerduty_status_page_impacts" "maintenance" {
+  status_page_id = data.pagerduty_status_page.test.id
+  post_type      = "maintenance"
+}
+`, statusPage)
+}
@@ -0,0 +1,87 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyStatusPageService() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyStatusPageServiceRead,
+
+		Schema: map[string]*schema.Schema{
+			"status_page_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"business_service": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyStatusPageServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusPageID := d.Get("status_page_id").(string)
+	searchName := d.Get("name").(string)
+
+	log.Printf("[INFO] Reading PagerDuty service %s of status page %s", searchName, statusPageID)
+
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.StatusPages.ListServicesContext(ctx, statusPageID)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) || isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		var found *pagerduty.StatusPageServiceObj
+
+		for _, s := range resp.Services {
+			if s.Name == searchName {
+				found = s
+				break
+			}
+		}
+
+		if found == nil {
+			return resource.NonRetryableError(
+				fmt.Errorf("unable to locate any service with name %s on status page %s", searchName, statusPageID),
+			)
+		}
+
+		d.SetId(found.ID)
+		d.Set("name", found.Name)
+		if found.BusinessService != nil {
+			d.Set("business_service", found.BusinessService.ID)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package pagerduty
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyStatusPageService_Missing(t *testing.T) {
+	statusPage := os.Getenv("PAGERDUTY_ACC_STATUS_PAGE_NAME")
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckStatusPages(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourcePagerDutyStatusPageServiceConfig(statusPage, name),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("unable to locate any service with name %s on status page", name)),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyStatusPageServiceConfig(statusPage, name string) string {
+	return fmt.Sprintf(`
+data "pagerduty_status_page" "test" {
+  name = "%s"
+}
+
+data "pagerduty_status_page_service" "test" {
+  status_page_id = data.pagerduty_status_page.test.id
+  name           = "%s"
+}
+`, statusPage, name)
+}
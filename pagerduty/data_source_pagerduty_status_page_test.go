@@ -0,0 +1,66 @@
+package pagerduty
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyStatusPage_Basic(t *testing.T) {
+	name := os.Getenv("PAGERDUTY_ACC_STATUS_PAGE_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckStatusPages(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyStatusPageConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_status_page.foo", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_status_page.foo", "name", name),
+					resource.TestCheckResourceAttrSet("data.pagerduty_status_page.foo", "status_page_type"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_status_page.foo", "url"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourcePagerDutyStatusPage_Missing(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckStatusPages(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourcePagerDutyStatusPageConfig(name),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("unable to locate any status page with name: %s", name)),
+			},
+		},
+	})
+}
+
+func testAccPreCheckStatusPages(t *testing.T) {
+	if v := os.Getenv("PAGERDUTY_ACC_STATUS_PAGE_NAME"); v == "" {
+		t.Skip("PAGERDUTY_ACC_STATUS_PAGE_NAME not set. Skipping Status Pages-related test")
+	}
+}
+
+func testAccDataSourcePagerDutyStatusPageConfig(name string) string {
+	return fmt.Sprintf(`
+data "pagerduty_status_page" "foo" {
+  name = "%s"
+}
+`, name)
+}
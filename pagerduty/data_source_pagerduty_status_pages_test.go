@@ -0,0 +1,44 @@
+package pagerduty
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyStatusPages_Basic(t *testing.T) {
+	statusPage := os.Getenv("PAGERDUTY_ACC_STATUS_PAGE_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckStatusPages(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyStatusPagesConfig(statusPage),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair("data.pagerduty_status_pages.all", "status_pages.*.id", "data.pagerduty_status_page.test", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.pagerduty_status_pages.same_type", "status_pages.*.url", "data.pagerduty_status_page.test", "url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyStatusPagesConfig(statusPage string) string {
+	return fmt.Sprintf(`
+data "pagerduty_status_page" "test" {
+  name = "%s"
+}
+
+data "pagerduty_status_pages" "all" {}
+
+data "pagerduty_status_pages" "same_type" {
+  status_page_type = data.pagerduty_status_page.test.status_page_type
+}
+`, statusPage)
+}
@@ -0,0 +1,48 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyTags_Basic(t *testing.T) {
+	tag := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyTagsConfig(tag),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_tags.by_query", "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("data.pagerduty_tags.by_query", "tags.*.id", "pagerduty_tag.foo", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.pagerduty_tags.by_query", "tags.*.id", "pagerduty_tag.bar", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pagerduty_tags.by_query", "tags.*", map[string]string{
+						"label": tag + "-foo",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyTagsConfig(tag string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_tag" "foo" {
+  label = "%[1]s-foo"
+}
+
+resource "pagerduty_tag" "bar" {
+  label = "%[1]s-bar"
+}
+
+data "pagerduty_tags" "by_query" {
+  query      = "%[1]s"
+  depends_on = [pagerduty_tag.foo, pagerduty_tag.bar]
+}
+`, tag)
+}
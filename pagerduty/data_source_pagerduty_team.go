@@ -28,6 +28,23 @@ func dataSourcePagerDutyTeam() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"escalation_policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The escalation policies owned by the team",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -70,10 +87,36 @@ func dataSourcePagerDutyTeamRead(d *schema.ResourceData, meta interface{}) error
 			)
 		}
 
+		// Teams have no default escalation policy in the API, the ones they
+		// own are exposed so modules creating services for a team can pick
+		// one without hardcoding its ID
+		escalationPolicies, err := client.EscalationPolicies.ListAll(&pagerduty.ListEscalationPoliciesOptions{
+			TeamIDs: []string{found.ID},
+			SortBy:  "name",
+		})
+		if err != nil {
+			// Client errors, e.g. a token without access to escalation
+			// policies, won't go away by retrying, only rate limits will
+			if isErrCode(err, 400) || isErrCode(err, 401) || isErrCode(err, 403) || isErrCode(err, 404) {
+				return resource.NonRetryableError(fmt.Errorf("Unable to list the escalation policies of team %s: %w", found.ID, err))
+			}
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		var policies []map[string]interface{}
+		for _, ep := range escalationPolicies {
+			policies = append(policies, map[string]interface{}{
+				"id":   ep.ID,
+				"name": ep.Name,
+			})
+		}
+
 		d.SetId(found.ID)
 		d.Set("name", found.Name)
 		d.Set("description", found.Description)
 		d.Set("parent", found.Parent)
+		d.Set("escalation_policies", policies)
 
 		return nil
 	})
@@ -2,6 +2,9 @@ package pagerduty
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -9,6 +12,29 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestDataSourcePagerDutyTeam_EscalationPoliciesForbidden(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/teams" {
+			w.Write([]byte(`{"teams":[{"id":"PTEAM01","name":"foo"}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error":{"code":2010,"message":"Access Denied"}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: server.URL, SkipCredsValidation: true}
+
+	d := dataSourcePagerDutyTeam().TestResourceData()
+	d.Set("name", "foo")
+
+	err := dataSourcePagerDutyTeamRead(d, config)
+	if err == nil || !strings.Contains(err.Error(), "Unable to list the escalation policies of team PTEAM01") {
+		t.Fatalf("expected the forbidden listing to fail without retrying, got %v", err)
+	}
+}
+
 func TestAccDataSourcePagerDutyTeam_Basic(t *testing.T) {
 	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	parent := fmt.Sprintf("tf-%s", acctest.RandString(5))
@@ -28,6 +54,28 @@ func TestAccDataSourcePagerDutyTeam_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePagerDutyTeam_EscalationPolicies(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyTeamEscalationPoliciesConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_team.by_name", "escalation_policies.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_team.by_name", "escalation_policies.0.id", "pagerduty_escalation_policy.test", "id"),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_team.by_name", "escalation_policies.0.name", name),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourcePagerDutyTeam(src, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -70,3 +118,35 @@ data "pagerduty_team" "by_name" {
 }
 `, parent, name, description)
 }
+
+func testAccDataSourcePagerDutyTeamEscalationPoliciesConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "test" {
+  name = "%[1]s"
+}
+
+resource "pagerduty_user" "test" {
+  name  = "%[1]s"
+  email = "%[1]s@foo.test"
+}
+
+resource "pagerduty_escalation_policy" "test" {
+  name  = "%[1]s"
+  teams = [pagerduty_team.test.id]
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.test.id
+    }
+  }
+}
+
+data "pagerduty_team" "by_name" {
+  name       = pagerduty_team.test.name
+  depends_on = [pagerduty_escalation_policy.test]
+}
+`, name)
+}
@@ -43,7 +43,16 @@ func dataSourcePagerDutyUserRead(d *schema.ResourceData, meta interface{}) error
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		resp, err := client.Users.ListAll(o)
+		if retry, scopeErr := narrowToTokenTeams(meta.(*Config), err, &o.TeamIDs); scopeErr != nil {
+			return resource.NonRetryableError(teamScopedTokenError("user", "email", searchEmail, scopeErr))
+		} else if retry {
+			resp, err = client.Users.ListAll(o)
+		}
 		if err != nil {
+			if isTeamScopedTokenError(err) {
+				return resource.NonRetryableError(teamScopedTokenError("user", "email", searchEmail, err))
+			}
+
 			// Delaying retry by 30s as recommended by PagerDuty
 			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
 			time.Sleep(30 * time.Second)
@@ -0,0 +1,504 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// buildEventOrchestrationCacheVariableResource builds the resource of the
+// cache variables of a global orchestration or of a service orchestration,
+// which only differ by the attribute holding the ID of their parent.
+func buildEventOrchestrationCacheVariableResource(cacheVariableType, parentAttr string) *schema.Resource {
+	resourceType := fmt.Sprintf("pagerduty_event_orchestration_%s_cache_variable", cacheVariableType)
+
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return resourcePagerDutyEventOrchestrationCacheVariableCreate(d, meta, cacheVariableType, parentAttr)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return resourcePagerDutyEventOrchestrationCacheVariableRead(d, meta, cacheVariableType, parentAttr)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return resourcePagerDutyEventOrchestrationCacheVariableUpdate(d, meta, cacheVariableType, parentAttr)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return resourcePagerDutyEventOrchestrationCacheVariableDelete(d, meta, cacheVariableType, parentAttr)
+		},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return resourcePagerDutyEventOrchestrationCacheVariableImport(d, meta, cacheVariableType, parentAttr, resourceType)
+			},
+		},
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			return validateEventOrchestrationCacheVariable(
+				diff.Get("configuration").([]interface{}),
+				len(diff.Get("condition").([]interface{})) > 0,
+				func(field string) bool { return diff.NewValueKnown("configuration.0." + field) },
+			)
+		},
+		Schema: map[string]*schema.Schema{
+			parentAttr: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"condition": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expression": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validatePCLExpression,
+						},
+					},
+				},
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validateValueFunc([]string{
+								"recent_value",
+								"trigger_event_count",
+								"external_data",
+							}),
+						},
+						"regex": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ttl_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"data_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validateValueFunc([]string{
+								"string",
+								"number",
+								"boolean",
+							}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateEventOrchestrationCacheVariable checks the configuration only sets
+// the fields used by its type, since the API silently drops the others.
+func validateEventOrchestrationCacheVariable(configuration []interface{}, hasConditions bool, known func(field string) bool) error {
+	if len(configuration) == 0 || configuration[0] == nil {
+		return nil
+	}
+	c := configuration[0].(map[string]interface{})
+	t := c["type"].(string)
+
+	required := map[string][]string{
+		"recent_value":        {"regex", "source"},
+		"trigger_event_count": {"ttl_seconds"},
+		"external_data":       {"data_type", "ttl_seconds"},
+	}
+	fields, ok := required[t]
+	if !ok {
+		// Unknown until apply, or rejected by the type validation
+		return nil
+	}
+
+	for _, f := range []string{"regex", "source", "ttl_seconds", "data_type"} {
+		// Values only known at apply time look unset during plan
+		if !known(f) {
+			continue
+		}
+		set := c[f] != "" && c[f] != 0
+		needed := false
+		for _, r := range fields {
+			needed = needed || r == f
+		}
+		if needed && !set {
+			return fmt.Errorf("configuration.%s is required for cache variables of type %s", f, t)
+		}
+		if !needed && set {
+			return fmt.Errorf("configuration.%s cannot be used with cache variables of type %s", f, t)
+		}
+	}
+
+	if t == "external_data" && hasConditions {
+		return fmt.Errorf("condition cannot be used with cache variables of type external_data, their value is set through the PagerDuty API")
+	}
+
+	return nil
+}
+
+func buildEventOrchestrationCacheVariableStruct(d *schema.ResourceData) *pagerduty.EventOrchestrationCacheVariable {
+	return &pagerduty.EventOrchestrationCacheVariable{
+		Name:          d.Get("name").(string),
+		Disabled:      d.Get("disabled").(bool),
+		Conditions:    expandEventOrchestrationCacheVariableConditions(d.Get("condition").([]interface{})),
+		Configuration: expandEventOrchestrationCacheVariableConfiguration(d.Get("configuration").([]interface{})),
+	}
+}
+
+func expandEventOrchestrationCacheVariableConditions(v []interface{}) []*pagerduty.EventOrchestrationCacheVariableCondition {
+	conditions := []*pagerduty.EventOrchestrationCacheVariableCondition{}
+
+	for _, cond := range v {
+		c := cond.(map[string]interface{})
+		conditions = append(conditions, &pagerduty.EventOrchestrationCacheVariableCondition{
+			Expression: c["expression"].(string),
+		})
+	}
+
+	return conditions
+}
+
+func expandEventOrchestrationCacheVariableConfiguration(v []interface{}) *pagerduty.EventOrchestrationCacheVariableConfiguration {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	c := v[0].(map[string]interface{})
+
+	return &pagerduty.EventOrchestrationCacheVariableConfiguration{
+		Type:       c["type"].(string),
+		Regex:      c["regex"].(string),
+		Source:     c["source"].(string),
+		TTLSeconds: c["ttl_seconds"].(int),
+		DataType:   c["data_type"].(string),
+	}
+}
+
+func flattenEventOrchestrationCacheVariableConditions(conditions []*pagerduty.EventOrchestrationCacheVariableCondition) []interface{} {
+	var flattened []interface{}
+
+	for _, c := range conditions {
+		flattened = append(flattened, map[string]interface{}{
+			"expression": c.Expression,
+		})
+	}
+
+	return flattened
+}
+
+func flattenEventOrchestrationCacheVariableConfiguration(c *pagerduty.EventOrchestrationCacheVariableConfiguration) []interface{} {
+	if c == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":        c.Type,
+			"regex":       c.Regex,
+			"source":      c.Source,
+			"ttl_seconds": c.TTLSeconds,
+			"data_type":   c.DataType,
+		},
+	}
+}
+
+func setEventOrchestrationCacheVariableProps(d *schema.ResourceData, cv *pagerduty.EventOrchestrationCacheVariable) {
+	d.Set("name", cv.Name)
+	d.Set("disabled", cv.Disabled)
+	d.Set("condition", flattenEventOrchestrationCacheVariableConditions(cv.Conditions))
+	d.Set("configuration", flattenEventOrchestrationCacheVariableConfiguration(cv.Configuration))
+}
+
+func resourcePagerDutyEventOrchestrationCacheVariableCreate(d *schema.ResourceData, meta interface{}, cacheVariableType, parentAttr string) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	oid := d.Get(parentAttr).(string)
+	payload := buildEventOrchestrationCacheVariableStruct(d)
+	var cacheVariable *pagerduty.EventOrchestrationCacheVariable
+
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		log.Printf("[INFO] Creating PagerDuty Event Orchestration %s cache variable %s on %s", cacheVariableType, payload.Name, oid)
+
+		cv, _, err := client.EventOrchestrationCacheVariables.Create(cacheVariableType, oid, payload)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+		cacheVariable = cv
+		return nil
+	})
+	if retryErr != nil {
+		return retryErr
+	}
+
+	d.SetId(cacheVariable.ID)
+	setEventOrchestrationCacheVariableProps(d, cacheVariable)
+
+	return nil
+}
+
+func resourcePagerDutyEventOrchestrationCacheVariableRead(d *schema.ResourceData, meta interface{}, cacheVariableType, parentAttr string) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	oid := d.Get(parentAttr).(string)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		log.Printf("[INFO] Reading PagerDuty Event Orchestration %s cache variable %s on %s", cacheVariableType, d.Id(), oid)
+
+		cacheVariable, _, err := client.EventOrchestrationCacheVariables.Get(cacheVariableType, oid, d.Id())
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		setEventOrchestrationCacheVariableProps(d, cacheVariable)
+
+		return nil
+	})
+}
+
+func resourcePagerDutyEventOrchestrationCacheVariableUpdate(d *schema.ResourceData, meta interface{}, cacheVariableType, parentAttr string) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	oid := d.Get(parentAttr).(string)
+	payload := buildEventOrchestrationCacheVariableStruct(d)
+
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		log.Printf("[INFO] Updating PagerDuty Event Orchestration %s cache variable %s on %s", cacheVariableType, d.Id(), oid)
+
+		cacheVariable, _, err := client.EventOrchestrationCacheVariables.Update(cacheVariableType, oid, d.Id(), payload)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+		setEventOrchestrationCacheVariableProps(d, cacheVariable)
+		return nil
+	})
+	if retryErr != nil {
+		return retryErr
+	}
+
+	return nil
+}
+
+func resourcePagerDutyEventOrchestrationCacheVariableDelete(d *schema.ResourceData, meta interface{}, cacheVariableType, parentAttr string) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	oid := d.Get(parentAttr).(string)
+
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		log.Printf("[INFO] Deleting PagerDuty Event Orchestration %s cache variable %s on %s", cacheVariableType, d.Id(), oid)
+
+		if _, err := client.EventOrchestrationCacheVariables.Delete(cacheVariableType, oid, d.Id()); err != nil {
+			if isErrCode(err, 404) {
+				return nil
+			}
+			if isErrCode(err, 400) {
+				return resource.NonRetryableError(err)
+			}
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+		return nil
+	})
+	if retryErr != nil {
+		return retryErr
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyEventOrchestrationCacheVariableImport(d *schema.ResourceData, meta interface{}, cacheVariableType, parentAttr, resourceType string) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	ids := strings.Split(d.Id(), ":")
+
+	if len(ids) == 1 {
+		resp, _, err := client.EventOrchestrationCacheVariables.List(cacheVariableType, ids[0])
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		var childIDs []string
+		for _, cv := range resp.CacheVariables {
+			childIDs = append(childIDs, fmt.Sprintf("%s:%s", ids[0], cv.ID))
+		}
+		return []*schema.ResourceData{}, importByParentIDError(resourceType, ids[0], childIDs)
+	}
+
+	if len(ids) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing %s. Expecting an importation ID formed as '<%s_id>:<cache_variable_id>'", resourceType, parentAttr)
+	}
+	oid, id := ids[0], ids[1]
+
+	if _, _, err := client.EventOrchestrationCacheVariables.Get(cacheVariableType, oid, id); err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(id)
+	d.Set(parentAttr, oid)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// buildEventOrchestrationCacheVariableDataSource builds the data source of the
+// cache variables of a global orchestration or of a service orchestration,
+// looking a cache variable up by its name.
+func buildEventOrchestrationCacheVariableDataSource(cacheVariableType, parentAttr string) *schema.Resource {
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return dataSourcePagerDutyEventOrchestrationCacheVariableRead(d, meta, cacheVariableType, parentAttr)
+		},
+		Schema: map[string]*schema.Schema{
+			parentAttr: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"condition": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expression": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"regex": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl_seconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"data_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyEventOrchestrationCacheVariableRead(d *schema.ResourceData, meta interface{}, cacheVariableType, parentAttr string) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	oid := d.Get(parentAttr).(string)
+	searchName := d.Get("name").(string)
+
+	log.Printf("[INFO] Reading PagerDuty Event Orchestration %s cache variable %s on %s", cacheVariableType, searchName, oid)
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.EventOrchestrationCacheVariables.List(cacheVariableType, oid)
+		if err != nil {
+			if isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		var found *pagerduty.EventOrchestrationCacheVariable
+		for _, cv := range resp.CacheVariables {
+			if cv.Name == searchName {
+				found = cv
+				break
+			}
+		}
+
+		if found == nil {
+			return resource.NonRetryableError(
+				fmt.Errorf("Unable to locate any %s cache variable with the name: %s on %s", cacheVariableType, searchName, oid),
+			)
+		}
+
+		d.SetId(found.ID)
+		setEventOrchestrationCacheVariableProps(d, found)
+
+		return nil
+	})
+}
@@ -3,15 +3,48 @@ package pagerduty
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 var eventOrchestrationPathConditionsSchema = map[string]*schema.Schema{
 	"expression": {
-		Type:     schema.TypeString,
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validatePCLExpression,
+	},
+}
+
+var eventOrchestrationPathActiveBetweenTimeRegex = regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d:[0-5]\d$`)
+
+var eventOrchestrationPathActiveBetweenSchema = map[string]*schema.Schema{
+	"days": {
+		Type:     schema.TypeList,
 		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validateValueFunc([]string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}),
+		},
+	},
+	"start_time": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringMatch(eventOrchestrationPathActiveBetweenTimeRegex, "must be a time of day formatted as HH:MM:SS"),
+	},
+	"end_time": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringMatch(eventOrchestrationPathActiveBetweenTimeRegex, "must be a time of day formatted as HH:MM:SS"),
+	},
+	"time_zone": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validateTimeZone,
 	},
 }
 
@@ -53,6 +86,17 @@ var eventOrchestrationPathExtractionsSchema = map[string]*schema.Schema{
 	},
 }
 
+var eventOrchestrationPathIncidentCustomFieldUpdatesSchema = map[string]*schema.Schema{
+	"id": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	"value": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+}
+
 func invalidExtractionRegexTemplateNilConfig() string {
 	return `
 		extraction {
@@ -159,6 +203,83 @@ func flattenEventOrchestrationPathConditions(conditions []*pagerduty.EventOrches
 	return flattendConditions
 }
 
+// A time window is sent as a PCL "now in" condition, on its own or ANDed
+// with each of the rule's conditions
+var (
+	eventOrchestrationPathWindowRegex    = regexp.MustCompile(`^\(now in (\S+) (\d{2}:\d{2}:\d{2}) to (\d{2}:\d{2}:\d{2}) (\S+)\)$`)
+	eventOrchestrationPathWindowAndRegex = regexp.MustCompile(`^\((.*)\) and (\(now in \S+ \d{2}:\d{2}:\d{2} to \d{2}:\d{2}:\d{2} \S+\))$`)
+)
+
+// expandEventOrchestrationPathActiveBetween restricts the conditions of a rule
+// to the time window configured in active_between, if any.
+func expandEventOrchestrationPathActiveBetween(conditions []*pagerduty.EventOrchestrationPathRuleCondition, v interface{}) []*pagerduty.EventOrchestrationPathRuleCondition {
+	var window string
+	for _, ab := range v.([]interface{}) {
+		a := ab.(map[string]interface{})
+		window = fmt.Sprintf("(now in %s %s to %s %s)",
+			strings.Join(expandStringList(a["days"].([]interface{})), ","),
+			a["start_time"].(string),
+			a["end_time"].(string),
+			a["time_zone"].(string),
+		)
+	}
+
+	if window == "" {
+		return conditions
+	}
+	if len(conditions) == 0 {
+		return []*pagerduty.EventOrchestrationPathRuleCondition{{Expression: window}}
+	}
+
+	for _, c := range conditions {
+		c.Expression = fmt.Sprintf("(%s) and %s", c.Expression, window)
+	}
+	return conditions
+}
+
+// flattenEventOrchestrationPathActiveBetween splits the time window shared by
+// all the conditions of a rule from them. Conditions are returned unchanged
+// when they don't all carry the same window.
+func flattenEventOrchestrationPathActiveBetween(conditions []*pagerduty.EventOrchestrationPathRuleCondition) ([]*pagerduty.EventOrchestrationPathRuleCondition, []interface{}) {
+	if len(conditions) == 0 {
+		return conditions, nil
+	}
+
+	var window string
+	var stripped []*pagerduty.EventOrchestrationPathRuleCondition
+	for _, c := range conditions {
+		w, expr := c.Expression, ""
+		if m := eventOrchestrationPathWindowAndRegex.FindStringSubmatch(c.Expression); m != nil {
+			w, expr = m[2], m[1]
+		} else if len(conditions) > 1 || !eventOrchestrationPathWindowRegex.MatchString(w) {
+			return conditions, nil
+		}
+
+		if window != "" && w != window {
+			return conditions, nil
+		}
+		window = w
+
+		if expr != "" {
+			stripped = append(stripped, &pagerduty.EventOrchestrationPathRuleCondition{Expression: expr})
+		}
+	}
+
+	m := eventOrchestrationPathWindowRegex.FindStringSubmatch(window)
+	if m == nil {
+		return conditions, nil
+	}
+
+	activeBetween := map[string]interface{}{
+		"days":       strings.Split(m[1], ","),
+		"start_time": m[2],
+		"end_time":   m[3],
+		"time_zone":  m[4],
+	}
+
+	return stripped, []interface{}{activeBetween}
+}
+
 func expandEventOrchestrationPathVariables(v interface{}) []*pagerduty.EventOrchestrationPathActionVariables {
 	res := []*pagerduty.EventOrchestrationPathActionVariables{}
 
@@ -209,6 +330,31 @@ func expandEventOrchestrationPathExtractions(v interface{}) []*pagerduty.EventOr
 	return res
 }
 
+func expandEventOrchestrationPathIncidentCustomFieldUpdates(v interface{}) []*pagerduty.EventOrchestrationPathIncidentCustomFieldUpdate {
+	res := []*pagerduty.EventOrchestrationPathIncidentCustomFieldUpdate{}
+
+	for _, i := range v.([]interface{}) {
+		u := i.(map[string]interface{})
+		res = append(res, &pagerduty.EventOrchestrationPathIncidentCustomFieldUpdate{
+			ID:    u["id"].(string),
+			Value: u["value"].(string),
+		})
+	}
+	return res
+}
+
+func flattenEventOrchestrationPathIncidentCustomFieldUpdates(v []*pagerduty.EventOrchestrationPathIncidentCustomFieldUpdate) []interface{} {
+	var res []interface{}
+
+	for _, u := range v {
+		res = append(res, map[string]interface{}{
+			"id":    u.ID,
+			"value": u.Value,
+		})
+	}
+	return res
+}
+
 func flattenEventOrchestrationPathExtractions(e []*pagerduty.EventOrchestrationPathActionExtractions) []interface{} {
 	var res []interface{}
 
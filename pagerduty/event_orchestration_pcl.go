@@ -0,0 +1,249 @@
+package pagerduty
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// The PagerDuty Condition Language (PCL) is only evaluated by the API, so typos
+// in conditions used to only fail at apply. pclParser checks the structure of
+// a condition at plan time: boolean operators, parentheses, quoting, and that
+// each path is followed by a known operator and a value. It deliberately
+// doesn't check paths or values, which PagerDuty keeps adding to.
+// https://developer.pagerduty.com/docs/ZG9jOjM1NTE0MDc0-pcl-overview
+
+type pclTokenKind int
+
+const (
+	pclWord pclTokenKind = iota
+	pclString
+	pclOpenParen
+	pclCloseParen
+	pclEOF
+)
+
+type pclToken struct {
+	kind  pclTokenKind
+	value string
+	pos   int
+}
+
+var pclComparisonOperators = map[string]bool{
+	"==": true,
+	"!=": true,
+	"<":  true,
+	"<=": true,
+	">":  true,
+	">=": true,
+}
+
+var pclKeywords = map[string]bool{
+	"and":     true,
+	"or":      true,
+	"not":     true,
+	"matches": true,
+	"exists":  true,
+	"in":      true,
+}
+
+func tokenizePCL(expression string) ([]pclToken, error) {
+	var tokens []pclToken
+	runes := []rune(expression)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, pclToken{pclOpenParen, "(", i})
+			i++
+		case r == ')':
+			tokens = append(tokens, pclToken{pclCloseParen, ")", i})
+			i++
+		case r == '\'' || r == '"':
+			start := i
+			i++
+			for i < len(runes) && runes[i] != r {
+				if runes[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("unterminated string starting at position %d", start)
+			}
+			i++
+			tokens = append(tokens, pclToken{pclString, string(runes[start:i]), start})
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && runes[i] != '(' && runes[i] != ')' {
+				// Brackets of paths may hold quoted keys, e.g. event.custom_details['a b']
+				if runes[i] == '[' {
+					for i < len(runes) && runes[i] != ']' {
+						i++
+					}
+					if i >= len(runes) {
+						return nil, fmt.Errorf("unterminated bracket starting at position %d", start)
+					}
+				}
+				i++
+			}
+			tokens = append(tokens, pclToken{pclWord, string(runes[start:i]), start})
+		}
+	}
+
+	return append(tokens, pclToken{pclEOF, "", len(runes)}), nil
+}
+
+type pclParser struct {
+	tokens []pclToken
+	pos    int
+}
+
+func (p *pclParser) peek() pclToken {
+	return p.tokens[p.pos]
+}
+
+func (p *pclParser) next() pclToken {
+	t := p.tokens[p.pos]
+	if t.kind != pclEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *pclParser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == pclWord && strings.ToLower(t.value) == keyword
+}
+
+func (t pclToken) String() string {
+	if t.kind == pclEOF {
+		return "end of condition"
+	}
+	return fmt.Sprintf("%q at position %d", t.value, t.pos)
+}
+
+func (p *pclParser) parseOr() error {
+	if err := p.parseAnd(); err != nil {
+		return err
+	}
+	for p.isKeyword("or") {
+		p.next()
+		if err := p.parseAnd(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *pclParser) parseAnd() error {
+	if err := p.parseUnary(); err != nil {
+		return err
+	}
+	for p.isKeyword("and") {
+		p.next()
+		if err := p.parseUnary(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *pclParser) parseUnary() error {
+	if p.isKeyword("not") {
+		p.next()
+		return p.parseUnary()
+	}
+
+	if p.peek().kind == pclOpenParen {
+		open := p.next()
+		if err := p.parseOr(); err != nil {
+			return err
+		}
+		if t := p.next(); t.kind != pclCloseParen {
+			return fmt.Errorf("expected ')' closing the parenthesis at position %d, found %s", open.pos, t)
+		}
+		return nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *pclParser) parseComparison() error {
+	path := p.next()
+	if path.kind != pclWord || pclKeywords[strings.ToLower(path.value)] {
+		return fmt.Errorf("expected a path such as event.summary, found %s", path)
+	}
+
+	// Time windows, e.g. now in Mon,Tue 09:00:00 to 17:00:00 America/New_York,
+	// have their own syntax and are only checked for not being empty
+	if strings.ToLower(path.value) == "now" && p.isKeyword("in") {
+		p.next()
+		n := 0
+		for t := p.peek(); t.kind == pclWord && !p.isKeyword("and") && !p.isKeyword("or"); t = p.peek() {
+			p.next()
+			n++
+		}
+		if n == 0 {
+			return fmt.Errorf("expected a time window after \"now in\", found %s", p.peek())
+		}
+		return nil
+	}
+
+	if p.isKeyword("not") {
+		p.next()
+	}
+
+	op := p.next()
+	operator := op.value
+	switch {
+	case op.kind == pclWord && strings.ToLower(op.value) == "exists":
+		return nil
+	case op.kind == pclWord && strings.ToLower(op.value) == "matches":
+		if p.isKeyword("part") || p.isKeyword("regex") {
+			operator = fmt.Sprintf("%s %s", operator, p.next().value)
+		}
+	case op.kind == pclWord && pclComparisonOperators[op.value]:
+	default:
+		return fmt.Errorf("expected an operator such as matches, matches part, exists or == after %q, found %s", path.value, op)
+	}
+
+	value := p.next()
+	if value.kind == pclString || (value.kind == pclWord && !pclKeywords[strings.ToLower(value.value)]) {
+		return nil
+	}
+	return fmt.Errorf("expected a value after %q, found %s", operator, value)
+}
+
+// validatePCLCondition returns an error describing the first problem found in
+// a PCL condition, if any.
+func validatePCLCondition(expression string) error {
+	if strings.TrimSpace(expression) == "" {
+		return fmt.Errorf("condition is empty")
+	}
+
+	tokens, err := tokenizePCL(expression)
+	if err != nil {
+		return err
+	}
+
+	p := &pclParser{tokens: tokens}
+	if err := p.parseOr(); err != nil {
+		return err
+	}
+	if t := p.peek(); t.kind != pclEOF {
+		return fmt.Errorf("expected and, or or the end of the condition, found %s", t)
+	}
+
+	return nil
+}
+
+func validatePCLExpression(v interface{}, key string) ([]string, []error) {
+	if err := validatePCLCondition(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: invalid PCL condition %q: %s", key, v.(string), err)}
+	}
+	return nil, nil
+}
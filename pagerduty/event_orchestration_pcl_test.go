@@ -0,0 +1,52 @@
+package pagerduty
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidatePCLCondition(t *testing.T) {
+	valid := []string{
+		"event.summary matches part 'heartbeat'",
+		"event.severity matches 'critical'",
+		"event.summary matches regex 'db-[0-9]+'",
+		"event.source exists",
+		"not event.custom_details.region exists",
+		"event.custom_details.count > 5 and event.custom_details.count <= 10",
+		"event.severity == 'critical' or (event.severity == 'error' and event.source matches part 'prod')",
+		"event.custom_details['host name'] matches 'db01'",
+		"raw_event.message matches part 'it\\'s down'",
+		"cache_var.num_triggers > 3",
+		"(event.severity matches 'critical') and (now in Mon,Tue,Wed,Thu,Fri 09:00:00 to 18:00:00 America/New_York)",
+		"event.summary not matches part 'test'",
+	}
+	for _, expression := range valid {
+		if err := validatePCLCondition(expression); err != nil {
+			t.Errorf("%q: unexpected error: %s", expression, err)
+		}
+	}
+
+	invalid := map[string]string{
+		"":                                        "condition is empty",
+		"event.summary machtes part 'heartbeat'":  `expected an operator such as matches, matches part, exists or == after "event.summary", found "machtes" at position 14`,
+		"event.summary matches part":              `expected a value after "matches part", found end of condition`,
+		"event.summary matches part 'heartbeat":   "unterminated string starting at position 27",
+		"(event.source exists":                    "expected ')' closing the parenthesis at position 0, found end of condition",
+		"event.source exists)":                    `expected and, or or the end of the condition, found ")" at position 19`,
+		"event.source exists and":                 "expected a path such as event.summary, found end of condition",
+		"event.source exists event.summary":       `expected and, or or the end of the condition, found "event.summary" at position 20`,
+		"event.severity == 'critical' or or":      `expected a path such as event.summary, found "or" at position 32`,
+		"now in":                                  `expected a time window after "now in", found end of condition`,
+		"event.custom_details['host name matches": "unterminated bracket starting at position 0",
+	}
+	for expression, expected := range invalid {
+		err := validatePCLCondition(expression)
+		if err == nil {
+			t.Errorf("%q: expected error %q", expression, expected)
+			continue
+		}
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("%q: expected error %q, got %q", expression, expected, err)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyEventOrchestrationIntegration_import(t *testing.T) {
+	team := fmt.Sprintf("tf-name-%s", acctest.RandString(5))
+	orchestration := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationIntegrationConfig(team, orchestration, "first", "Datadog"),
+			},
+			{
+				ResourceName:      "pagerduty_event_orchestration_integration.int",
+				ImportStateIdFunc: testAccCheckPagerDutyEventOrchestrationIntegrationID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationIntegrationID(s *terraform.State) (string, error) {
+	rs := s.RootModule().Resources["pagerduty_event_orchestration_integration.int"]
+	return fmt.Sprintf("%s:%s", rs.Primary.Attributes["event_orchestration"], rs.Primary.ID), nil
+}
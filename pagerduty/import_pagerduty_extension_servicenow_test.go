@@ -26,6 +26,8 @@ func TestAccPagerDutyExtensionServiceNow_import(t *testing.T) {
 				ResourceName:      "pagerduty_extension_servicenow.foo",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// The password is never read back from PagerDuty
+				ImportStateVerifyIgnore: []string{"snow_password"},
 			},
 		},
 	})
@@ -0,0 +1,39 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyIncidentCustomFieldOption_import(t *testing.T) {
+	fieldName := fmt.Sprintf("tf_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckIncidentCustomFields(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPagerDutyIncidentCustomFieldOptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentCustomFieldOptionConfig(fieldName, "us-east"),
+			},
+
+			{
+				ResourceName:      "pagerduty_incident_custom_field_option.test",
+				ImportStateIdFunc: testAccCheckPagerDutyIncidentCustomFieldOptionID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentCustomFieldOptionID(s *terraform.State) (string, error) {
+	return fmt.Sprintf("%v:%v", s.RootModule().Resources["pagerduty_incident_custom_field.test"].Primary.ID, s.RootModule().Resources["pagerduty_incident_custom_field_option.test"].Primary.ID), nil
+}
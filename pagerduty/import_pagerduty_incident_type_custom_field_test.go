@@ -0,0 +1,39 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyIncidentTypeCustomField_import(t *testing.T) {
+	typeName := fmt.Sprintf("tf_%s", acctest.RandString(5))
+	fieldName := fmt.Sprintf("tf_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPagerDutyIncidentTypeCustomFieldDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentTypeCustomFieldConfig(typeName, fieldName, "Region", `["us-east", "eu-west"]`, `jsonencode("us-east")`, true),
+			},
+
+			{
+				ResourceName:      "pagerduty_incident_type_custom_field.test",
+				ImportStateIdFunc: testAccCheckPagerDutyIncidentTypeCustomFieldID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentTypeCustomFieldID(s *terraform.State) (string, error) {
+	return fmt.Sprintf("%v:%v", s.RootModule().Resources["pagerduty_incident_type.test"].Primary.ID, s.RootModule().Resources["pagerduty_incident_type_custom_field.test"].Primary.ID), nil
+}
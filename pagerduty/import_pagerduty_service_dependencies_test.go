@@ -0,0 +1,39 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyServiceDependencies_import(t *testing.T) {
+	businessService := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDependenciesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceDependenciesConfig(businessService, service, username, email, escalationPolicy, "pagerduty_service.one", "pagerduty_service.two"),
+			},
+			{
+				ResourceName:      "pagerduty_service_dependencies.foo",
+				ImportStateIdFunc: testAccCheckPagerDutyServiceDependenciesID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyServiceDependenciesID(s *terraform.State) (string, error) {
+	return fmt.Sprintf("%v.business_service", s.RootModule().Resources["pagerduty_business_service.foo"].Primary.ID), nil
+}
@@ -2,6 +2,9 @@ package pagerduty
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -9,6 +12,33 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestPagerDutyServiceIntegration_importByParentID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"service":{"id":"PSERV01","integrations":[{"id":"PINT001","type":"generic_events_api_inbound_integration_reference"},{"id":"PINT002","type":"generic_email_inbound_integration_reference"}]}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: server.URL, SkipCredsValidation: true}
+
+	r := resourcePagerDutyServiceIntegration()
+	d := r.TestResourceData()
+	d.SetId("PSERV01")
+
+	_, err := r.Importer.State(d, config)
+	if err == nil {
+		t.Fatal("expected importing by parent ID to fail")
+	}
+	for _, want := range []string{
+		"terraform import pagerduty_service_integration.<name_1> PSERV01.PINT001",
+		"terraform import pagerduty_service_integration.<name_2> PSERV01.PINT002",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error %q to contain %q", err, want)
+		}
+	}
+}
+
 func TestAccPagerDutyServiceIntegration_import(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -0,0 +1,45 @@
+package pagerduty
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyStatusPagePost_import(t *testing.T) {
+	statusPage := os.Getenv("PAGERDUTY_ACC_STATUS_PAGE_NAME")
+	title := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	startsAt := timeNowInAccLoc().Add(24 * time.Hour).Truncate(time.Minute).Format(time.RFC3339)
+	endsAt := timeNowInAccLoc().Add(26 * time.Hour).Truncate(time.Minute).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckStatusPages(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPagerDutyStatusPagePostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyStatusPagePostConfig(statusPage, title, startsAt, endsAt),
+			},
+
+			{
+				ResourceName:      "pagerduty_status_page_post.test",
+				ImportStateIdFunc: testAccCheckPagerDutyStatusPagePostID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyStatusPagePostID(s *terraform.State) (string, error) {
+	rs := s.RootModule().Resources["pagerduty_status_page_post.test"]
+	return fmt.Sprintf("%v:%v", rs.Primary.Attributes["status_page_id"], rs.Primary.ID), nil
+}
@@ -0,0 +1,42 @@
+package pagerduty
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyStatusPageSubscription_import(t *testing.T) {
+	statusPage := os.Getenv("PAGERDUTY_ACC_STATUS_PAGE_NAME")
+	contact := fmt.Sprintf("tf-%s@foo.test", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckStatusPages(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPagerDutyStatusPageSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyStatusPageSubscriptionConfig(statusPage, contact),
+			},
+
+			{
+				ResourceName:      "pagerduty_status_page_subscription.test",
+				ImportStateIdFunc: testAccCheckPagerDutyStatusPageSubscriptionID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyStatusPageSubscriptionID(s *terraform.State) (string, error) {
+	rs := s.RootModule().Resources["pagerduty_status_page_subscription.test"]
+	return fmt.Sprintf("%v:%v", rs.Primary.Attributes["status_page_id"], rs.Primary.ID), nil
+}
@@ -2,6 +2,9 @@ package pagerduty
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -9,6 +12,33 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestPagerDutyUserContactMethod_importByParentID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"contact_methods":[{"id":"PCM0001","type":"email_contact_method"},{"id":"PCM0002","type":"phone_contact_method"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: server.URL, SkipCredsValidation: true}
+
+	r := resourcePagerDutyUserContactMethod()
+	d := r.TestResourceData()
+	d.SetId("PUSER01")
+
+	_, err := r.Importer.State(d, config)
+	if err == nil {
+		t.Fatal("expected importing by parent ID to fail")
+	}
+	for _, want := range []string{
+		"terraform import pagerduty_user_contact_method.<name_1> PUSER01:PCM0001",
+		"terraform import pagerduty_user_contact_method.<name_2> PUSER01:PCM0002",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error %q to contain %q", err, want)
+		}
+	}
+}
+
 func TestAccPagerDutyUserContactMethod_import(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
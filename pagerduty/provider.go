@@ -1,11 +1,16 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"runtime"
 	"strings"
+	"sync/atomic"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
@@ -43,67 +48,143 @@ func Provider() *schema.Provider {
 				Optional: true,
 				Default:  "",
 			},
+
+			"allowed_email_domains": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"service_data_source_exact_match": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"pagerduty_escalation_policy":         dataSourcePagerDutyEscalationPolicy(),
-			"pagerduty_schedule":                  dataSourcePagerDutySchedule(),
-			"pagerduty_user":                      dataSourcePagerDutyUser(),
-			"pagerduty_users":                     dataSourcePagerDutyUsers(),
-			"pagerduty_user_contact_method":       dataSourcePagerDutyUserContactMethod(),
-			"pagerduty_team":                      dataSourcePagerDutyTeam(),
-			"pagerduty_vendor":                    dataSourcePagerDutyVendor(),
-			"pagerduty_extension_schema":          dataSourcePagerDutyExtensionSchema(),
-			"pagerduty_service":                   dataSourcePagerDutyService(),
-			"pagerduty_service_integration":       dataSourcePagerDutyServiceIntegration(),
-			"pagerduty_business_service":          dataSourcePagerDutyBusinessService(),
-			"pagerduty_priority":                  dataSourcePagerDutyPriority(),
-			"pagerduty_ruleset":                   dataSourcePagerDutyRuleset(),
-			"pagerduty_tag":                       dataSourcePagerDutyTag(),
-			"pagerduty_event_orchestration":       dataSourcePagerDutyEventOrchestration(),
-			"pagerduty_automation_actions_runner": dataSourcePagerDutyAutomationActionsRunner(),
-			"pagerduty_automation_actions_action": dataSourcePagerDutyAutomationActionsAction(),
-			"pagerduty_incident_workflow":         dataSourcePagerDutyIncidentWorkflow(),
+			"pagerduty_escalation_policy":                          dataSourcePagerDutyEscalationPolicy(),
+			"pagerduty_schedule":                                   dataSourcePagerDutySchedule(),
+			"pagerduty_schedule_overrides":                         dataSourcePagerDutyScheduleOverrides(),
+			"pagerduty_user":                                       dataSourcePagerDutyUser(),
+			"pagerduty_users":                                      dataSourcePagerDutyUsers(),
+			"pagerduty_user_contact_method":                        dataSourcePagerDutyUserContactMethod(),
+			"pagerduty_team":                                       dataSourcePagerDutyTeam(),
+			"pagerduty_vendor":                                     dataSourcePagerDutyVendor(),
+			"pagerduty_extension_schema":                           dataSourcePagerDutyExtensionSchema(),
+			"pagerduty_extension_schemas":                          dataSourcePagerDutyExtensionSchemas(),
+			"pagerduty_service":                                    dataSourcePagerDutyService(),
+			"pagerduty_service_dependencies":                       dataSourcePagerDutyServiceDependencies(),
+			"pagerduty_service_integration":                        dataSourcePagerDutyServiceIntegration(),
+			"pagerduty_service_integrations":                       dataSourcePagerDutyServiceIntegrations(),
+			"pagerduty_standards":                                  dataSourcePagerDutyStandards(),
+			"pagerduty_standards_resource_scores":                  dataSourcePagerDutyStandardsResourceScores(),
+			"pagerduty_services":                                   dataSourcePagerDutyServices(),
+			"pagerduty_abilities":                                  dataSourcePagerDutyAbilities(),
+			"pagerduty_business_service":                           dataSourcePagerDutyBusinessService(),
+			"pagerduty_slack_connection":                           dataSourcePagerDutySlackConnection(),
+			"pagerduty_business_services":                          dataSourcePagerDutyBusinessServices(),
+			"pagerduty_priority":                                   dataSourcePagerDutyPriority(),
+			"pagerduty_priorities":                                 dataSourcePagerDutyPriorities(),
+			"pagerduty_ruleset":                                    dataSourcePagerDutyRuleset(),
+			"pagerduty_tag":                                        dataSourcePagerDutyTag(),
+			"pagerduty_tags":                                       dataSourcePagerDutyTags(),
+			"pagerduty_event_orchestration":                        dataSourcePagerDutyEventOrchestration(),
+			"pagerduty_event_orchestration_global_cache_variable":  dataSourcePagerDutyEventOrchestrationGlobalCacheVariable(),
+			"pagerduty_event_orchestration_service_cache_variable": dataSourcePagerDutyEventOrchestrationServiceCacheVariable(),
+			"pagerduty_event_orchestrations":                       dataSourcePagerDutyEventOrchestrations(),
+			"pagerduty_event_orchestration_path_raw":               dataSourcePagerDutyEventOrchestrationPathRaw(),
+			"pagerduty_event_orchestration_ruleset_migration":      dataSourcePagerDutyEventOrchestrationRulesetMigration(),
+			"pagerduty_automation_actions_runner":                  dataSourcePagerDutyAutomationActionsRunner(),
+			"pagerduty_automation_actions_runners":                 dataSourcePagerDutyAutomationActionsRunners(),
+			"pagerduty_automation_actions_action":                  dataSourcePagerDutyAutomationActionsAction(),
+			"pagerduty_incident_custom_field":                      dataSourcePagerDutyIncidentCustomField(),
+			"pagerduty_incident_type":                              dataSourcePagerDutyIncidentType(),
+			"pagerduty_incident_workflow":                          dataSourcePagerDutyIncidentWorkflow(),
+			"pagerduty_status_page":                                dataSourcePagerDutyStatusPage(),
+			"pagerduty_status_pages":                               dataSourcePagerDutyStatusPages(),
+			"pagerduty_status_page_service":                        dataSourcePagerDutyStatusPageService(),
+			"pagerduty_status_page_impacts":                        dataSourcePagerDutyStatusPageImpacts(),
+			"pagerduty_status_page_severities":                     dataSourcePagerDutyStatusPageSeverities(),
+			"pagerduty_status_page_statuses":                       dataSourcePagerDutyStatusPageStatuses(),
+			"pagerduty_status_dashboard":                           dataSourcePagerDutyStatusDashboard(),
+			"pagerduty_status_dashboards":                          dataSourcePagerDutyStatusDashboards(),
+			"pagerduty_jira_cloud_account_mapping":                 dataSourcePagerDutyJiraCloudAccountMapping(),
+			"pagerduty_maintenance_windows":                        dataSourcePagerDutyMaintenanceWindows(),
+			"pagerduty_licenses":                                   dataSourcePagerDutyLicenses(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"pagerduty_addon":                                      resourcePagerDutyAddon(),
-			"pagerduty_escalation_policy":                          resourcePagerDutyEscalationPolicy(),
-			"pagerduty_maintenance_window":                         resourcePagerDutyMaintenanceWindow(),
-			"pagerduty_schedule":                                   resourcePagerDutySchedule(),
-			"pagerduty_service":                                    resourcePagerDutyService(),
-			"pagerduty_service_integration":                        resourcePagerDutyServiceIntegration(),
-			"pagerduty_team":                                       resourcePagerDutyTeam(),
-			"pagerduty_team_membership":                            resourcePagerDutyTeamMembership(),
-			"pagerduty_user":                                       resourcePagerDutyUser(),
-			"pagerduty_user_contact_method":                        resourcePagerDutyUserContactMethod(),
-			"pagerduty_user_notification_rule":                     resourcePagerDutyUserNotificationRule(),
-			"pagerduty_extension":                                  resourcePagerDutyExtension(),
-			"pagerduty_extension_servicenow":                       resourcePagerDutyExtensionServiceNow(),
-			"pagerduty_event_rule":                                 resourcePagerDutyEventRule(),
-			"pagerduty_ruleset":                                    resourcePagerDutyRuleset(),
-			"pagerduty_ruleset_rule":                               resourcePagerDutyRulesetRule(),
-			"pagerduty_business_service":                           resourcePagerDutyBusinessService(),
-			"pagerduty_service_dependency":                         resourcePagerDutyServiceDependency(),
-			"pagerduty_response_play":                              resourcePagerDutyResponsePlay(),
-			"pagerduty_tag":                                        resourcePagerDutyTag(),
-			"pagerduty_tag_assignment":                             resourcePagerDutyTagAssignment(),
-			"pagerduty_service_event_rule":                         resourcePagerDutyServiceEventRule(),
-			"pagerduty_slack_connection":                           resourcePagerDutySlackConnection(),
-			"pagerduty_business_service_subscriber":                resourcePagerDutyBusinessServiceSubscriber(),
-			"pagerduty_webhook_subscription":                       resourcePagerDutyWebhookSubscription(),
-			"pagerduty_event_orchestration":                        resourcePagerDutyEventOrchestration(),
-			"pagerduty_event_orchestration_router":                 resourcePagerDutyEventOrchestrationPathRouter(),
-			"pagerduty_event_orchestration_unrouted":               resourcePagerDutyEventOrchestrationPathUnrouted(),
-			"pagerduty_event_orchestration_service":                resourcePagerDutyEventOrchestrationPathService(),
-			"pagerduty_automation_actions_runner":                  resourcePagerDutyAutomationActionsRunner(),
-			"pagerduty_automation_actions_action":                  resourcePagerDutyAutomationActionsAction(),
-			"pagerduty_automation_actions_action_team_association": resourcePagerDutyAutomationActionsActionTeamAssociation(),
-			"pagerduty_incident_workflow":                          resourcePagerDutyIncidentWorkflow(),
-			"pagerduty_incident_workflow_trigger":                  resourcePagerDutyIncidentWorkflowTrigger(),
+			"pagerduty_addon":                                         resourcePagerDutyAddon(),
+			"pagerduty_escalation_policy":                             resourcePagerDutyEscalationPolicy(),
+			"pagerduty_maintenance_window":                            resourcePagerDutyMaintenanceWindow(),
+			"pagerduty_schedule":                                      resourcePagerDutySchedule(),
+			"pagerduty_schedule_v2":                                   resourcePagerDutyScheduleV2(),
+			"pagerduty_service":                                       resourcePagerDutyService(),
+			"pagerduty_service_integration":                           resourcePagerDutyServiceIntegration(),
+			"pagerduty_team":                                          resourcePagerDutyTeam(),
+			"pagerduty_team_membership":                               resourcePagerDutyTeamMembership(),
+			"pagerduty_user":                                          resourcePagerDutyUser(),
+			"pagerduty_user_contact_method":                           resourcePagerDutyUserContactMethod(),
+			"pagerduty_user_notification_rule":                        resourcePagerDutyUserNotificationRule(),
+			"pagerduty_extension":                                     resourcePagerDutyExtension(),
+			"pagerduty_extension_webhook_migration":                   resourcePagerDutyExtensionWebhookMigration(),
+			"pagerduty_extension_servicenow":                          resourcePagerDutyExtensionServiceNow(),
+			"pagerduty_event_rule":                                    resourcePagerDutyEventRule(),
+			"pagerduty_ruleset":                                       resourcePagerDutyRuleset(),
+			"pagerduty_ruleset_rule":                                  resourcePagerDutyRulesetRule(),
+			"pagerduty_business_service":                              resourcePagerDutyBusinessService(),
+			"pagerduty_service_dependency":                            resourcePagerDutyServiceDependency(),
+			"pagerduty_service_dependencies":                          resourcePagerDutyServiceDependencies(),
+			"pagerduty_response_play":                                 resourcePagerDutyResponsePlay(),
+			"pagerduty_tag":                                           resourcePagerDutyTag(),
+			"pagerduty_tag_assignment":                                resourcePagerDutyTagAssignment(),
+			"pagerduty_service_event_rule":                            resourcePagerDutyServiceEventRule(),
+			"pagerduty_service_event_rule_order":                      resourcePagerDutyServiceEventRuleOrder(),
+			"pagerduty_slack_connection":                              resourcePagerDutySlackConnection(),
+			"pagerduty_business_service_subscriber":                   resourcePagerDutyBusinessServiceSubscriber(),
+			"pagerduty_webhook_subscription":                          resourcePagerDutyWebhookSubscription(),
+			"pagerduty_event_orchestration":                           resourcePagerDutyEventOrchestration(),
+			"pagerduty_event_orchestration_integration":               resourcePagerDutyEventOrchestrationIntegration(),
+			"pagerduty_event_orchestration_path_raw":                  resourcePagerDutyEventOrchestrationPathRaw(),
+			"pagerduty_event_orchestration_router":                    resourcePagerDutyEventOrchestrationPathRouter(),
+			"pagerduty_event_orchestration_unrouted":                  resourcePagerDutyEventOrchestrationPathUnrouted(),
+			"pagerduty_event_orchestration_global":                    resourcePagerDutyEventOrchestrationPathGlobal(),
+			"pagerduty_event_orchestration_global_cache_variable":     resourcePagerDutyEventOrchestrationGlobalCacheVariable(),
+			"pagerduty_event_orchestration_global_set":                resourcePagerDutyEventOrchestrationGlobalSet(),
+			"pagerduty_event_orchestration_service":                   resourcePagerDutyEventOrchestrationPathService(),
+			"pagerduty_event_orchestration_service_cache_variable":    resourcePagerDutyEventOrchestrationServiceCacheVariable(),
+			"pagerduty_automation_actions_runner":                     resourcePagerDutyAutomationActionsRunner(),
+			"pagerduty_automation_actions_action":                     resourcePagerDutyAutomationActionsAction(),
+			"pagerduty_automation_actions_action_team_association":    resourcePagerDutyAutomationActionsActionTeamAssociation(),
+			"pagerduty_automation_actions_action_service_association": resourcePagerDutyAutomationActionsActionServiceAssociation(),
+			"pagerduty_automation_actions_runner_team_association":    resourcePagerDutyAutomationActionsRunnerTeamAssociation(),
+			"pagerduty_incident_custom_field":                         resourcePagerDutyIncidentCustomField(),
+			"pagerduty_incident_custom_field_option":                  resourcePagerDutyIncidentCustomFieldOption(),
+			"pagerduty_incident_type":                                 resourcePagerDutyIncidentType(),
+			"pagerduty_incident_type_custom_field":                    resourcePagerDutyIncidentTypeCustomField(),
+			"pagerduty_incident_workflow":                             resourcePagerDutyIncidentWorkflow(),
+			"pagerduty_incident_workflow_trigger":                     resourcePagerDutyIncidentWorkflowTrigger(),
+			"pagerduty_status_page_post":                              resourcePagerDutyStatusPagePost(),
+			"pagerduty_status_page_post_update":                       resourcePagerDutyStatusPagePostUpdate(),
+			"pagerduty_status_page_subscription":                      resourcePagerDutyStatusPageSubscription(),
+			"pagerduty_jira_cloud_account_mapping_rule":               resourcePagerDutyJiraCloudAccountMappingRule(),
+			"pagerduty_standard":                                      resourcePagerDutyStandard(),
+			"pagerduty_incident":                                      resourcePagerDutyIncident(),
 		},
 	}
 
+	for name, r := range p.ResourcesMap {
+		instrumentResource(name, r)
+		wrapResourceErrors(name, r)
+	}
+	for name, r := range p.DataSourcesMap {
+		instrumentResource(name, r)
+		wrapResourceErrors(name, r)
+	}
+
 	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := p.TerraformVersion
 		if terraformVersion == "" {
@@ -125,6 +206,149 @@ func isErrCode(err error, code int) bool {
 	return false
 }
 
+// isTeamScopedTokenError reports whether err is the way account-level
+// listings fail when the token is scoped to some teams only: a 403 with the
+// Access Denied error code, as opposed to e.g. a missing ability.
+func isTeamScopedTokenError(err error) bool {
+	e, ok := err.(*pagerduty.Error)
+	return ok && isErrCode(err, 403) && e.Code == 2010
+}
+
+// teamScopedTokenError explains why a name-based lookup can't be done with a
+// team-scoped token.
+func teamScopedTokenError(kind, attr, value string, err error) error {
+	return fmt.Errorf("Unable to locate any %s with the %s %s: listing %ss is forbidden for the configured token, which appears to be team-scoped (%s). Use an account-level token, or reference the %s by ID", kind, attr, value, kind, err, kind)
+}
+
+// narrowToTokenTeams sets teamIDs to the teams of the token when err shows an
+// account-level listing was forbidden, and reports whether the listing should
+// be made again with them. Listings already narrowed to teams aren't retried.
+func narrowToTokenTeams(config *Config, err error, teamIDs *[]string) (bool, error) {
+	if !isTeamScopedTokenError(err) || len(*teamIDs) > 0 {
+		return false, nil
+	}
+
+	ids, scopeErr := config.tokenTeamIDs()
+	if scopeErr != nil {
+		return false, scopeErr
+	}
+
+	*teamIDs = ids
+	return true, nil
+}
+
+// wrapResourceErrors decorates the errors returned by the CRUD functions of a
+// resource with its type and ID, so failed applies can be traced back to the
+// object and, through the API error's request ID, to PagerDuty support.
+func wrapResourceErrors(name string, r *schema.Resource) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			if err := f(d, meta); err != nil {
+				return resourceError(name, d, err)
+			}
+			return nil
+		}
+	}
+	wrapContext := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			diags := f(ctx, d, meta)
+			for i := range diags {
+				if diags[i].Severity == diag.Error {
+					diags[i].Summary = resourceErrorPrefix(name, d) + diags[i].Summary
+				}
+			}
+			return diags
+		}
+	}
+
+	r.Create = wrap(r.Create)
+	r.Read = wrap(r.Read)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+	r.CreateContext = wrapContext(r.CreateContext)
+	r.ReadContext = wrapContext(r.ReadContext)
+	r.UpdateContext = wrapContext(r.UpdateContext)
+	r.DeleteContext = wrapContext(r.DeleteContext)
+}
+
+// instrumentResource logs the elapsed time and the number of API calls of
+// each CRUD function of a resource when TF_LOG is set to TRACE, to find the
+// resources dominating apply time in large workspaces.
+func instrumentResource(name string, r *schema.Resource) {
+	wrap := func(op string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			done := instrumentOperation(name, op, d, nil)
+			err := f(d, meta)
+			done()
+			return err
+		}
+	}
+	wrapContext := func(op string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			var calls int64
+			done := instrumentOperation(name, op, d, &calls)
+			diags := f(withAPICallCounter(ctx, &calls), d, meta)
+			done()
+			return diags
+		}
+	}
+
+	r.Create = wrap("create", r.Create)
+	r.Read = wrap("read", r.Read)
+	r.Update = wrap("update", r.Update)
+	r.Delete = wrap("delete", r.Delete)
+	r.CreateContext = wrapContext("create", r.CreateContext)
+	r.ReadContext = wrapContext("read", r.ReadContext)
+	r.UpdateContext = wrapContext("update", r.UpdateContext)
+	r.DeleteContext = wrapContext("delete", r.DeleteContext)
+}
+
+// instrumentOperation returns a function logging the timing of an operation
+// once it's done. The provider's client is shared by concurrent operations, so
+// API calls are counted through the operation's context, into calls. They are
+// only logged when calls is set, as operations without a context can't be told
+// apart.
+func instrumentOperation(name, op string, d *schema.ResourceData, calls *int64) func() {
+	if logging.LogLevel() != "TRACE" {
+		return func() {}
+	}
+
+	start := time.Now()
+
+	return func() {
+		prefix := strings.TrimSuffix(resourceErrorPrefix(name, d), ": ")
+		elapsed := time.Since(start).Round(time.Millisecond)
+		if calls == nil {
+			log.Printf("[TRACE] %s: %s took %s", prefix, op, elapsed)
+			return
+		}
+		log.Printf("[TRACE] %s: %s took %s with %d API calls", prefix, op, elapsed, atomic.LoadInt64(calls))
+	}
+}
+
+func resourceErrorPrefix(name string, d *schema.ResourceData) string {
+	if d.Id() == "" {
+		return fmt.Sprintf("%s: ", name)
+	}
+	return fmt.Sprintf("%s (%s): ", name, d.Id())
+}
+
+func resourceError(name string, d *schema.ResourceData, err error) error {
+	return fmt.Errorf("%s%w", resourceErrorPrefix(name, d), err)
+}
+
 func genError(err error, d *schema.ResourceData) error {
 	return fmt.Errorf("Error reading: %s: %s", d.Id(), err)
 }
@@ -155,6 +379,9 @@ func providerConfigure(data *schema.ResourceData, terraformVersion string) (inte
 		UserToken:           data.Get("user_token").(string),
 		UserAgent:           fmt.Sprintf("(%s %s) Terraform/%s", runtime.GOOS, runtime.GOARCH, terraformVersion),
 		ApiUrlOverride:      data.Get("api_url_override").(string),
+		AllowedEmailDomains: expandStringList(data.Get("allowed_email_domains").(*schema.Set).List()),
+
+		ServiceDataSourceExactMatch: data.Get("service_data_source_exact_match").(bool),
 	}
 
 	log.Println("[INFO] Initializing PagerDuty client")
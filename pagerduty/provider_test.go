@@ -1,12 +1,19 @@
 package pagerduty
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
@@ -37,6 +44,111 @@ func TestProviderImpl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestProviderResourceErrors(t *testing.T) {
+	apiErr := &pagerduty.Error{
+		ErrorResponse: &pagerduty.Response{
+			Response: &http.Response{
+				Status:     "404 Not Found",
+				StatusCode: 404,
+				Header:     http.Header{"X-Request-Id": []string{"abc123"}},
+				Request:    &http.Request{Method: "GET", URL: &url.URL{Path: "/services/PXXXXXX"}},
+			},
+		},
+		Code:    2100,
+		Message: "Not Found",
+	}
+
+	r := &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return apiErr
+		},
+	}
+	wrapResourceErrors("pagerduty_service", r)
+
+	d := r.TestResourceData()
+	d.SetId("PXXXXXX")
+
+	err := r.Read(d, nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	for _, want := range []string{"pagerduty_service (PXXXXXX): ", "Code: 2100", "Request ID: abc123"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error %q to contain %q", err.Error(), want)
+		}
+	}
+
+	if !isErrCode(errors.Unwrap(err), 404) {
+		t.Errorf("expected the wrapped error to still be a PagerDuty API error")
+	}
+}
+
+func TestProviderResourceInstrumentation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"incident":{"id":"PXXXXXX"}}`))
+	}))
+	defer server.Close()
+
+	defer os.Setenv("TF_LOG", os.Getenv("TF_LOG"))
+	os.Setenv("TF_LOG", "TRACE")
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	config := &Config{Token: "foo", ApiUrlOverride: server.URL, SkipCredsValidation: true}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			for i := 0; i < 2; i++ {
+				if _, _, err := client.Incidents.GetContext(ctx, d.Id()); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			_, _, err := client.Incidents.Get(d.Id())
+			return err
+		},
+	}
+	instrumentResource("pagerduty_incident", r)
+
+	d := r.TestResourceData()
+	d.SetId("PXXXXXX")
+
+	// Requests made through the shared client outside of the operation aren't
+	// counted into it.
+	if _, _, err := client.Incidents.GetContext(context.Background(), "PXXXXXX"); err != nil {
+		t.Fatal(err)
+	}
+	if diags := r.ReadContext(context.Background(), d, config); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if err := r.Delete(d, config); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"[TRACE] pagerduty_incident (PXXXXXX): read took ",
+		" with 2 API calls",
+		"[TRACE] pagerduty_incident (PXXXXXX): delete took ",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("expected log %q to contain %q", buf.String(), want)
+		}
+	}
+	if strings.Count(buf.String(), "API calls") != 1 {
+		t.Errorf("expected only the read to report API calls, got %q", buf.String())
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("PAGERDUTY_PARALLEL"); v != "" {
 		t.Parallel()
@@ -1,6 +1,8 @@
 package pagerduty
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -18,6 +20,19 @@ func resourcePagerDutyAddon() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			services, ok := d.GetOk("services")
+			if ok && services.(*schema.Set).Len() > 0 && d.Get("type").(string) != pagerduty.AddonTypeIncidentShow {
+				return fmt.Errorf("services can only be set for the add-on type %s", pagerduty.AddonTypeIncidentShow)
+			}
+			// The API keeps the services of an add-on when none are sent
+			if d.Id() != "" && d.HasChange("services") {
+				if o, n := d.GetChange("services"); o.(*schema.Set).Len() > 0 && n.(*schema.Set).Len() == 0 {
+					return d.ForceNew("services")
+				}
+			}
+			return nil
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -27,6 +42,23 @@ func resourcePagerDutyAddon() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  pagerduty.AddonTypeFullPage,
+				ForceNew: true,
+				ValidateFunc: validateValueFunc([]string{
+					pagerduty.AddonTypeFullPage,
+					pagerduty.AddonTypeIncidentShow,
+				}),
+			},
+			"services": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -35,7 +67,14 @@ func buildAddonStruct(d *schema.ResourceData) *pagerduty.Addon {
 	addon := &pagerduty.Addon{
 		Name: d.Get("name").(string),
 		Src:  d.Get("src").(string),
-		Type: "full_page_addon",
+		Type: d.Get("type").(string),
+	}
+
+	for _, id := range d.Get("services").(*schema.Set).List() {
+		addon.Services = append(addon.Services, &pagerduty.ServiceReference{
+			ID:   id.(string),
+			Type: "service_reference",
+		})
 	}
 
 	return addon
@@ -62,6 +101,13 @@ func fetchPagerDutyAddon(d *schema.ResourceData, meta interface{}, errCallback f
 
 		d.Set("name", addon.Name)
 		d.Set("src", addon.Src)
+		d.Set("type", addon.Type)
+
+		var services []string
+		for _, s := range addon.Services {
+			services = append(services, s.ID)
+		}
+		d.Set("services", services)
 
 		return nil
 	})
@@ -106,7 +152,7 @@ func resourcePagerDutyAddonUpdate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	return nil
+	return resourcePagerDutyAddonRead(d, meta)
 }
 
 func resourcePagerDutyAddonDelete(d *schema.ResourceData, meta interface{}) error {
@@ -117,7 +163,7 @@ func resourcePagerDutyAddonDelete(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[INFO] Deleting PagerDuty add-on %s", d.Id())
 
-	if _, err := client.Addons.Delete(d.Id()); err != nil {
+	if _, err := client.Addons.Delete(d.Id()); err != nil && !isErrCode(err, 404) {
 		return err
 	}
 
@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -80,6 +81,38 @@ func TestAccPagerDutyAddon_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyAddon_IncidentShowServices(t *testing.T) {
+	addon := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyAddonConfigIncidentShow(addon, username, email, escalationPolicy, service, "incident_show_addon"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyAddonExists("pagerduty_addon.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_addon.foo", "type", "incident_show_addon"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_addon.foo", "services.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"pagerduty_addon.foo", "services.*", "pagerduty_service.foo", "id"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyAddonConfigIncidentShow(addon, username, email, escalationPolicy, service, "full_page_addon"),
+				ExpectError: regexp.MustCompile("services can only be set for the add-on type incident_show_addon"),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyAddonDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -138,3 +171,36 @@ resource "pagerduty_addon" "foo" {
 }
 `, addon)
 }
+
+func testAccCheckPagerDutyAddonConfigIncidentShow(addon, username, email, escalationPolicy, service, addonType string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[2]s"
+  email = "%[3]s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%[4]s"
+  num_loops = 1
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[5]s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_addon" "foo" {
+  name     = "%[1]s"
+  src      = "https://intranet.foo.test/runbooks"
+  type     = "%[6]s"
+  services = [pagerduty_service.foo.id]
+}
+`, addon, username, email, escalationPolicy, service, addonType)
+}
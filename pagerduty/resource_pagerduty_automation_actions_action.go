@@ -1,7 +1,9 @@
 package pagerduty
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -19,6 +21,7 @@ func resourcePagerDutyAutomationActionsAction() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeAutomationActionsActionDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -94,10 +97,62 @@ func resourcePagerDutyAutomationActionsAction() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"only_invocable_on_unresolved_incidents": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"allow_invocation_manually": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"allow_invocation_from_event_orchestration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"map_to_all_services": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// customizeAutomationActionsActionDiff checks that the action data reference
+// has what the action type needs to be invoked, and replaces process
+// automation actions when their runner changes, as the API doesn't allow it.
+func customizeAutomationActionsActionDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	actionType := d.Get("action_type").(string)
+
+	switch actionType {
+	case "script":
+		if d.NewValueKnown("action_data_reference.0.script") && d.Get("action_data_reference.0.script").(string) == "" {
+			return fmt.Errorf("action_data_reference.0.script must be specified for actions of type script")
+		}
+	case "process_automation":
+		if d.NewValueKnown("action_data_reference.0.process_automation_job_id") && d.Get("action_data_reference.0.process_automation_job_id").(string) == "" {
+			return fmt.Errorf("action_data_reference.0.process_automation_job_id must be specified for actions of type process_automation")
+		}
+		if d.Id() != "" && d.HasChange("runner_id") {
+			if err := d.ForceNew("runner_id"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func buildAutomationActionsActionStruct(d *schema.ResourceData) (*pagerduty.AutomationActionsAction, error) {
 
 	automationActionsAction := pagerduty.AutomationActionsAction{
@@ -149,6 +204,11 @@ func buildAutomationActionsActionStruct(d *schema.ResourceData) (*pagerduty.Auto
 		automationActionsAction.ModifyTime = &val
 	}
 
+	automationActionsAction.OnlyInvocableOnUnresolvedIncidents = configuredBoolPtr(d, "only_invocable_on_unresolved_incidents")
+	automationActionsAction.AllowInvocationManually = configuredBoolPtr(d, "allow_invocation_manually")
+	automationActionsAction.AllowInvocationFromEventOrchestration = configuredBoolPtr(d, "allow_invocation_from_event_orchestration")
+	automationActionsAction.MapToAllServices = configuredBoolPtr(d, "map_to_all_services")
+
 	return &automationActionsAction, nil
 }
 
@@ -238,7 +298,7 @@ func resourcePagerDutyAutomationActionsActionUpdate(d *schema.ResourceData, meta
 		return err
 	}
 
-	return nil
+	return resourcePagerDutyAutomationActionsActionRead(d, meta)
 }
 
 func resourcePagerDutyAutomationActionsActionRead(d *schema.ResourceData, meta interface{}) error {
@@ -251,8 +311,13 @@ func resourcePagerDutyAutomationActionsActionRead(d *schema.ResourceData, meta i
 
 	return resource.Retry(30*time.Second, func() *resource.RetryError {
 		if automationActionsAction, _, err := client.AutomationActionsAction.Get(d.Id()); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if automationActionsAction != nil {
 			d.Set("name", automationActionsAction.Name)
 			d.Set("type", automationActionsAction.Type)
@@ -283,6 +348,12 @@ func resourcePagerDutyAutomationActionsActionRead(d *schema.ResourceData, meta i
 			if automationActionsAction.ActionClassification != nil {
 				d.Set("action_classification", &automationActionsAction.ActionClassification)
 			}
+
+			if err := d.Set("permissions", flattenAutomationActionsPrivileges(automationActionsAction.Privileges)); err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			flattenAutomationActionsActionInvocationSettings(d, automationActionsAction)
 		}
 		return nil
 	})
@@ -310,6 +381,24 @@ func flattenActionDataReference(adr pagerduty.AutomationActionsActionDataReferen
 	return []interface{}{adr_map}
 }
 
+func flattenAutomationActionsActionInvocationSettings(d *schema.ResourceData, a *pagerduty.AutomationActionsAction) {
+	if a.OnlyInvocableOnUnresolvedIncidents != nil {
+		d.Set("only_invocable_on_unresolved_incidents", *a.OnlyInvocableOnUnresolvedIncidents)
+	}
+
+	if a.AllowInvocationManually != nil {
+		d.Set("allow_invocation_manually", *a.AllowInvocationManually)
+	}
+
+	if a.AllowInvocationFromEventOrchestration != nil {
+		d.Set("allow_invocation_from_event_orchestration", *a.AllowInvocationFromEventOrchestration)
+	}
+
+	if a.MapToAllServices != nil {
+		d.Set("map_to_all_services", *a.MapToAllServices)
+	}
+}
+
 func resourcePagerDutyAutomationActionsActionDelete(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -0,0 +1,170 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePagerDutyAutomationActionsActionServiceAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyAutomationActionsActionServiceAssociationCreate,
+		Read:   resourcePagerDutyAutomationActionsActionServiceAssociationRead,
+		Delete: resourcePagerDutyAutomationActionsActionServiceAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyAutomationActionsActionServiceAssociationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"action_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourcePagerDutyAutomationActionsActionServiceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	actionID := d.Get("action_id").(string)
+	serviceID := d.Get("service_id").(string)
+
+	log.Printf("[INFO] Creating PagerDuty AutomationActionsActionServiceAssociation %s:%s", d.Get("action_id").(string), d.Get("service_id").(string))
+
+	retryErr := resource.Retry(10*time.Second, func() *resource.RetryError {
+		if serviceRef, _, err := client.AutomationActionsAction.AssociateToService(actionID, serviceID); err != nil {
+			if isErrCode(err, 429) {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		} else if serviceRef != nil {
+			d.SetId(fmt.Sprintf("%s:%s", actionID, serviceID))
+		}
+		return nil
+	})
+
+	if retryErr != nil {
+		return retryErr
+	}
+
+	return fetchPagerDutyAutomationActionsActionServiceAssociation(d, meta, handleNotFoundError)
+}
+
+func fetchPagerDutyAutomationActionsActionServiceAssociation(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	actionID, serviceID := resourcePagerDutyParseColonCompoundID(d.Id())
+	return resource.Retry(30*time.Second, func() *resource.RetryError {
+		resp, _, err := client.AutomationActionsAction.GetAssociationToService(actionID, serviceID)
+		if err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		if resp.Service.ID != serviceID {
+			log.Printf("[WARN] Removing %s since the action: %s is not associated to the service: %s", d.Id(), actionID, serviceID)
+			d.SetId("")
+			return nil
+		}
+
+		d.Set("action_id", actionID)
+		d.Set("service_id", resp.Service.ID)
+
+		return nil
+	})
+}
+
+func resourcePagerDutyAutomationActionsActionServiceAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	return fetchPagerDutyAutomationActionsActionServiceAssociation(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyAutomationActionsActionServiceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	actionID, serviceID := resourcePagerDutyParseColonCompoundID(d.Id())
+	log.Printf("[INFO] Deleting PagerDuty AutomationActionsActionServiceAssociation %s", d.Id())
+
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if _, err := client.AutomationActionsAction.DissociateFromService(actionID, serviceID); err != nil {
+			if isErrCode(err, 404) {
+				return nil
+			}
+			return resource.RetryableError(err)
+		}
+		return nil
+	})
+	if retryErr != nil {
+		time.Sleep(2 * time.Second)
+		return retryErr
+	}
+	d.SetId("")
+
+	// giving the API time to catchup
+	time.Sleep(time.Second)
+	return nil
+}
+
+func resourcePagerDutyAutomationActionsActionServiceAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	if strings.Contains(d.Id(), ":") {
+		ids := strings.Split(d.Id(), ":")
+		if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+			return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_automation_actions_action_service_association. Expecting an importation ID formed as '<action_id>:<service_id>'")
+		}
+		actionID, serviceID := ids[0], ids[1]
+
+		if _, _, err := client.AutomationActionsAction.GetAssociationToService(actionID, serviceID); err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		d.Set("action_id", actionID)
+		d.Set("service_id", serviceID)
+
+		return []*schema.ResourceData{d}, nil
+	}
+
+	// An ID without colon is taken as an action ID, whose services are listed
+
+	actionID := d.Id()
+	action, _, err := client.AutomationActionsAction.Get(actionID)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	var childIDs []string
+	for _, service := range action.Services {
+		childIDs = append(childIDs, fmt.Sprintf("%s:%s", actionID, service.ID))
+	}
+
+	return []*schema.ResourceData{}, importByParentIDError("pagerduty_automation_actions_action_service_association", actionID, childIDs)
+}
@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -15,7 +16,7 @@ func resourcePagerDutyAutomationActionsActionTeamAssociation() *schema.Resource
 		Read:   resourcePagerDutyAutomationActionsActionTeamAssociationRead,
 		Delete: resourcePagerDutyAutomationActionsActionTeamAssociationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourcePagerDutyAutomationActionsActionTeamAssociationImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"action_id": {
@@ -84,7 +85,7 @@ func fetchPagerDutyAutomationActionsActionTeamAssociation(d *schema.ResourceData
 		}
 
 		if resp.Team.ID != teamID {
-			log.Printf("[WARN] Removing %s since the user: %s is not a member of: %s", d.Id(), actionID, teamID)
+			log.Printf("[WARN] Removing %s since the action: %s is not associated to the team: %s", d.Id(), actionID, teamID)
 			d.SetId("")
 			return nil
 		}
@@ -111,6 +112,9 @@ func resourcePagerDutyAutomationActionsActionTeamAssociationDelete(d *schema.Res
 
 	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
 		if _, err := client.AutomationActionsAction.DissociateToTeam(actionID, teamID); err != nil {
+			if isErrCode(err, 404) {
+				return nil
+			}
 			return resource.RetryableError(err)
 		}
 		return nil
@@ -125,3 +129,42 @@ func resourcePagerDutyAutomationActionsActionTeamAssociationDelete(d *schema.Res
 	time.Sleep(time.Second)
 	return nil
 }
+
+func resourcePagerDutyAutomationActionsActionTeamAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	if strings.Contains(d.Id(), ":") {
+		ids := strings.Split(d.Id(), ":")
+		if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+			return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_automation_actions_action_team_association. Expecting an importation ID formed as '<action_id>:<team_id>'")
+		}
+		actionID, teamID := ids[0], ids[1]
+
+		if _, _, err := client.AutomationActionsAction.GetAssociationToTeam(actionID, teamID); err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		d.Set("action_id", actionID)
+		d.Set("team_id", teamID)
+
+		return []*schema.ResourceData{d}, nil
+	}
+
+	// An ID without colon is taken as an action ID, whose teams are listed
+
+	actionID := d.Id()
+	action, _, err := client.AutomationActionsAction.Get(actionID)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	var childIDs []string
+	for _, team := range action.Teams {
+		childIDs = append(childIDs, fmt.Sprintf("%s:%s", actionID, team.ID))
+	}
+
+	return []*schema.ResourceData{}, importByParentIDError("pagerduty_automation_actions_action_team_association", actionID, childIDs)
+}
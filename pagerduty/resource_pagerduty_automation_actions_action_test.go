@@ -2,6 +2,7 @@ package pagerduty
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -55,6 +56,7 @@ func TestAccPagerDutyAutomationActionsActionTypeProcessAutomation_Basic(t *testi
 					resource.TestCheckResourceAttrSet("pagerduty_automation_actions_action.foo", "modify_time"),
 					resource.TestCheckResourceAttrSet("pagerduty_automation_actions_action.foo", "runner_id"),
 					resource.TestCheckResourceAttr("pagerduty_automation_actions_action.foo", "runner_type", "runbook"),
+					resource.TestCheckResourceAttrSet("pagerduty_automation_actions_action.foo", "permissions.#"),
 				),
 			},
 			{
@@ -150,6 +152,61 @@ func TestAccPagerDutyAutomationActionsActionTypeScript_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyAutomationActionsAction_InvocationSettings(t *testing.T) {
+	actionName := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyAutomationActionsActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyAutomationActionsActionInvocationSettingsConfig(actionName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyAutomationActionsActionExists("pagerduty_automation_actions_action.foo"),
+					resource.TestCheckResourceAttr("pagerduty_automation_actions_action.foo", "only_invocable_on_unresolved_incidents", "true"),
+					resource.TestCheckResourceAttr("pagerduty_automation_actions_action.foo", "allow_invocation_manually", "true"),
+					resource.TestCheckResourceAttr("pagerduty_automation_actions_action.foo", "allow_invocation_from_event_orchestration", "false"),
+					resource.TestCheckResourceAttr("pagerduty_automation_actions_action.foo", "map_to_all_services", "false"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyAutomationActionsActionInvocationSettingsConfig(actionName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyAutomationActionsActionExists("pagerduty_automation_actions_action.foo"),
+					resource.TestCheckResourceAttr("pagerduty_automation_actions_action.foo", "only_invocable_on_unresolved_incidents", "false"),
+					resource.TestCheckResourceAttr("pagerduty_automation_actions_action.foo", "allow_invocation_manually", "false"),
+					resource.TestCheckResourceAttr("pagerduty_automation_actions_action.foo", "allow_invocation_from_event_orchestration", "true"),
+					resource.TestCheckResourceAttr("pagerduty_automation_actions_action.foo", "map_to_all_services", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyAutomationActionsActionTypeScript_WithoutScript(t *testing.T) {
+	config := `
+resource "pagerduty_automation_actions_action" "foo" {
+  name        = "tf-ignored"
+  description = "Ignored"
+  action_type = "script"
+  action_data_reference {
+    invocation_command = "/usr/local/bin/python3"
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("action_data_reference.0.script must be specified for actions of type script"),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyAutomationActionsActionDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -261,3 +318,21 @@ func testAccCheckPagerDutyAutomationActionsActionTypeScriptConfigUpdated(actionN
 	}
 `, actionName, actionDescription, actionClassification)
 }
+
+func testAccCheckPagerDutyAutomationActionsActionInvocationSettingsConfig(actionName string, manual, automatic bool) string {
+	return fmt.Sprintf(`
+resource "pagerduty_automation_actions_action" "foo" {
+	name = "%s"
+	description = "PA Action created by TF"
+	action_type = "script"
+	action_data_reference {
+		script = "java --version"
+		invocation_command = "/bin/bash"
+	  }
+	only_invocable_on_unresolved_incidents = %[2]t
+	allow_invocation_manually = %[2]t
+	allow_invocation_from_event_orchestration = %[3]t
+	map_to_all_services = %[3]t
+}
+`, actionName, manual, automatic)
+}
@@ -1,7 +1,10 @@
 package pagerduty
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -19,6 +22,9 @@ func resourcePagerDutyAutomationActionsRunner() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -45,6 +51,15 @@ func resourcePagerDutyAutomationActionsRunner() *schema.Resource {
 				Type:      schema.TypeString,
 				Optional:  true,
 				Sensitive: true,
+				StateFunc: hashAutomationActionsRunnerAPIKey,
+			},
+			"teams": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -59,6 +74,22 @@ func resourcePagerDutyAutomationActionsRunner() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_for_connected": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -96,6 +127,10 @@ func buildAutomationActionsRunnerStruct(d *schema.ResourceData) (*pagerduty.Auto
 		return nil, errors.New("runbook_api_key must be specified when creating a runbook runner")
 	}
 
+	if attr, ok := d.GetOk("teams"); ok {
+		automationActionsRunner.Teams = expandTeams(attr.(*schema.Set).List())
+	}
+
 	return &automationActionsRunner, nil
 }
 
@@ -130,9 +165,40 @@ func resourcePagerDutyAutomationActionsRunnerCreate(d *schema.ResourceData, meta
 		return retryErr
 	}
 
+	if d.Get("wait_for_connected").(bool) {
+		if err := waitForAutomationActionsRunnerConnected(client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
 	return resourcePagerDutyAutomationActionsRunnerRead(d, meta)
 }
 
+// waitForAutomationActionsRunnerConnected polls the runner until PagerDuty
+// has seen it, so that the actions using it aren't created against a runner
+// which never connected.
+func waitForAutomationActionsRunnerConnected(client *pagerduty.Client, id string, timeout time.Duration) error {
+	log.Printf("[INFO] Waiting for PagerDuty AutomationActionsRunner %s to connect", id)
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		runner, _, err := client.AutomationActionsRunner.Get(id)
+		if err != nil {
+			if isErrCode(err, 429) {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		if runner.LastSeenTime == nil || *runner.LastSeenTime == "" {
+			time.Sleep(10 * time.Second)
+			return resource.RetryableError(fmt.Errorf("runner %s has not connected to PagerDuty yet", id))
+		}
+
+		return nil
+	})
+}
+
 func resourcePagerDutyAutomationActionsRunnerRead(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -162,6 +228,18 @@ func resourcePagerDutyAutomationActionsRunnerRead(d *schema.ResourceData, meta i
 			if automationActionsRunner.LastSeenTime != nil {
 				d.Set("last_seen", &automationActionsRunner.LastSeenTime)
 			}
+
+			if automationActionsRunner.Status != nil {
+				d.Set("status", *automationActionsRunner.Status)
+			}
+
+			if err := d.Set("permissions", flattenAutomationActionsPrivileges(automationActionsRunner.Privileges)); err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			if err := d.Set("teams", flattenTeams(automationActionsRunner.Teams)); err != nil {
+				return resource.NonRetryableError(err)
+			}
 		}
 		return nil
 	})
@@ -178,12 +256,50 @@ func resourcePagerDutyAutomationActionsRunnerUpdate(d *schema.ResourceData, meta
 		return err
 	}
 
+	// Only the hash of the API key is in the state, so the key is sent only
+	// when it's rotated
+	if !d.HasChange("runbook_api_key") {
+		automationActionsRunner.RunbookApiKey = nil
+	}
+
+	// The teams are managed through their association endpoints
+	automationActionsRunner.Teams = nil
+
 	log.Printf("[INFO] Updating PagerDuty AutomationActionsRunner %s", d.Id())
 
 	if _, _, err := client.AutomationActionsRunner.Update(d.Id(), automationActionsRunner); err != nil {
 		return err
 	}
 
+	if d.HasChange("teams") {
+		if err := updateAutomationActionsRunnerTeams(client, d); err != nil {
+			return err
+		}
+	}
+
+	return resourcePagerDutyAutomationActionsRunnerRead(d, meta)
+}
+
+// updateAutomationActionsRunnerTeams dissociates the runner from the teams
+// which are no longer configured, then associates it with the new ones.
+func updateAutomationActionsRunnerTeams(client *pagerduty.Client, d *schema.ResourceData) error {
+	o, n := d.GetChange("teams")
+	oldTeams, newTeams := o.(*schema.Set), n.(*schema.Set)
+
+	for _, teamID := range oldTeams.Difference(newTeams).List() {
+		log.Printf("[INFO] Dissociating PagerDuty AutomationActionsRunner %s from team %s", d.Id(), teamID)
+		if _, err := client.AutomationActionsRunner.DissociateFromTeam(d.Id(), teamID.(string)); err != nil && !isErrCode(err, 404) {
+			return err
+		}
+	}
+
+	for _, teamID := range newTeams.Difference(oldTeams).List() {
+		log.Printf("[INFO] Associating PagerDuty AutomationActionsRunner %s with team %s", d.Id(), teamID)
+		if _, _, err := client.AutomationActionsRunner.AssociateToTeam(d.Id(), teamID.(string)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -211,3 +327,32 @@ func resourcePagerDutyAutomationActionsRunnerDelete(d *schema.ResourceData, meta
 	time.Sleep(time.Second)
 	return nil
 }
+
+// hashAutomationActionsRunnerAPIKey keeps the Runbook Automation API key out
+// of the state, while still detecting its changes.
+func hashAutomationActionsRunnerAPIKey(v interface{}) string {
+	key, ok := v.(string)
+	if !ok || key == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// flattenAutomationActionsPrivileges returns the permissions the credentials of
+// the provider have on a runner or an action.
+func flattenAutomationActionsPrivileges(p *pagerduty.AutomationActionsPrivileges) []string {
+	permissions := []string{}
+	if p == nil {
+		return permissions
+	}
+
+	for _, permission := range p.Permissions {
+		if permission != nil {
+			permissions = append(permissions, *permission)
+		}
+	}
+
+	return permissions
+}
@@ -0,0 +1,170 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePagerDutyAutomationActionsRunnerTeamAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyAutomationActionsRunnerTeamAssociationCreate,
+		Read:   resourcePagerDutyAutomationActionsRunnerTeamAssociationRead,
+		Delete: resourcePagerDutyAutomationActionsRunnerTeamAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyAutomationActionsRunnerTeamAssociationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"runner_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourcePagerDutyAutomationActionsRunnerTeamAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	runnerID := d.Get("runner_id").(string)
+	teamID := d.Get("team_id").(string)
+
+	log.Printf("[INFO] Creating PagerDuty AutomationActionsRunnerTeamAssociation %s:%s", d.Get("runner_id").(string), d.Get("team_id").(string))
+
+	retryErr := resource.Retry(10*time.Second, func() *resource.RetryError {
+		if teamRef, _, err := client.AutomationActionsRunner.AssociateToTeam(runnerID, teamID); err != nil {
+			if isErrCode(err, 429) {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		} else if teamRef != nil {
+			d.SetId(fmt.Sprintf("%s:%s", runnerID, teamID))
+		}
+		return nil
+	})
+
+	if retryErr != nil {
+		return retryErr
+	}
+
+	return fetchPagerDutyAutomationActionsRunnerTeamAssociation(d, meta, handleNotFoundError)
+}
+
+func fetchPagerDutyAutomationActionsRunnerTeamAssociation(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	runnerID, teamID := resourcePagerDutyParseColonCompoundID(d.Id())
+	return resource.Retry(30*time.Second, func() *resource.RetryError {
+		resp, _, err := client.AutomationActionsRunner.GetAssociationToTeam(runnerID, teamID)
+		if err != nil {
+			errResp := errCallback(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		if resp.Team.ID != teamID {
+			log.Printf("[WARN] Removing %s since the runner: %s is not associated to the team: %s", d.Id(), runnerID, teamID)
+			d.SetId("")
+			return nil
+		}
+
+		d.Set("runner_id", runnerID)
+		d.Set("team_id", resp.Team.ID)
+
+		return nil
+	})
+}
+
+func resourcePagerDutyAutomationActionsRunnerTeamAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	return fetchPagerDutyAutomationActionsRunnerTeamAssociation(d, meta, handleNotFoundError)
+}
+
+func resourcePagerDutyAutomationActionsRunnerTeamAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	runnerID, teamID := resourcePagerDutyParseColonCompoundID(d.Id())
+	log.Printf("[INFO] Deleting PagerDuty AutomationActionsRunnerTeamAssociation %s", d.Id())
+
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if _, err := client.AutomationActionsRunner.DissociateFromTeam(runnerID, teamID); err != nil {
+			if isErrCode(err, 404) {
+				return nil
+			}
+			return resource.RetryableError(err)
+		}
+		return nil
+	})
+	if retryErr != nil {
+		time.Sleep(2 * time.Second)
+		return retryErr
+	}
+	d.SetId("")
+
+	// giving the API time to catchup
+	time.Sleep(time.Second)
+	return nil
+}
+
+func resourcePagerDutyAutomationActionsRunnerTeamAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	if strings.Contains(d.Id(), ":") {
+		ids := strings.Split(d.Id(), ":")
+		if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+			return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_automation_actions_runner_team_association. Expecting an importation ID formed as '<runner_id>:<team_id>'")
+		}
+		runnerID, teamID := ids[0], ids[1]
+
+		if _, _, err := client.AutomationActionsRunner.GetAssociationToTeam(runnerID, teamID); err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		d.Set("runner_id", runnerID)
+		d.Set("team_id", teamID)
+
+		return []*schema.ResourceData{d}, nil
+	}
+
+	// An ID without colon is taken as a runner ID, whose teams are listed
+
+	runnerID := d.Id()
+	runner, _, err := client.AutomationActionsRunner.Get(runnerID)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	var childIDs []string
+	for _, team := range runner.Teams {
+		childIDs = append(childIDs, fmt.Sprintf("%s:%s", runnerID, team.ID))
+	}
+
+	return []*schema.ResourceData{}, importByParentIDError("pagerduty_automation_actions_runner_team_association", runnerID, childIDs)
+}
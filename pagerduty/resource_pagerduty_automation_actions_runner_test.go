@@ -2,11 +2,13 @@ package pagerduty
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 func init() {
@@ -20,6 +22,41 @@ func testSweepAutomationActionsRunner(region string) error {
 	return nil
 }
 
+func TestFlattenAutomationActionsPrivileges(t *testing.T) {
+	read, update := "read", "update"
+
+	cases := []struct {
+		privileges *pagerduty.AutomationActionsPrivileges
+		expected   []string
+	}{
+		{nil, []string{}},
+		{&pagerduty.AutomationActionsPrivileges{}, []string{}},
+		{&pagerduty.AutomationActionsPrivileges{Permissions: []*string{&read, nil, &update}}, []string{"read", "update"}},
+	}
+
+	for _, c := range cases {
+		if permissions := flattenAutomationActionsPrivileges(c.privileges); !reflect.DeepEqual(permissions, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, permissions)
+		}
+	}
+}
+
+func TestHashAutomationActionsRunnerAPIKey(t *testing.T) {
+	hash := hashAutomationActionsRunnerAPIKey("cat-secret")
+	if hash == "cat-secret" || len(hash) != 64 {
+		t.Errorf("expected the SHA-256 hex digest of the API key, got %s", hash)
+	}
+	if hashAutomationActionsRunnerAPIKey("cat-secret") != hash {
+		t.Errorf("expected the hash of the API key to be stable")
+	}
+	if hashAutomationActionsRunnerAPIKey("cat-secret-updated") == hash {
+		t.Errorf("expected a rotated API key to change its hash")
+	}
+	if hash := hashAutomationActionsRunnerAPIKey(""); hash != "" {
+		t.Errorf("expected no hash for an empty API key, got %s", hash)
+	}
+}
+
 func TestAccPagerDutyAutomationActionsRunner_Basic(t *testing.T) {
 	runnerName := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
@@ -39,9 +76,12 @@ func TestAccPagerDutyAutomationActionsRunner_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("pagerduty_automation_actions_runner.foo", "runner_type", "runbook"),
 					resource.TestCheckResourceAttr("pagerduty_automation_actions_runner.foo", "description", "Runner created by TF"),
 					resource.TestCheckResourceAttr("pagerduty_automation_actions_runner.foo", "runbook_base_uri", "cat-cat"),
+					resource.TestCheckResourceAttr("pagerduty_automation_actions_runner.foo", "runbook_api_key", hashAutomationActionsRunnerAPIKey("cat-secret")),
+					resource.TestCheckResourceAttr("pagerduty_automation_actions_runner.foo", "wait_for_connected", "false"),
 					resource.TestCheckResourceAttr("pagerduty_automation_actions_runner.foo", "type", "runner"),
 					resource.TestCheckResourceAttrSet("pagerduty_automation_actions_runner.foo", "id"),
 					resource.TestCheckResourceAttrSet("pagerduty_automation_actions_runner.foo", "creation_time"),
+					resource.TestCheckResourceAttrSet("pagerduty_automation_actions_runner.foo", "permissions.#"),
 				),
 			},
 			{
@@ -52,6 +92,7 @@ func TestAccPagerDutyAutomationActionsRunner_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("pagerduty_automation_actions_runner.foo", "runner_type", "runbook"),
 					resource.TestCheckResourceAttr("pagerduty_automation_actions_runner.foo", "description", descriptionUpdated),
 					resource.TestCheckResourceAttr("pagerduty_automation_actions_runner.foo", "runbook_base_uri", "cat-cat-updated"),
+					resource.TestCheckResourceAttr("pagerduty_automation_actions_runner.foo", "runbook_api_key", hashAutomationActionsRunnerAPIKey("cat-secret-updated")),
 					resource.TestCheckResourceAttr("pagerduty_automation_actions_runner.foo", "type", "runner"),
 					resource.TestCheckResourceAttrSet("pagerduty_automation_actions_runner.foo", "id"),
 					resource.TestCheckResourceAttrSet("pagerduty_automation_actions_runner.foo", "creation_time"),
@@ -61,6 +102,35 @@ func TestAccPagerDutyAutomationActionsRunner_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyAutomationActionsRunner_Teams(t *testing.T) {
+	runnerName := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	teamName := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyAutomationActionsRunnerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyAutomationActionsRunnerTeamsConfig(runnerName, teamName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyAutomationActionsRunnerExists("pagerduty_automation_actions_runner.foo"),
+					resource.TestCheckResourceAttr("pagerduty_automation_actions_runner.foo", "teams.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("pagerduty_automation_actions_runner.foo", "teams.*", "pagerduty_team.foo", "id"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyAutomationActionsRunnerTeamsUpdatedConfig(runnerName, teamName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyAutomationActionsRunnerExists("pagerduty_automation_actions_runner.foo"),
+					resource.TestCheckResourceAttr("pagerduty_automation_actions_runner.foo", "teams.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("pagerduty_automation_actions_runner.foo", "teams.*", "pagerduty_team.bar", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyAutomationActionsRunnerDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -120,3 +190,41 @@ resource "pagerduty_automation_actions_runner" "foo" {
 }
 `, runnerName, runnerDescription)
 }
+
+func testAccCheckPagerDutyAutomationActionsRunnerTeamsConfig(runnerName, teamName string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+	name = "%s"
+}
+
+resource "pagerduty_automation_actions_runner" "foo" {
+	name = "%s"
+	description = "Runner created by TF"
+	runner_type = "runbook"
+	runbook_base_uri = "cat-cat"
+	runbook_api_key = "cat-secret"
+	teams = [pagerduty_team.foo.id]
+}
+`, teamName, runnerName)
+}
+
+func testAccCheckPagerDutyAutomationActionsRunnerTeamsUpdatedConfig(runnerName, teamName string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+	name = "%[1]s"
+}
+
+resource "pagerduty_team" "bar" {
+	name = "%[1]s-bar"
+}
+
+resource "pagerduty_automation_actions_runner" "foo" {
+	name = "%[2]s"
+	description = "Runner created by TF"
+	runner_type = "runbook"
+	runbook_base_uri = "cat-cat"
+	runbook_api_key = "cat-secret"
+	teams = [pagerduty_team.bar.id]
+}
+`, teamName, runnerName)
+}
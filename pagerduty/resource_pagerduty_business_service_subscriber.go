@@ -98,8 +98,13 @@ func resourcePagerDutyBusinessServiceSubscriberRead(d *schema.ResourceData, meta
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		if subscriberResponse, _, err := client.BusinessServiceSubscribers.List(businessServiceId); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if subscriberResponse != nil {
 			var foundSubscriber *pagerduty.BusinessServiceSubscriber
 
@@ -130,7 +135,7 @@ func resourcePagerDutyBusinessServiceSubscriberDelete(d *schema.ResourceData, me
 
 	log.Printf("[INFO] Deleting PagerDuty business service %s subscriber %s type %s", businessServiceId, businessServiceSubscriber.ID, businessServiceSubscriber.Type)
 
-	if _, err := client.BusinessServiceSubscribers.Delete(businessServiceId, businessServiceSubscriber); err != nil {
+	if _, err := client.BusinessServiceSubscribers.Delete(businessServiceId, businessServiceSubscriber); err != nil && !isErrCode(err, 404) {
 		return err
 	}
 
@@ -150,26 +155,42 @@ func resourcePagerDutyBusinessServiceSubscriberImport(d *schema.ResourceData, me
 		return []*schema.ResourceData{}, err
 	}
 
+	if len(ids) == 1 {
+		subscriberResponse, _, err := client.BusinessServiceSubscribers.List(ids[0])
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		var childIDs []string
+		for _, subscriber := range subscriberResponse.BusinessServiceSubscribers {
+			childIDs = append(childIDs, createSubscriberID(ids[0], subscriber.Type, subscriber.ID))
+		}
+		return []*schema.ResourceData{}, importByParentIDError("pagerduty_business_service_subscriber", ids[0], childIDs)
+	}
+
 	if len(ids) != 3 {
 		return []*schema.ResourceData{}, fmt.Errorf("error importing pagerduty_business_service_subscriber. Expecting an importation ID formed as '<business_service_id>.<subscriber_type>.<subscriber_id>'")
 	}
 
 	businessServiceId, businessServiceSubscriberType, businessServiceSubscriberID := ids[0], ids[1], ids[2]
 	subscriberResponse, _, err := client.BusinessServiceSubscribers.List(businessServiceId)
-	if subscriberResponse != nil {
-		// loop subscribers and find matching ID
-		for _, subscriber := range subscriberResponse.BusinessServiceSubscribers {
-			if subscriber.ID == businessServiceSubscriberID && subscriber.Type == businessServiceSubscriberType {
-				// create subscriber assignment it as PagerDuty API does not return one
-				assignmentID := createSubscriberID(businessServiceId, businessServiceSubscriberType, businessServiceSubscriberID)
-				d.SetId(assignmentID)
-				d.Set("business_service_id", businessServiceId)
-				d.Set("subscriber_type", businessServiceSubscriberType)
-				d.Set("subscriber_id", businessServiceSubscriberID)
-				break
-			}
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	// loop subscribers and find matching ID
+	for _, subscriber := range subscriberResponse.BusinessServiceSubscribers {
+		if subscriber.ID == businessServiceSubscriberID && subscriber.Type == businessServiceSubscriberType {
+			// create subscriber assignment it as PagerDuty API does not return one
+			assignmentID := createSubscriberID(businessServiceId, businessServiceSubscriberType, businessServiceSubscriberID)
+			d.SetId(assignmentID)
+			d.Set("business_service_id", businessServiceId)
+			d.Set("subscriber_type", businessServiceSubscriberType)
+			d.Set("subscriber_id", businessServiceSubscriberID)
+
+			return []*schema.ResourceData{d}, nil
 		}
 	}
 
-	return []*schema.ResourceData{d}, err
+	return []*schema.ResourceData{}, fmt.Errorf("error importing pagerduty_business_service_subscriber. The %s %s is not a subscriber of the business service %s", businessServiceSubscriberType, businessServiceSubscriberID, businessServiceId)
 }
@@ -81,6 +81,47 @@ func resourcePagerDutyEscalationPolicy() *schema.Resource {
 					},
 				},
 			},
+			"rule_summaries": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The escalation rules with their targets resolved, for rendering in service catalogs",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"level": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"escalation_delay_in_minutes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"targets": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"html_url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -167,6 +208,10 @@ func resourcePagerDutyEscalationPolicyRead(d *schema.ResourceData, meta interfac
 			return resource.NonRetryableError(err)
 		}
 
+		if err := d.Set("rule_summaries", flattenEscalationRuleSummaries(escalationPolicy.EscalationRules)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
 		return nil
 	})
 }
@@ -275,6 +320,32 @@ func flattenEscalationRules(v []*pagerduty.EscalationRule) []map[string]interfac
 	return escalationRules
 }
 
+// flattenEscalationRuleSummaries uses the summaries of the rule targets
+// returned along the policy, so the target names don't need extra lookups.
+func flattenEscalationRuleSummaries(v []*pagerduty.EscalationRule) []map[string]interface{} {
+	var summaries []map[string]interface{}
+
+	for i, er := range v {
+		var targets []map[string]interface{}
+		for _, ert := range er.Targets {
+			targets = append(targets, map[string]interface{}{
+				"id":       ert.ID,
+				"type":     ert.Type,
+				"name":     ert.Summary,
+				"html_url": ert.HTMLURL,
+			})
+		}
+
+		summaries = append(summaries, map[string]interface{}{
+			"level":                       i + 1,
+			"escalation_delay_in_minutes": er.EscalationDelayInMinutes,
+			"targets":                     targets,
+		})
+	}
+
+	return summaries
+}
+
 func expandTeams(v interface{}) []*pagerduty.TeamReference {
 	var teams []*pagerduty.TeamReference
 
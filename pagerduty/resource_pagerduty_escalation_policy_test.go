@@ -75,6 +75,14 @@ func TestAccPagerDutyEscalationPolicy_Basic(t *testing.T) {
 						"pagerduty_escalation_policy.foo", "rule.#", "1"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_escalation_policy.foo", "rule.0.escalation_delay_in_minutes", "10"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "rule_summaries.0.level", "1"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "rule_summaries.0.escalation_delay_in_minutes", "10"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "rule_summaries.0.targets.0.name", username),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "rule_summaries.0.targets.0.type", "user_reference"),
 				),
 			},
 
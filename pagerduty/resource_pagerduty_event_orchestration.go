@@ -45,6 +45,10 @@ func resourcePagerDutyEventOrchestration() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"parameters": {
 							Type:     schema.TypeList,
 							Computed: true,
@@ -160,11 +164,13 @@ func resourcePagerDutyEventOrchestrationUpdate(d *schema.ResourceData, meta inte
 	log.Printf("[INFO] Updating PagerDuty Event Orchestration: %s", d.Id())
 
 	retryErr := resource.Retry(10*time.Second, func() *resource.RetryError {
-		if _, _, err := client.EventOrchestrations.Update(d.Id(), orchestration); err != nil {
+		if orch, _, err := client.EventOrchestrations.Update(d.Id(), orchestration); err != nil {
 			if isErrCode(err, 400) || isErrCode(err, 429) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
+		} else if orch != nil {
+			setEventOrchestrationProps(d, orch)
 		}
 
 		return nil
@@ -207,6 +213,7 @@ func flattenEventOrchestrationIntegrations(eoi []*pagerduty.EventOrchestrationIn
 	for _, i := range eoi {
 		integration := map[string]interface{}{
 			"id":         i.ID,
+			"label":      i.Label,
 			"parameters": flattenEventOrchestrationIntegrationParameters(i.Parameters),
 		}
 		result = append(result, integration)
@@ -228,8 +235,10 @@ func setEventOrchestrationProps(d *schema.ResourceData, o *pagerduty.EventOrches
 	d.Set("description", o.Description)
 	d.Set("routes", o.Routes)
 
-	if o.Team != nil {
+	if o.Team != nil && o.Team.ID != nil {
 		d.Set("team", o.Team.ID)
+	} else {
+		d.Set("team", nil)
 	}
 
 	if len(o.Integrations) > 0 {
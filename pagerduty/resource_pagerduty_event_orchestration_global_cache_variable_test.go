@@ -0,0 +1,181 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestValidateEventOrchestrationCacheVariable(t *testing.T) {
+	config := func(t, regex, source string, ttl int, dataType string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"type":        t,
+				"regex":       regex,
+				"source":      source,
+				"ttl_seconds": ttl,
+				"data_type":   dataType,
+			},
+		}
+	}
+
+	cases := []struct {
+		name          string
+		configuration []interface{}
+		hasConditions bool
+		unknown       string
+		err           string
+	}{
+		{"recent value", config("recent_value", "(.*)", "event.summary", 0, ""), true, "", ""},
+		{"recent value without source", config("recent_value", "(.*)", "", 0, ""), false, "", "configuration.source is required"},
+		{"recent value with ttl", config("recent_value", "(.*)", "event.summary", 60, ""), false, "", "configuration.ttl_seconds cannot be used"},
+		{"trigger event count", config("trigger_event_count", "", "", 300, ""), true, "", ""},
+		{"trigger event count without ttl", config("trigger_event_count", "", "", 0, ""), false, "", "configuration.ttl_seconds is required"},
+		{"trigger event count with regex", config("trigger_event_count", "(.*)", "", 300, ""), false, "", "configuration.regex cannot be used"},
+		{"external data", config("external_data", "", "", 3600, "number"), false, "", ""},
+		{"external data without data type", config("external_data", "", "", 3600, ""), false, "", "configuration.data_type is required"},
+		{"external data with conditions", config("external_data", "", "", 3600, "string"), true, "", "condition cannot be used"},
+		{"unknown type", config("", "", "", 0, ""), false, "", ""},
+		{"recent value with unknown source", config("recent_value", "(.*)", "", 0, ""), false, "source", ""},
+	}
+
+	for _, c := range cases {
+		known := func(field string) bool { return field != c.unknown }
+		err := validateEventOrchestrationCacheVariable(c.configuration, c.hasConditions, known)
+		if c.err == "" && err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+		}
+		if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+			t.Errorf("%s: expected error containing %q, got %v", c.name, c.err, err)
+		}
+	}
+}
+
+func TestAccPagerDutyEventOrchestrationGlobalCacheVariable_Basic(t *testing.T) {
+	team := fmt.Sprintf("tf-name-%s", acctest.RandString(5))
+	orchestration := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_global_cache_variable.cv"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationCacheVariableDestroy(pagerduty.CacheVariableTypeGlobal, "event_orchestration"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyEventOrchestrationGlobalCacheVariableConfig(team, orchestration, `type = "trigger_event_count"`),
+				ExpectError: regexp.MustCompile("configuration.ttl_seconds is required"),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationGlobalCacheVariableConfig(team, orchestration, `
+					type        = "trigger_event_count"
+					ttl_seconds = 300
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationCacheVariableExists(resourceName, pagerduty.CacheVariableTypeGlobal, "event_orchestration"),
+					resource.TestCheckResourceAttr(resourceName, "name", "recent_failures"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.type", "trigger_event_count"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.ttl_seconds", "300"),
+					resource.TestCheckResourceAttr(resourceName, "condition.0.expression", "event.severity matches 'critical'"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationGlobalCacheVariableConfig(team, orchestration, `
+					type   = "recent_value"
+					regex  = "(.*)"
+					source = "event.summary"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.type", "recent_value"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.source", "event.summary"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.ttl_seconds", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccCheckPagerDutyEventOrchestrationCacheVariableImportID(resourceName, "event_orchestration"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationCacheVariableDestroy(cacheVariableType, parentAttr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		for _, r := range s.RootModule().Resources {
+			if r.Type != fmt.Sprintf("pagerduty_event_orchestration_%s_cache_variable", cacheVariableType) {
+				continue
+			}
+			if _, _, err := client.EventOrchestrationCacheVariables.Get(cacheVariableType, r.Primary.Attributes[parentAttr], r.Primary.ID); err == nil {
+				return fmt.Errorf("Event Orchestration Cache Variable still exists")
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyEventOrchestrationCacheVariableExists(rn, cacheVariableType, parentAttr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("Not found: %s", rn)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Event Orchestration Cache Variable ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		found, _, err := client.EventOrchestrationCacheVariables.Get(cacheVariableType, rs.Primary.Attributes[parentAttr], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Event Orchestration Cache Variable not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyEventOrchestrationCacheVariableImportID(rn, parentAttr string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", rn)
+		}
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes[parentAttr], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckPagerDutyEventOrchestrationGlobalCacheVariableConfig(t, o, configuration string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+	name = "%s"
+}
+
+resource "pagerduty_event_orchestration" "orch" {
+	name = "%s"
+	team = pagerduty_team.foo.id
+}
+
+resource "pagerduty_event_orchestration_global_cache_variable" "cv" {
+	event_orchestration = pagerduty_event_orchestration.orch.id
+	name = "recent_failures"
+
+	condition {
+		expression = "event.severity matches 'critical'"
+	}
+
+	configuration {
+		%s
+	}
+}
+`, t, o, configuration)
+}
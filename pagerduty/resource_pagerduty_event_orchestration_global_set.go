@@ -0,0 +1,297 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// Every change to a Global Orchestration replaces all of its sets, so the
+// updates made by pagerduty_event_orchestration_global and by each of its
+// pagerduty_event_orchestration_global_set resources are serialized.
+var (
+	eventOrchestrationGlobalPathLocksMu sync.Mutex
+	eventOrchestrationGlobalPathLocks   = make(map[string]*sync.Mutex)
+
+	// eventOrchestrationGlobalSetClaims holds the sets created by
+	// pagerduty_event_orchestration_global_set resources during this run,
+	// to detect two resources claiming the same set. It is shared by every
+	// Global Orchestration, so it has its own lock.
+	eventOrchestrationGlobalSetClaimsMu sync.Mutex
+	eventOrchestrationGlobalSetClaims   = make(map[string]bool)
+)
+
+// claimEventOrchestrationGlobalSet records that a resource manages the set
+// with the given ID, and returns false if another resource already does.
+func claimEventOrchestrationGlobalSet(id string) bool {
+	eventOrchestrationGlobalSetClaimsMu.Lock()
+	defer eventOrchestrationGlobalSetClaimsMu.Unlock()
+
+	if eventOrchestrationGlobalSetClaims[id] {
+		return false
+	}
+	eventOrchestrationGlobalSetClaims[id] = true
+
+	return true
+}
+
+func releaseEventOrchestrationGlobalSet(id string) {
+	eventOrchestrationGlobalSetClaimsMu.Lock()
+	defer eventOrchestrationGlobalSetClaimsMu.Unlock()
+
+	delete(eventOrchestrationGlobalSetClaims, id)
+}
+
+func lockEventOrchestrationGlobalPath(id string) func() {
+	eventOrchestrationGlobalPathLocksMu.Lock()
+	l, ok := eventOrchestrationGlobalPathLocks[id]
+	if !ok {
+		l = new(sync.Mutex)
+		eventOrchestrationGlobalPathLocks[id] = l
+	}
+	eventOrchestrationGlobalPathLocksMu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+func resourcePagerDutyEventOrchestrationGlobalSet() *schema.Resource {
+	return &schema.Resource{
+		Read:   resourcePagerDutyEventOrchestrationGlobalSetRead,
+		Create: resourcePagerDutyEventOrchestrationGlobalSetCreate,
+		Update: resourcePagerDutyEventOrchestrationGlobalSetUpdate,
+		Delete: resourcePagerDutyEventOrchestrationGlobalSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyEventOrchestrationGlobalSetImport,
+		},
+		CustomizeDiff: checkGlobalSetActions,
+		Schema: map[string]*schema.Schema{
+			"event_orchestration": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"set_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: eventOrchestrationPathGlobalRuleSchema,
+				},
+			},
+		},
+	}
+}
+
+func checkGlobalSetActions(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	rn := diff.Get("rule.#").(int)
+	for ri := 0; ri < rn; ri++ {
+		if err := checkExtractionAttributes(diff, fmt.Sprintf("rule.%d.actions.0.extraction", ri)); err != nil {
+			return err
+		}
+		if err := checkGlobalPathDropEvent(diff, fmt.Sprintf("rule.%d.actions.0", ri)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourcePagerDutyEventOrchestrationGlobalSetRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	oid, setID := d.Get("event_orchestration").(string), d.Get("set_id").(string)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		log.Printf("[INFO] Reading set %s of PagerDuty Event Orchestration Path of type: %s for orchestration: %s", setID, pagerduty.PathTypeGlobal, oid)
+
+		path, _, err := client.EventOrchestrationPaths.Get(oid, pagerduty.PathTypeGlobal)
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		set := findGlobalPathSet(path, setID)
+		if set == nil {
+			log.Printf("[WARN] Removing %s because the set %s is no longer part of the Global Orchestration", d.Id(), setID)
+			d.SetId("")
+			return nil
+		}
+
+		d.Set("rule", flattenGlobalPathRules(set.Rules))
+		return nil
+	})
+}
+
+func resourcePagerDutyEventOrchestrationGlobalSetCreate(d *schema.ResourceData, meta interface{}) error {
+	oid, setID := d.Get("event_orchestration").(string), d.Get("set_id").(string)
+	id := fmt.Sprintf("%s:%s", oid, setID)
+
+	unlock := lockEventOrchestrationGlobalPath(oid)
+	defer unlock()
+
+	if !claimEventOrchestrationGlobalSet(id) {
+		return fmt.Errorf("The set %s of the Global Orchestration of %s is already managed by another pagerduty_event_orchestration_global_set resource", setID, oid)
+	}
+
+	err := updateEventOrchestrationGlobalSetRules(d, meta, func(set *pagerduty.EventOrchestrationPathSet) error {
+		if len(set.Rules) > 0 {
+			return fmt.Errorf("The set %s of the Global Orchestration of %s already has rules, which are managed by another resource. Import the set with the ID %s to manage them with this resource", setID, oid, id)
+		}
+		return nil
+	})
+	if err != nil {
+		releaseEventOrchestrationGlobalSet(id)
+		return err
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+func resourcePagerDutyEventOrchestrationGlobalSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	unlock := lockEventOrchestrationGlobalPath(d.Get("event_orchestration").(string))
+	defer unlock()
+
+	return updateEventOrchestrationGlobalSetRules(d, meta, nil)
+}
+
+// The set itself belongs to the Global Orchestration, so deleting this
+// resource only removes the rules of the set
+func resourcePagerDutyEventOrchestrationGlobalSetDelete(d *schema.ResourceData, meta interface{}) error {
+	unlock := lockEventOrchestrationGlobalPath(d.Get("event_orchestration").(string))
+	defer unlock()
+
+	d.Set("rule", []interface{}{})
+	if err := updateEventOrchestrationGlobalSetRules(d, meta, nil); err != nil && !isErrCode(err, 404) {
+		return err
+	}
+
+	// Releasing the claim lets a resource replacing this one, e.g. with
+	// -replace, create the set again in the same run.
+	releaseEventOrchestrationGlobalSet(d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourcePagerDutyEventOrchestrationGlobalSetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	ids := strings.Split(d.Id(), ":")
+	if len(ids) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_event_orchestration_global_set. Expecting an importation ID formed as '<event_orchestration_id>:<set_id>'")
+	}
+	oid, setID := ids[0], ids[1]
+
+	path, _, err := client.EventOrchestrationPaths.Get(oid, pagerduty.PathTypeGlobal)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+	if findGlobalPathSet(path, setID) == nil {
+		return []*schema.ResourceData{}, fmt.Errorf("The Global Orchestration of %s has no set %s", oid, setID)
+	}
+
+	d.Set("event_orchestration", oid)
+	d.Set("set_id", setID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateEventOrchestrationGlobalSetRules replaces the rules of the set in the
+// Global Orchestration with the configured ones, leaving the other sets and
+// the catch_all unchanged. The check, if any, is run against the current
+// state of the set before it is changed.
+func updateEventOrchestrationGlobalSetRules(d *schema.ResourceData, meta interface{}, check func(*pagerduty.EventOrchestrationPathSet) error) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	oid, setID := d.Get("event_orchestration").(string), d.Get("set_id").(string)
+
+	path, _, err := client.EventOrchestrationPaths.Get(oid, pagerduty.PathTypeGlobal)
+	if err != nil {
+		return err
+	}
+
+	set := findGlobalPathSet(path, setID)
+	if set == nil {
+		return fmt.Errorf("The Global Orchestration of %s has no set %s. Sets are declared by the pagerduty_event_orchestration_global resource, with external_rules set to true for the sets managed by pagerduty_event_orchestration_global_set resources", oid, setID)
+	}
+	if check != nil {
+		if err := check(set); err != nil {
+			return err
+		}
+	}
+
+	rules := expandGlobalPathRules(d.Get("rule"))
+	keepGlobalPathRuleIDs(
+		[]*pagerduty.EventOrchestrationPathSet{{ID: setID, Rules: rules}},
+		[]*pagerduty.EventOrchestrationPathSet{set},
+	)
+	set.Rules = rules
+
+	payload := &pagerduty.EventOrchestrationPath{
+		Parent:   &pagerduty.EventOrchestrationPathReference{ID: oid},
+		Sets:     path.Sets,
+		CatchAll: path.CatchAll,
+	}
+
+	log.Printf("[INFO] Updating set %s of PagerDuty Event Orchestration Path of type: %s for orchestration: %s", setID, pagerduty.PathTypeGlobal, oid)
+
+	var updated *pagerduty.EventOrchestrationPath
+	retryErr := resource.Retry(30*time.Second, func() *resource.RetryError {
+		p, _, err := client.EventOrchestrationPaths.Update(oid, pagerduty.PathTypeGlobal, payload)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+			return resource.RetryableError(err)
+		}
+		updated = p
+		return nil
+	})
+	if retryErr != nil {
+		return retryErr
+	}
+
+	if s := findGlobalPathSet(updated, setID); s != nil {
+		d.Set("rule", flattenGlobalPathRules(s.Rules))
+	}
+
+	return nil
+}
+
+func findGlobalPathSet(path *pagerduty.EventOrchestrationPath, id string) *pagerduty.EventOrchestrationPathSet {
+	if path == nil {
+		return nil
+	}
+	for _, s := range path.Sets {
+		if s.ID == id {
+			return s
+		}
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAccPagerDutyEventOrchestrationGlobalSet_Basic(t *testing.T) {
+	team := fmt.Sprintf("tf-name-%s", acctest.RandString(5))
+	orchestration := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_global_set.team"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyEventOrchestrationGlobalSetConfig(team, orchestration, "", `rule { actions { severity = "info" } }`),
+				ExpectError: regexp.MustCompile("rules cannot be set when external_rules is true"),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationGlobalSetConfig(team, orchestration, `
+					rule {
+						label = "Raise the severity of database events"
+						condition {
+							expression = "event.source matches part 'db'"
+						}
+						actions {
+							severity = "critical"
+						}
+					}
+				`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationGlobalSetRules(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.label", "Raise the severity of database events"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.actions.0.severity", "critical"),
+					resource.TestCheckResourceAttr("pagerduty_event_orchestration_global.global", "set.1.external_rules", "true"),
+					resource.TestCheckResourceAttr("pagerduty_event_orchestration_global.global", "set.1.rule.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationGlobalSetConfig(team, orchestration, `
+					rule {
+						label = "Raise the severity of database events"
+						condition {
+							expression = "event.source matches part 'db'"
+						}
+						actions {
+							severity = "critical"
+						}
+					}
+				`, "") + `
+resource "pagerduty_event_orchestration_global_set" "duplicate" {
+  event_orchestration = pagerduty_event_orchestration.orch.id
+  set_id              = "team"
+  depends_on          = [pagerduty_event_orchestration_global_set.team]
+}
+`,
+				ExpectError: regexp.MustCompile("already"),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationGlobalSetConfig(team, orchestration, rules, centralRules string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "team" {
+  name = "%s"
+}
+
+resource "pagerduty_event_orchestration" "orch" {
+  name = "%s"
+  team = pagerduty_team.team.id
+}
+
+resource "pagerduty_event_orchestration_global" "global" {
+  event_orchestration = pagerduty_event_orchestration.orch.id
+  set {
+    id = "start"
+    rule {
+      label = "Route to the team set"
+      actions {
+        route_to = "team"
+      }
+    }
+  }
+  set {
+    id             = "team"
+    external_rules = true
+    %s
+  }
+  catch_all {
+    actions {}
+  }
+}
+
+resource "pagerduty_event_orchestration_global_set" "team" {
+  event_orchestration = pagerduty_event_orchestration.orch.id
+  set_id              = "team"
+  %s
+  depends_on          = [pagerduty_event_orchestration_global.global]
+}
+`, team, orchestration, centralRules, rules)
+}
+
+func testAccCheckPagerDutyEventOrchestrationGlobalSetRules(rn string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		r, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("Not found: %s", rn)
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		path, _, err := client.EventOrchestrationPaths.Get(r.Primary.Attributes["event_orchestration"], pagerduty.PathTypeGlobal)
+		if err != nil {
+			return err
+		}
+
+		set := findGlobalPathSet(path, r.Primary.Attributes["set_id"])
+		if set == nil {
+			return fmt.Errorf("Global Orchestration has no set %s", r.Primary.Attributes["set_id"])
+		}
+		if len(set.Rules) != count {
+			return fmt.Errorf("expected %d rules in set %s, found %d", count, set.ID, len(set.Rules))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,251 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyEventOrchestrationIntegration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyEventOrchestrationIntegrationCreate,
+		Read:   resourcePagerDutyEventOrchestrationIntegrationRead,
+		Update: resourcePagerDutyEventOrchestrationIntegrationUpdate,
+		Delete: resourcePagerDutyEventOrchestrationIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyEventOrchestrationIntegrationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"event_orchestration": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"routing_key": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildEventOrchestrationIntegrationStruct(d *schema.ResourceData) *pagerduty.EventOrchestrationIntegration {
+	return &pagerduty.EventOrchestrationIntegration{
+		Label: d.Get("label").(string),
+	}
+}
+
+func resourcePagerDutyEventOrchestrationIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	oid := d.Get("event_orchestration").(string)
+	payload := buildEventOrchestrationIntegrationStruct(d)
+	var integration *pagerduty.EventOrchestrationIntegration
+
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		log.Printf("[INFO] Creating PagerDuty Event Orchestration Integration %s on orchestration %s", payload.Label, oid)
+
+		i, _, err := client.EventOrchestrations.CreateIntegration(oid, payload)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+		integration = i
+		return nil
+	})
+	if retryErr != nil {
+		return retryErr
+	}
+
+	d.SetId(integration.ID)
+	setEventOrchestrationIntegrationProps(d, integration)
+
+	return nil
+}
+
+func resourcePagerDutyEventOrchestrationIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	oid := d.Get("event_orchestration").(string)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		log.Printf("[INFO] Reading PagerDuty Event Orchestration Integration %s on orchestration %s", d.Id(), oid)
+
+		integration, _, err := client.EventOrchestrations.GetIntegration(oid, d.Id())
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		setEventOrchestrationIntegrationProps(d, integration)
+
+		return nil
+	})
+}
+
+func resourcePagerDutyEventOrchestrationIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	o, n := d.GetChange("event_orchestration")
+	oid := n.(string)
+
+	// Moving the integration keeps its ID and routing key, so event sources
+	// don't need to be reconfigured
+	if d.HasChange("event_orchestration") {
+		log.Printf("[INFO] Migrating PagerDuty Event Orchestration Integration %s from orchestration %s to %s", d.Id(), o.(string), oid)
+
+		retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+			if _, _, err := client.EventOrchestrations.MigrateIntegration(oid, o.(string), d.Id()); err != nil {
+				if isErrCode(err, 400) || isErrCode(err, 404) {
+					return resource.NonRetryableError(err)
+				}
+				time.Sleep(30 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return nil
+		})
+		if retryErr != nil {
+			return retryErr
+		}
+	}
+
+	if d.HasChange("label") {
+		payload := buildEventOrchestrationIntegrationStruct(d)
+
+		log.Printf("[INFO] Updating PagerDuty Event Orchestration Integration %s on orchestration %s", d.Id(), oid)
+
+		retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+			integration, _, err := client.EventOrchestrations.UpdateIntegration(oid, d.Id(), payload)
+			if err != nil {
+				if isErrCode(err, 400) || isErrCode(err, 404) {
+					return resource.NonRetryableError(err)
+				}
+				time.Sleep(30 * time.Second)
+				return resource.RetryableError(err)
+			}
+			setEventOrchestrationIntegrationProps(d, integration)
+			return nil
+		})
+		if retryErr != nil {
+			return retryErr
+		}
+	}
+
+	return resourcePagerDutyEventOrchestrationIntegrationRead(d, meta)
+}
+
+func resourcePagerDutyEventOrchestrationIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	oid := d.Get("event_orchestration").(string)
+
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		log.Printf("[INFO] Deleting PagerDuty Event Orchestration Integration %s on orchestration %s", d.Id(), oid)
+
+		if _, err := client.EventOrchestrations.DeleteIntegration(oid, d.Id()); err != nil {
+			if isErrCode(err, 404) {
+				return nil
+			}
+			if isErrCode(err, 400) {
+				return resource.NonRetryableError(err)
+			}
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+		return nil
+	})
+	if retryErr != nil {
+		return retryErr
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyEventOrchestrationIntegrationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	ids := strings.Split(d.Id(), ":")
+
+	if len(ids) == 1 {
+		resp, _, err := client.EventOrchestrations.ListIntegrations(ids[0])
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		var childIDs []string
+		for _, i := range resp.Integrations {
+			childIDs = append(childIDs, fmt.Sprintf("%s:%s", ids[0], i.ID))
+		}
+		return []*schema.ResourceData{}, importByParentIDError("pagerduty_event_orchestration_integration", ids[0], childIDs)
+	}
+
+	if len(ids) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_event_orchestration_integration. Expecting an importation ID formed as '<event_orchestration_id>:<integration_id>'")
+	}
+	oid, id := ids[0], ids[1]
+
+	if _, _, err := client.EventOrchestrations.GetIntegration(oid, id); err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(id)
+	d.Set("event_orchestration", oid)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setEventOrchestrationIntegrationProps(d *schema.ResourceData, i *pagerduty.EventOrchestrationIntegration) {
+	d.Set("label", i.Label)
+
+	if i.Parameters != nil {
+		d.Set("parameters", flattenEventOrchestrationIntegrationParameters(i.Parameters))
+	}
+}
@@ -0,0 +1,121 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyEventOrchestrationIntegration_Basic(t *testing.T) {
+	team := fmt.Sprintf("tf-name-%s", acctest.RandString(5))
+	orchestration := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_integration.int"
+	var id, routingKey string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationIntegrationConfig(team, orchestration, "first", "Datadog"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationIntegrationExists(resourceName, &id, &routingKey),
+					resource.TestCheckResourceAttr(resourceName, "label", "Datadog"),
+					resource.TestCheckResourceAttrPair(resourceName, "event_orchestration", "pagerduty_event_orchestration.first", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "parameters.0.routing_key"),
+				),
+			},
+			// Moving the integration keeps its ID and routing key
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationIntegrationConfig(team, orchestration, "second", "Datadog (migrated)"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "label", "Datadog (migrated)"),
+					resource.TestCheckResourceAttrPair(resourceName, "event_orchestration", "pagerduty_event_orchestration.second", "id"),
+					testAccCheckPagerDutyEventOrchestrationIntegrationUnchanged(resourceName, &id, &routingKey),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationIntegrationDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_event_orchestration_integration" {
+			continue
+		}
+		if _, _, err := client.EventOrchestrations.GetIntegration(r.Primary.Attributes["event_orchestration"], r.Primary.ID); err == nil {
+			return fmt.Errorf("Event Orchestration Integration still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyEventOrchestrationIntegrationExists(rn string, id, routingKey *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("Not found: %s", rn)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Event Orchestration Integration ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		found, _, err := client.EventOrchestrations.GetIntegration(rs.Primary.Attributes["event_orchestration"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Event Orchestration Integration not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		*id = found.ID
+		*routingKey = found.Parameters.RoutingKey
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyEventOrchestrationIntegrationUnchanged(rn string, id, routingKey *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("Not found: %s", rn)
+		}
+		if rs.Primary.ID != *id {
+			return fmt.Errorf("Expected the integration to keep its ID %s, got %s", *id, rs.Primary.ID)
+		}
+		if rk := rs.Primary.Attributes["parameters.0.routing_key"]; rk != *routingKey {
+			return fmt.Errorf("Expected the integration to keep its routing key %s, got %s", *routingKey, rk)
+		}
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyEventOrchestrationIntegrationConfig(t, o, parent, label string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+  name = "%[1]s"
+}
+
+resource "pagerduty_event_orchestration" "first" {
+  name = "%[2]s-first"
+  team = pagerduty_team.foo.id
+}
+
+resource "pagerduty_event_orchestration" "second" {
+  name = "%[2]s-second"
+  team = pagerduty_team.foo.id
+}
+
+resource "pagerduty_event_orchestration_integration" "int" {
+  event_orchestration = pagerduty_event_orchestration.%[3]s.id
+  label               = "%[4]s"
+}
+`, t, o, parent, label)
+}
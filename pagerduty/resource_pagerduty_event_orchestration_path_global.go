@@ -0,0 +1,575 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+var eventOrchestrationPathGlobalCatchAllActionsSchema = buildEventOrchestrationPathGlobalActionsSchema(false)
+
+var eventOrchestrationPathGlobalRuleActionsSchema = buildEventOrchestrationPathGlobalActionsSchema(true)
+
+// buildEventOrchestrationPathGlobalActionsSchema returns the actions of a
+// Global Orchestration. They match the Service Orchestration ones, except
+// events can be dropped and rules can only route to other sets.
+func buildEventOrchestrationPathGlobalActionsSchema(routeTo bool) map[string]*schema.Schema {
+	a := map[string]*schema.Schema{
+		"drop_event": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+	}
+
+	for k, v := range eventOrchestrationPathServiceCatchAllActionsSchema {
+		if k == "pagerduty_automation_action" || k == "route_to" {
+			continue
+		}
+		a[k] = v
+	}
+
+	if routeTo {
+		a["route_to"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+	}
+
+	return a
+}
+
+var eventOrchestrationPathGlobalRuleSchema = map[string]*schema.Schema{
+	"id": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"label": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"condition": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: eventOrchestrationPathConditionsSchema,
+		},
+	},
+	"active_between": {
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: eventOrchestrationPathActiveBetweenSchema,
+		},
+	},
+	"actions": {
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: eventOrchestrationPathGlobalRuleActionsSchema,
+		},
+	},
+	"disabled": {
+		Type:     schema.TypeBool,
+		Optional: true,
+	},
+}
+
+func resourcePagerDutyEventOrchestrationPathGlobal() *schema.Resource {
+	return &schema.Resource{
+		Read:   resourcePagerDutyEventOrchestrationPathGlobalRead,
+		Create: resourcePagerDutyEventOrchestrationPathGlobalCreate,
+		Update: resourcePagerDutyEventOrchestrationPathGlobalUpdate,
+		Delete: resourcePagerDutyEventOrchestrationPathGlobalDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyEventOrchestrationPathGlobalImport,
+		},
+		CustomizeDiff: checkGlobalPathActions,
+		Schema: map[string]*schema.Schema{
+			"event_orchestration": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"set": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1, // A Global Orchestration must contain at least a "start" set
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: eventOrchestrationPathGlobalRuleSchema,
+							},
+						},
+						"external_rules": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"catch_all": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: eventOrchestrationPathGlobalCatchAllActionsSchema,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func checkGlobalPathActions(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	if err := checkExtractions(ctx, diff, i); err != nil {
+		return err
+	}
+
+	sn := diff.Get("set.#").(int)
+	for si := 0; si < sn; si++ {
+		rn := diff.Get(fmt.Sprintf("set.%d.rule.#", si)).(int)
+		if rn > 0 && diff.Get(fmt.Sprintf("set.%d.external_rules", si)).(bool) {
+			return fmt.Errorf("Invalid configuration in set.%d: rules cannot be set when external_rules is true, they are managed by a pagerduty_event_orchestration_global_set resource", si)
+		}
+		for ri := 0; ri < rn; ri++ {
+			if err := checkGlobalPathDropEvent(diff, fmt.Sprintf("set.%d.rule.%d.actions.0", si, ri)); err != nil {
+				return err
+			}
+		}
+	}
+	return checkGlobalPathDropEvent(diff, "catch_all.0.actions.0")
+}
+
+// checkGlobalPathDropEvent rejects actions combining drop_event with any other
+// action, since dropped events are discarded before other actions apply.
+func checkGlobalPathDropEvent(diff *schema.ResourceDiff, loc string) error {
+	a, ok := diff.Get(loc).(map[string]interface{})
+	if !ok || !a["drop_event"].(bool) {
+		return nil
+	}
+
+	for k, v := range a {
+		if k == "drop_event" {
+			continue
+		}
+
+		set := false
+		switch val := v.(type) {
+		case string:
+			set = val != ""
+		case bool:
+			set = val
+		case int:
+			set = val != 0
+		case []interface{}:
+			set = len(val) > 0
+		}
+
+		if set {
+			return fmt.Errorf("Invalid configuration in %s: drop_event cannot be combined with %s", loc, k)
+		}
+	}
+	return nil
+}
+
+func resourcePagerDutyEventOrchestrationPathGlobalRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		log.Printf("[INFO] Reading PagerDuty Event Orchestration Path of type: %s for orchestration: %s", pagerduty.PathTypeGlobal, d.Id())
+
+		path, _, err := client.EventOrchestrationPaths.Get(d.Id(), pagerduty.PathTypeGlobal)
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		if path != nil {
+			setEventOrchestrationPathGlobalProps(d, path)
+		}
+		return nil
+	})
+}
+
+// EventOrchestrationPath cannot be created, use update to add / edit / remove rules and sets
+func resourcePagerDutyEventOrchestrationPathGlobalCreate(d *schema.ResourceData, meta interface{}) error {
+	return resourcePagerDutyEventOrchestrationPathGlobalUpdate(d, meta)
+}
+
+func resourcePagerDutyEventOrchestrationPathGlobalUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	payload := buildGlobalPathStruct(d)
+	var globalPath *pagerduty.EventOrchestrationPath
+
+	unlock := lockEventOrchestrationGlobalPath(payload.Parent.ID)
+	defer unlock()
+
+	if err := keepGlobalPathExternalRules(client, payload, d.Get("set")); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating PagerDuty Event Orchestration Path of type: %s for orchestration: %s", pagerduty.PathTypeGlobal, payload.Parent.ID)
+
+	retryErr := resource.Retry(30*time.Second, func() *resource.RetryError {
+		path, _, err := client.EventOrchestrationPaths.Update(payload.Parent.ID, pagerduty.PathTypeGlobal, payload)
+		if err != nil {
+			return resource.RetryableError(err)
+		}
+		if path == nil {
+			return resource.NonRetryableError(fmt.Errorf("no event orchestration global found"))
+		}
+		globalPath = path
+		return nil
+	})
+	if retryErr != nil {
+		return retryErr
+	}
+
+	d.SetId(payload.Parent.ID)
+	setEventOrchestrationPathGlobalProps(d, globalPath)
+
+	return nil
+}
+
+// EventOrchestrationPath cannot be deleted, use update to add / edit / remove rules and sets
+func resourcePagerDutyEventOrchestrationPathGlobalDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func resourcePagerDutyEventOrchestrationPathGlobalImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	id := d.Id()
+
+	if _, _, err := client.EventOrchestrationPaths.Get(id, pagerduty.PathTypeGlobal); err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(id)
+	d.Set("event_orchestration", id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// keepGlobalPathExternalRules copies the current rules of the sets with
+// external_rules into the payload, so updating the Global Orchestration
+// leaves the rules of pagerduty_event_orchestration_global_set resources as
+// they are.
+func keepGlobalPathExternalRules(client *pagerduty.Client, payload *pagerduty.EventOrchestrationPath, v interface{}) error {
+	external := globalPathExternalSets(v)
+	if len(external) == 0 {
+		return nil
+	}
+
+	current, _, err := client.EventOrchestrationPaths.Get(payload.Parent.ID, pagerduty.PathTypeGlobal)
+	if err != nil {
+		return err
+	}
+
+	rules := make(map[string][]*pagerduty.EventOrchestrationPathRule)
+	for _, s := range current.Sets {
+		rules[s.ID] = s.Rules
+	}
+	for _, s := range payload.Sets {
+		if external[s.ID] && rules[s.ID] != nil {
+			s.Rules = rules[s.ID]
+		}
+	}
+
+	return nil
+}
+
+// globalPathExternalSets returns the IDs of the sets whose rules are managed
+// by pagerduty_event_orchestration_global_set resources.
+func globalPathExternalSets(v interface{}) map[string]bool {
+	external := make(map[string]bool)
+	for _, set := range v.([]interface{}) {
+		s, ok := set.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if e, _ := s["external_rules"].(bool); e {
+			external[s["id"].(string)] = true
+		}
+	}
+	return external
+}
+
+func buildGlobalPathStruct(d *schema.ResourceData) *pagerduty.EventOrchestrationPath {
+	sets := expandGlobalPathSets(d.Get("set"))
+	o, _ := d.GetChange("set")
+	keepGlobalPathRuleIDs(sets, expandGlobalPathSets(o))
+
+	return &pagerduty.EventOrchestrationPath{
+		Parent: &pagerduty.EventOrchestrationPathReference{
+			ID: d.Get("event_orchestration").(string),
+		},
+		Sets:     sets,
+		CatchAll: expandGlobalPathCatchAll(d.Get("catch_all")),
+	}
+}
+
+// keepGlobalPathRuleIDs ties the computed rule IDs to the rule labels rather
+// than to their position in the set, so inserting, removing or reordering
+// rules doesn't hand the ID of one rule to another. Rules without a label,
+// or with a label shared by several rules in a set, keep their position's ID.
+func keepGlobalPathRuleIDs(sets []*pagerduty.EventOrchestrationPathSet, prev []*pagerduty.EventOrchestrationPathSet) {
+	prevIDs := make(map[string]map[string]string)
+	for _, s := range prev {
+		ids := make(map[string]string)
+		seen := make(map[string]int)
+		for _, r := range s.Rules {
+			if r.Label == "" {
+				continue
+			}
+			seen[r.Label]++
+			ids[r.Label] = r.ID
+		}
+		for l, n := range seen {
+			if n > 1 {
+				delete(ids, l)
+			}
+		}
+		prevIDs[s.ID] = ids
+	}
+
+	for _, s := range sets {
+		ids := prevIDs[s.ID]
+		matched := make(map[*pagerduty.EventOrchestrationPathRule]bool)
+		claimed := make(map[string]bool)
+		for _, r := range s.Rules {
+			if id, ok := ids[r.Label]; ok && r.Label != "" {
+				r.ID = id
+				matched[r] = true
+				claimed[id] = true
+			}
+		}
+		for _, r := range s.Rules {
+			if !matched[r] && claimed[r.ID] {
+				r.ID = ""
+			}
+		}
+	}
+}
+
+func expandGlobalPathSets(v interface{}) []*pagerduty.EventOrchestrationPathSet {
+	var sets []*pagerduty.EventOrchestrationPathSet
+
+	for _, set := range v.([]interface{}) {
+		s := set.(map[string]interface{})
+
+		orchPathSet := &pagerduty.EventOrchestrationPathSet{
+			ID:    s["id"].(string),
+			Rules: expandGlobalPathRules(s["rule"]),
+		}
+
+		sets = append(sets, orchPathSet)
+	}
+
+	return sets
+}
+
+func expandGlobalPathRules(v interface{}) []*pagerduty.EventOrchestrationPathRule {
+	rules := []*pagerduty.EventOrchestrationPathRule{}
+
+	for _, rule := range v.([]interface{}) {
+		r := rule.(map[string]interface{})
+
+		ruleInSet := &pagerduty.EventOrchestrationPathRule{
+			ID:         r["id"].(string),
+			Label:      r["label"].(string),
+			Disabled:   r["disabled"].(bool),
+			Conditions: expandEventOrchestrationPathActiveBetween(expandEventOrchestrationPathConditions(r["condition"]), r["active_between"]),
+			Actions:    expandGlobalPathActions(r["actions"]),
+		}
+
+		rules = append(rules, ruleInSet)
+	}
+	return rules
+}
+
+func expandGlobalPathCatchAll(v interface{}) *pagerduty.EventOrchestrationPathCatchAll {
+	var catchAll = new(pagerduty.EventOrchestrationPathCatchAll)
+
+	for _, ca := range v.([]interface{}) {
+		if ca != nil {
+			am := ca.(map[string]interface{})
+			catchAll.Actions = expandGlobalPathActions(am["actions"])
+		}
+	}
+
+	return catchAll
+}
+
+func expandGlobalPathActions(v interface{}) *pagerduty.EventOrchestrationPathRuleActions {
+	var actions = &pagerduty.EventOrchestrationPathRuleActions{
+		AutomationActions: []*pagerduty.EventOrchestrationPathAutomationAction{},
+		Variables:         []*pagerduty.EventOrchestrationPathActionVariables{},
+		Extractions:       []*pagerduty.EventOrchestrationPathActionExtractions{},
+	}
+
+	for _, i := range v.([]interface{}) {
+		if i == nil {
+			continue
+		}
+		a := i.(map[string]interface{})
+
+		if routeTo, ok := a["route_to"]; ok {
+			actions.RouteTo = routeTo.(string)
+		}
+		actions.DropEvent = a["drop_event"].(bool)
+		actions.Suppress = a["suppress"].(bool)
+		actions.Suspend = intTypeToIntPtr(a["suspend"].(int))
+		actions.Priority = a["priority"].(string)
+		actions.EscalationPolicy = a["escalation_policy"].(string)
+		actions.Annotate = a["annotate"].(string)
+		actions.Severity = a["severity"].(string)
+		actions.EventAction = a["event_action"].(string)
+		actions.AutomationActions = expandServicePathAutomationActions(a["automation_action"])
+		actions.Variables = expandEventOrchestrationPathVariables(a["variable"])
+		actions.Extractions = expandEventOrchestrationPathExtractions(a["extraction"])
+		actions.IncidentCustomFieldUpdates = expandEventOrchestrationPathIncidentCustomFieldUpdates(a["incident_custom_field_update"])
+	}
+
+	return actions
+}
+
+func setEventOrchestrationPathGlobalProps(d *schema.ResourceData, p *pagerduty.EventOrchestrationPath) error {
+	if p.Parent != nil {
+		d.Set("event_orchestration", p.Parent.ID)
+	}
+	sets := flattenGlobalPathSets(p.Sets)
+	external := globalPathExternalSets(d.Get("set"))
+	for _, set := range sets {
+		s := set.(map[string]interface{})
+		if external[s["id"].(string)] {
+			s["external_rules"] = true
+			s["rule"] = nil
+		}
+	}
+	d.Set("set", sets)
+	if p.CatchAll != nil {
+		d.Set("catch_all", flattenGlobalPathCatchAll(p.CatchAll))
+	}
+	return nil
+}
+
+func flattenGlobalPathSets(orchPathSets []*pagerduty.EventOrchestrationPathSet) []interface{} {
+	var flattenedSets []interface{}
+
+	for _, set := range orchPathSets {
+		flattenedSet := map[string]interface{}{
+			"id":   set.ID,
+			"rule": flattenGlobalPathRules(set.Rules),
+		}
+		flattenedSets = append(flattenedSets, flattenedSet)
+	}
+	return flattenedSets
+}
+
+func flattenGlobalPathRules(rules []*pagerduty.EventOrchestrationPathRule) []interface{} {
+	var flattenedRules []interface{}
+
+	for _, rule := range rules {
+		conditions, activeBetween := flattenEventOrchestrationPathActiveBetween(rule.Conditions)
+
+		flattenedRule := map[string]interface{}{
+			"id":             rule.ID,
+			"label":          rule.Label,
+			"disabled":       rule.Disabled,
+			"condition":      flattenEventOrchestrationPathConditions(conditions),
+			"active_between": activeBetween,
+			"actions":        flattenGlobalPathActions(rule.Actions, true),
+		}
+		flattenedRules = append(flattenedRules, flattenedRule)
+	}
+
+	return flattenedRules
+}
+
+func flattenGlobalPathCatchAll(catchAll *pagerduty.EventOrchestrationPathCatchAll) []map[string]interface{} {
+	var caMap []map[string]interface{}
+
+	c := make(map[string]interface{})
+
+	c["actions"] = flattenGlobalPathActions(catchAll.Actions, false)
+	caMap = append(caMap, c)
+
+	return caMap
+}
+
+func flattenGlobalPathActions(actions *pagerduty.EventOrchestrationPathRuleActions, routeTo bool) []map[string]interface{} {
+	var actionsMap []map[string]interface{}
+
+	if actions == nil {
+		return actionsMap
+	}
+
+	flattenedAction := map[string]interface{}{
+		"drop_event":        actions.DropEvent,
+		"severity":          actions.Severity,
+		"event_action":      actions.EventAction,
+		"suppress":          actions.Suppress,
+		"suspend":           actions.Suspend,
+		"priority":          actions.Priority,
+		"escalation_policy": actions.EscalationPolicy,
+		"annotate":          actions.Annotate,
+	}
+
+	if routeTo {
+		flattenedAction["route_to"] = actions.RouteTo
+	}
+	if actions.Variables != nil {
+		flattenedAction["variable"] = flattenEventOrchestrationPathVariables(actions.Variables)
+	}
+	if actions.Extractions != nil {
+		flattenedAction["extraction"] = flattenEventOrchestrationPathExtractions(actions.Extractions)
+	}
+	if actions.AutomationActions != nil {
+		flattenedAction["automation_action"] = flattenServicePathAutomationActions(actions.AutomationActions)
+	}
+	if actions.IncidentCustomFieldUpdates != nil {
+		flattenedAction["incident_custom_field_update"] = flattenEventOrchestrationPathIncidentCustomFieldUpdates(actions.IncidentCustomFieldUpdates)
+	}
+
+	actionsMap = append(actionsMap, flattenedAction)
+
+	return actionsMap
+}
@@ -0,0 +1,453 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func init() {
+	resource.AddTestSweepers("pagerduty_event_orchestration_global", &resource.Sweeper{
+		Name: "pagerduty_event_orchestration_global",
+		F:    testSweepEventOrchestration,
+	})
+}
+
+func TestKeepGlobalPathRuleIDs(t *testing.T) {
+	prev := []*pagerduty.EventOrchestrationPathSet{
+		{
+			ID: "start",
+			Rules: []*pagerduty.EventOrchestrationPathRule{
+				{ID: "a", Label: "drop"},
+				{ID: "b", Label: "route"},
+				{ID: "c"},
+			},
+		},
+	}
+	// A new rule inserted on top takes the ID of "drop" by position
+	sets := []*pagerduty.EventOrchestrationPathSet{
+		{
+			ID: "start",
+			Rules: []*pagerduty.EventOrchestrationPathRule{
+				{ID: "a", Label: "new"},
+				{ID: "b", Label: "drop"},
+				{ID: "c", Label: "route"},
+				{ID: ""},
+			},
+		},
+	}
+
+	keepGlobalPathRuleIDs(sets, prev)
+
+	expected := []string{"", "a", "b", ""}
+	for i, r := range sets[0].Rules {
+		if r.ID != expected[i] {
+			t.Errorf("rule %d: expected ID %q, got %q", i, expected[i], r.ID)
+		}
+	}
+}
+
+func TestEventOrchestrationPathActiveBetween(t *testing.T) {
+	activeBetween := []interface{}{
+		map[string]interface{}{
+			"days":       []interface{}{"Mon", "Fri"},
+			"start_time": "09:00:00",
+			"end_time":   "17:30:00",
+			"time_zone":  "America/New_York",
+		},
+	}
+
+	cases := map[string]struct {
+		conditions []*pagerduty.EventOrchestrationPathRuleCondition
+		expected   []string
+	}{
+		"no conditions": {
+			expected: []string{"(now in Mon,Fri 09:00:00 to 17:30:00 America/New_York)"},
+		},
+		"conditions": {
+			conditions: []*pagerduty.EventOrchestrationPathRuleCondition{
+				{Expression: "event.summary matches part 'db'"},
+				{Expression: "event.severity matches 'critical'"},
+			},
+			expected: []string{
+				"(event.summary matches part 'db') and (now in Mon,Fri 09:00:00 to 17:30:00 America/New_York)",
+				"(event.severity matches 'critical') and (now in Mon,Fri 09:00:00 to 17:30:00 America/New_York)",
+			},
+		},
+	}
+
+	for name, c := range cases {
+		var original []string
+		for _, cond := range c.conditions {
+			original = append(original, cond.Expression)
+		}
+
+		conditions := expandEventOrchestrationPathActiveBetween(c.conditions, activeBetween)
+		if len(conditions) != len(c.expected) {
+			t.Fatalf("%s: expected %d conditions, got %d", name, len(c.expected), len(conditions))
+		}
+		for i, cond := range conditions {
+			if cond.Expression != c.expected[i] {
+				t.Errorf("%s: expected condition %q, got %q", name, c.expected[i], cond.Expression)
+			}
+		}
+
+		flattened, flattenedActiveBetween := flattenEventOrchestrationPathActiveBetween(conditions)
+		if len(flattened) != len(original) {
+			t.Fatalf("%s: expected %d flattened conditions, got %d", name, len(original), len(flattened))
+		}
+		for i, cond := range flattened {
+			if cond.Expression != original[i] {
+				t.Errorf("%s: expected flattened condition %q, got %q", name, original[i], cond.Expression)
+			}
+		}
+		ab := flattenedActiveBetween[0].(map[string]interface{})
+		if ab["start_time"] != "09:00:00" || ab["end_time"] != "17:30:00" || ab["time_zone"] != "America/New_York" || len(ab["days"].([]string)) != 2 {
+			t.Errorf("%s: unexpected active_between %v", name, ab)
+		}
+	}
+
+	// Conditions that don't all share the same window are kept as they are
+	mixed := []*pagerduty.EventOrchestrationPathRuleCondition{
+		{Expression: "(event.summary matches part 'db') and (now in Mon 09:00:00 to 17:00:00 UTC)"},
+		{Expression: "event.severity matches 'critical'"},
+	}
+	if flattened, ab := flattenEventOrchestrationPathActiveBetween(mixed); ab != nil || flattened[0].Expression != mixed[0].Expression {
+		t.Errorf("expected mixed conditions to be kept, got %v and %v", flattened, ab)
+	}
+}
+
+func TestEventOrchestrationPathIncidentCustomFieldUpdates(t *testing.T) {
+	updates := []interface{}{
+		map[string]interface{}{"id": "PFIELD1", "value": "us-east-1"},
+		map[string]interface{}{"id": "PFIELD2", "value": "{{event.custom_details.component}}"},
+	}
+	configured := []interface{}{
+		map[string]interface{}{
+			"route_to":                     "",
+			"drop_event":                   false,
+			"suppress":                     false,
+			"suspend":                      0,
+			"priority":                     "",
+			"escalation_policy":            "",
+			"annotate":                     "",
+			"severity":                     "",
+			"event_action":                 "",
+			"automation_action":            []interface{}{},
+			"variable":                     []interface{}{},
+			"extraction":                   []interface{}{},
+			"incident_custom_field_update": updates,
+		},
+	}
+
+	actions := expandGlobalPathActions(configured)
+	b, err := json.Marshal(actions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"incident_custom_field_updates":[{"id":"PFIELD1","value":"us-east-1"},`) {
+		t.Errorf("expected the custom field updates to be sent, got %s", b)
+	}
+
+	flattened := flattenGlobalPathActions(actions, true)
+	if !reflect.DeepEqual(flattened[0]["incident_custom_field_update"], updates) {
+		t.Errorf("expected %v, got %v", updates, flattened[0]["incident_custom_field_update"])
+	}
+
+	// Router and unrouted orchestrations don't support the action, so it is
+	// left out of their requests
+	b, err = json.Marshal(&pagerduty.EventOrchestrationPathRuleActions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "incident_custom_field_updates") {
+		t.Errorf("expected no custom field updates to be sent, got %s", b)
+	}
+}
+
+func TestAccPagerDutyEventOrchestrationPathGlobal_Basic(t *testing.T) {
+	team := fmt.Sprintf("tf-name-%s", acctest.RandString(5))
+	orchestration := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_global.global"
+	var dropRuleID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationPathGlobalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathGlobalConfigNoRules(team, orchestration),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathGlobalExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "set.0.rule.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathGlobalConfigWithRules(team, orchestration),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathGlobalExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "set.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "set.0.rule.0.label", "drop heartbeats"),
+					resource.TestCheckResourceAttr(resourceName, "set.0.rule.0.actions.0.drop_event", "true"),
+					resource.TestCheckResourceAttr(resourceName, "set.0.rule.1.actions.0.route_to", "enrich"),
+					resource.TestCheckResourceAttr(resourceName, "set.1.rule.0.actions.0.variable.0.name", "hostname"),
+					resource.TestCheckResourceAttr(resourceName, "set.1.rule.0.actions.0.extraction.0.target", "event.custom_details.host"),
+					resource.TestCheckResourceAttr(resourceName, "catch_all.0.actions.0.severity", "info"),
+					testAccCheckPagerDutyEventOrchestrationPathGlobalRuleID(resourceName, "set.0.rule.0.id", &dropRuleID, true),
+				),
+			},
+			// Inserting a rule on top doesn't change the ID of the existing ones
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathGlobalConfigWithInsertedRule(team, orchestration),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "set.0.rule.1.label", "drop heartbeats"),
+					testAccCheckPagerDutyEventOrchestrationPathGlobalRuleID(resourceName, "set.0.rule.1.id", &dropRuleID, false),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathGlobalConfigActiveBetween(team, orchestration),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "set.0.rule.0.condition.0.expression", "event.severity matches 'critical'"),
+					resource.TestCheckResourceAttr(resourceName, "set.0.rule.0.active_between.0.days.#", "5"),
+					resource.TestCheckResourceAttr(resourceName, "set.0.rule.0.active_between.0.time_zone", "Europe/Paris"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyEventOrchestrationPathGlobalConfigInvalidTimeZone(team, orchestration),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("unknown time zone Europe/Nowhere"),
+			},
+			{
+				Config:      testAccCheckPagerDutyEventOrchestrationPathGlobalConfigInvalidDrop(team, orchestration),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid configuration in set.0.rule.0.actions.0: drop_event cannot be combined with severity"),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathGlobalDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_event_orchestration" {
+			continue
+		}
+
+		if _, _, err := client.EventOrchestrationPaths.Get(r.Primary.ID, pagerduty.PathTypeGlobal); err == nil {
+			return fmt.Errorf("Event Orchestration Global Path still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathGlobalExists(rn string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("Not found: %s", rn)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Event Orchestration Global Path is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		if _, _, err := client.EventOrchestrationPaths.Get(rs.Primary.ID, pagerduty.PathTypeGlobal); err != nil {
+			return fmt.Errorf("Event Orchestration Global Path not found for orchestration %v", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathGlobalRuleID(rn, attr string, id *string, capture bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("Not found: %s", rn)
+		}
+
+		v := rs.Primary.Attributes[attr]
+		if v == "" {
+			return fmt.Errorf("Expected %s to be set", attr)
+		}
+		if capture {
+			*id = v
+			return nil
+		}
+		if v != *id {
+			return fmt.Errorf("Expected %s to be %s, got %s", attr, *id, v)
+		}
+		return nil
+	}
+}
+
+func createGlobalBaseConfig(t, o string) string {
+	return fmt.Sprintf(`
+		resource "pagerduty_team" "foo" {
+			name = "%s"
+		}
+
+		resource "pagerduty_event_orchestration" "orch" {
+			name = "%s"
+			team = pagerduty_team.foo.id
+		}
+	`, t, o)
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathGlobalConfigNoRules(t, o string) string {
+	return fmt.Sprintf("%s%s", createGlobalBaseConfig(t, o),
+		`resource "pagerduty_event_orchestration_global" "global" {
+			event_orchestration = pagerduty_event_orchestration.orch.id
+
+			set {
+				id = "start"
+			}
+			catch_all {
+				actions { }
+			}
+		}
+	`)
+}
+
+const testAccPagerDutyEventOrchestrationPathGlobalRules = `
+				rule {
+					label = "drop heartbeats"
+					condition {
+						expression = "event.summary matches part 'heartbeat'"
+					}
+					actions {
+						drop_event = true
+					}
+				}
+				rule {
+					label = "enrich hosts"
+					condition {
+						expression = "event.source exists"
+					}
+					actions {
+						route_to = "enrich"
+					}
+				}
+			}
+			set {
+				id = "enrich"
+				rule {
+					label = "extract hostname"
+					actions {
+						variable {
+							name  = "hostname"
+							path  = "event.source"
+							type  = "regex"
+							value = "(.*)"
+						}
+						extraction {
+							target   = "event.custom_details.host"
+							template = "{{variables.hostname}}"
+						}
+					}
+				}
+			}
+			catch_all {
+				actions {
+					severity = "info"
+				}
+			}
+		}
+`
+
+func testAccCheckPagerDutyEventOrchestrationPathGlobalConfigWithRules(t, o string) string {
+	return fmt.Sprintf("%s%s%s", createGlobalBaseConfig(t, o),
+		`resource "pagerduty_event_orchestration_global" "global" {
+			event_orchestration = pagerduty_event_orchestration.orch.id
+
+			set {
+				id = "start"`,
+		testAccPagerDutyEventOrchestrationPathGlobalRules)
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathGlobalConfigWithInsertedRule(t, o string) string {
+	return fmt.Sprintf("%s%s%s", createGlobalBaseConfig(t, o),
+		`resource "pagerduty_event_orchestration_global" "global" {
+			event_orchestration = pagerduty_event_orchestration.orch.id
+
+			set {
+				id = "start"
+				rule {
+					label = "suppress low priority"
+					condition {
+						expression = "event.severity matches 'info'"
+					}
+					actions {
+						suppress = true
+					}
+				}`,
+		testAccPagerDutyEventOrchestrationPathGlobalRules)
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathGlobalActiveBetweenConfig(t, o, tz string) string {
+	return fmt.Sprintf("%s%s", createGlobalBaseConfig(t, o), fmt.Sprintf(`
+		resource "pagerduty_event_orchestration_global" "global" {
+			event_orchestration = pagerduty_event_orchestration.orch.id
+
+			set {
+				id = "start"
+				rule {
+					label = "critical during business hours"
+					condition {
+						expression = "event.severity matches 'critical'"
+					}
+					active_between {
+						days       = ["Mon", "Tue", "Wed", "Thu", "Fri"]
+						start_time = "09:00:00"
+						end_time   = "18:00:00"
+						time_zone  = "%s"
+					}
+					actions {
+						annotate = "Raised during business hours"
+					}
+				}
+			}
+			catch_all {
+				actions { }
+			}
+		}
+	`, tz))
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathGlobalConfigActiveBetween(t, o string) string {
+	return testAccCheckPagerDutyEventOrchestrationPathGlobalActiveBetweenConfig(t, o, "Europe/Paris")
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathGlobalConfigInvalidTimeZone(t, o string) string {
+	return testAccCheckPagerDutyEventOrchestrationPathGlobalActiveBetweenConfig(t, o, "Europe/Nowhere")
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathGlobalConfigInvalidDrop(t, o string) string {
+	return fmt.Sprintf("%s%s", createGlobalBaseConfig(t, o),
+		`resource "pagerduty_event_orchestration_global" "global" {
+			event_orchestration = pagerduty_event_orchestration.orch.id
+
+			set {
+				id = "start"
+				rule {
+					actions {
+						drop_event = true
+						severity   = "critical"
+					}
+				}
+			}
+			catch_all {
+				actions { }
+			}
+		}
+	`)
+}
@@ -0,0 +1,258 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyEventOrchestrationPathRaw() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyEventOrchestrationPathRawCreate,
+		Read:   resourcePagerDutyEventOrchestrationPathRawRead,
+		Update: resourcePagerDutyEventOrchestrationPathRawUpdate,
+		Delete: resourcePagerDutyEventOrchestrationPathRawDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyEventOrchestrationPathRawImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateValueFunc([]string{
+					pagerduty.PathTypeGlobal,
+					pagerduty.PathTypeRouter,
+					pagerduty.PathTypeService,
+					pagerduty.PathTypeUnrouted,
+				}),
+			},
+			"parent": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"path": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"self": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePagerDutyEventOrchestrationPathRawCreate(d *schema.ResourceData, meta interface{}) error {
+	return resourcePagerDutyEventOrchestrationPathRawUpdate(d, meta)
+}
+
+func resourcePagerDutyEventOrchestrationPathRawRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	pathType := d.Get("type").(string)
+	parent := d.Get("parent").(string)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		log.Printf("[INFO] Reading PagerDuty Event Orchestration Path of type %s for %s", pathType, parent)
+
+		path, _, err := client.EventOrchestrationPaths.GetRaw(parent, pathType)
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		if err := setEventOrchestrationPathRawProps(d, path); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyEventOrchestrationPathRawUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	pathType := d.Get("type").(string)
+	parent := d.Get("parent").(string)
+	payload := json.RawMessage(d.Get("path").(string))
+
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		log.Printf("[INFO] Updating PagerDuty Event Orchestration Path of type %s for %s", pathType, parent)
+
+		path, _, err := client.EventOrchestrationPaths.UpdateRaw(parent, pathType, payload)
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		d.SetId(parent)
+		if err := setEventOrchestrationPathRawProps(d, path); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if retryErr != nil {
+		return retryErr
+	}
+
+	return nil
+}
+
+// Orchestration paths always exist, so deleting one only removes it from the
+// state and leaves its rules in place, like the other path resources.
+func resourcePagerDutyEventOrchestrationPathRawDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func resourcePagerDutyEventOrchestrationPathRawImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	ids := strings.Split(d.Id(), ":")
+	if len(ids) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_event_orchestration_path_raw. Expecting an importation ID formed as '<type>:<event_orchestration_id or service_id>'")
+	}
+	pathType, parent := ids[0], ids[1]
+
+	if _, _, err := client.EventOrchestrationPaths.GetRaw(parent, pathType); err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(parent)
+	d.Set("type", pathType)
+	d.Set("parent", parent)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// eventOrchestrationPathRawServerFields are the fields of a path document
+// set by PagerDuty, which are never part of the configured document.
+var eventOrchestrationPathRawServerFields = []string{
+	"type",
+	"self",
+	"parent",
+	"created_at",
+	"created_by",
+	"updated_at",
+	"updated_by",
+	"version",
+}
+
+func setEventOrchestrationPathRawProps(d *schema.ResourceData, path json.RawMessage) error {
+	var remote map[string]interface{}
+	if err := json.Unmarshal(path, &remote); err != nil {
+		return fmt.Errorf("Error decoding Event Orchestration Path: %s", err)
+	}
+
+	if self, ok := remote["self"].(string); ok {
+		d.Set("self", self)
+	}
+
+	// PagerDuty adds defaults and IDs (e.g. of rules) to the document, so the
+	// configured one is kept as long as the remote one still includes it
+	var configured interface{}
+	if err := json.Unmarshal([]byte(d.Get("path").(string)), &configured); err == nil && eventOrchestrationPathRawIncludes(remote, configured) {
+		return nil
+	}
+
+	for _, f := range eventOrchestrationPathRawServerFields {
+		delete(remote, f)
+	}
+	b, err := json.Marshal(remote)
+	if err != nil {
+		return err
+	}
+	d.Set("path", string(b))
+
+	return nil
+}
+
+// eventOrchestrationPathRawIncludes reports whether every value of want is
+// found in got, which may hold additional object keys. Lists must have the
+// same length and match element by element, since rule order matters.
+func eventOrchestrationPathRawIncludes(got, want interface{}) bool {
+	switch w := want.(type) {
+	case map[string]interface{}:
+		g, ok := got.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, wv := range w {
+			gv, ok := g[k]
+			if !ok {
+				// PagerDuty omits empty values from the documents it returns
+				if isEmptyEventOrchestrationPathRawValue(wv) {
+					continue
+				}
+				return false
+			}
+			if !eventOrchestrationPathRawIncludes(gv, wv) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		g, ok := got.([]interface{})
+		if !ok || len(g) != len(w) {
+			return false
+		}
+		for i := range w {
+			if !eventOrchestrationPathRawIncludes(g[i], w[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(got, want)
+	}
+}
+
+func isEmptyEventOrchestrationPathRawValue(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	default:
+		return reflect.ValueOf(v).IsZero()
+	}
+}
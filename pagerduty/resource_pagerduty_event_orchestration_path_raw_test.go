@@ -0,0 +1,107 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestEventOrchestrationPathRawIncludes(t *testing.T) {
+	remote := `{
+		"type": "global",
+		"self": "https://api.pagerduty.com/event_orchestrations/E1/global",
+		"sets": [{"id": "start", "rules": [{"id": "a1b2c3", "label": "drop", "disabled": false, "conditions": [], "actions": {"drop_event": true, "suppress": false}}]}],
+		"catch_all": {"actions": {"suppress": false}}
+	}`
+
+	cases := []struct {
+		name       string
+		configured string
+		includes   bool
+	}{
+		{"server fields and defaults", `{"sets": [{"id": "start", "rules": [{"label": "drop", "actions": {"drop_event": true}}]}], "catch_all": {"actions": {}}}`, true},
+		{"omitted empty values", `{"sets": [{"id": "start", "rules": [{"label": "drop", "conditions": [], "actions": {"drop_event": true, "variables": [], "priority": "", "suspend": 0, "annotate": null}}]}]}`, true},
+		{"changed value", `{"sets": [{"id": "start", "rules": [{"label": "drop", "actions": {"drop_event": false}}]}]}`, false},
+		{"missing rule", `{"sets": [{"id": "start", "rules": []}]}`, false},
+		{"extra rule", `{"sets": [{"id": "start", "rules": [{"label": "drop"}, {"label": "other"}]}]}`, false},
+		{"omitted set value", `{"sets": [{"id": "start", "rules": [{"label": "drop", "actions": {"suspend": 60}}]}]}`, false},
+		{"unknown field", `{"sets": [{"id": "start", "rules": [{"label": "drop", "actions": {"new_action": "x"}}]}]}`, false},
+	}
+
+	var got interface{}
+	if err := json.Unmarshal([]byte(remote), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range cases {
+		var want interface{}
+		if err := json.Unmarshal([]byte(c.configured), &want); err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if includes := eventOrchestrationPathRawIncludes(got, want); includes != c.includes {
+			t.Errorf("%s: expected %t, got %t", c.name, c.includes, includes)
+		}
+	}
+}
+
+func TestAccPagerDutyEventOrchestrationPathRaw_Basic(t *testing.T) {
+	team := fmt.Sprintf("tf-name-%s", acctest.RandString(5))
+	orchestration := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_path_raw.global"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathRawConfig(team, orchestration, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "parent", "pagerduty_event_orchestration.orch", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "self"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathRawConfig(team, orchestration, "false"),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateIdPrefix:     "global:",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"path"},
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathRawConfig(t, o, dropEvent string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+	name = "%s"
+}
+
+resource "pagerduty_event_orchestration" "orch" {
+	name = "%s"
+	team = pagerduty_team.foo.id
+}
+
+resource "pagerduty_event_orchestration_path_raw" "global" {
+	type   = "global"
+	parent = pagerduty_event_orchestration.orch.id
+	path   = jsonencode({
+		sets = [{
+			id    = "start"
+			rules = [{
+				label      = "Drop heartbeats"
+				conditions = [{ expression = "event.summary matches part 'heartbeat'" }]
+				actions    = { drop_event = %s }
+			}]
+		}]
+		catch_all = { actions = {} }
+	})
+}
+`, t, o, dropEvent)
+}
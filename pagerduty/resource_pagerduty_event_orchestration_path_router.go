@@ -1,12 +1,14 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
@@ -19,6 +21,7 @@ func resourcePagerDutyEventOrchestrationPathRouter() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyEventOrchestrationPathRouterImport,
 		},
+		CustomizeDiff: customizeEventOrchestrationPathRouterDiff,
 		Schema: map[string]*schema.Schema{
 			"event_orchestration": {
 				Type:     schema.TypeString,
@@ -60,9 +63,31 @@ func resourcePagerDutyEventOrchestrationPathRouter() *schema.Resource {
 										MaxItems: 1, //there can only be one action for router
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"dynamic_route_to": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"lookup_by": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice([]string{"service_name", "service_id"}, false),
+															},
+															"regex": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"source": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
 												"route_to": {
 													Type:     schema.TypeString,
-													Required: true,
+													Optional: true,
 													ValidateFunc: func(v interface{}, key string) (warns []string, errs []error) {
 														value := v.(string)
 														if value == "unrouted" {
@@ -110,6 +135,44 @@ func resourcePagerDutyEventOrchestrationPathRouter() *schema.Resource {
 	}
 }
 
+// customizeEventOrchestrationPathRouterDiff checks each rule routes either to
+// a service or dynamically, which the API only allows on the first rule of
+// the start set.
+func customizeEventOrchestrationPathRouterDiff(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	for si, set := range diff.Get("set").([]interface{}) {
+		if set == nil {
+			continue
+		}
+		setID := set.(map[string]interface{})["id"].(string)
+		for ri, r := range set.(map[string]interface{})["rule"].([]interface{}) {
+			if r == nil {
+				continue
+			}
+			for _, a := range r.(map[string]interface{})["actions"].([]interface{}) {
+				if a == nil {
+					continue
+				}
+				actions := a.(map[string]interface{})
+				dynamic := len(actions["dynamic_route_to"].([]interface{})) > 0
+				routeTo := actions["route_to"].(string)
+
+				// Unknown service IDs are empty during plan, they're only
+				// checked once they are known
+				if !dynamic && routeTo == "" && diff.NewValueKnown(fmt.Sprintf("set.%d.rule.%d.actions.0.route_to", si, ri)) {
+					return fmt.Errorf("set.%d.rule.%d: one of route_to or dynamic_route_to must be set", si, ri)
+				}
+				if dynamic && routeTo != "" {
+					return fmt.Errorf("set.%d.rule.%d: route_to and dynamic_route_to can't be set together", si, ri)
+				}
+				if dynamic && (ri != 0 || (setID != "start" && diff.NewValueKnown(fmt.Sprintf("set.%d.id", si)))) {
+					return fmt.Errorf("set.%d.rule.%d: dynamic_route_to is only supported on the first rule of the start set", si, ri)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func resourcePagerDutyEventOrchestrationPathRouterRead(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -249,11 +312,27 @@ func expandRouterActions(v interface{}) *pagerduty.EventOrchestrationPathRuleAct
 	for _, ai := range v.([]interface{}) {
 		am := ai.(map[string]interface{})
 		actions.RouteTo = am["route_to"].(string)
+		if dr, ok := am["dynamic_route_to"]; ok {
+			actions.DynamicRouteTo = expandRouterDynamicRouteTo(dr)
+		}
 	}
 
 	return actions
 }
 
+func expandRouterDynamicRouteTo(v interface{}) *pagerduty.EventOrchestrationPathDynamicRouteTo {
+	for _, di := range v.([]interface{}) {
+		dm := di.(map[string]interface{})
+		return &pagerduty.EventOrchestrationPathDynamicRouteTo{
+			LookupBy: dm["lookup_by"].(string),
+			Regex:    dm["regex"].(string),
+			Source:   dm["source"].(string),
+		}
+	}
+
+	return nil
+}
+
 func expandCatchAll(v interface{}) *pagerduty.EventOrchestrationPathCatchAll {
 	var catchAll = new(pagerduty.EventOrchestrationPathCatchAll)
 
@@ -299,6 +378,15 @@ func flattenRouterActions(actions *pagerduty.EventOrchestrationPathRuleActions)
 
 	am := make(map[string]interface{})
 	am["route_to"] = actions.RouteTo
+	if actions.DynamicRouteTo != nil {
+		am["dynamic_route_to"] = []map[string]interface{}{
+			{
+				"lookup_by": actions.DynamicRouteTo.LookupBy,
+				"regex":     actions.DynamicRouteTo.Regex,
+				"source":    actions.DynamicRouteTo.Source,
+			},
+		}
+	}
 	actionsMap = append(actionsMap, am)
 	return actionsMap
 }
@@ -2,6 +2,7 @@ package pagerduty
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -67,6 +68,25 @@ func TestAccPagerDutyEventOrchestrationPathRouter_Basic(t *testing.T) {
 						"pagerduty_event_orchestration_router.router", "set.0.rule.1.condition.0.expression", "event.severity matches part 'critical'"),
 				),
 			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationRouterConfigWithDynamicRouting(team, escalationPolicy, service, orchestration, "rule1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationRouterExists("pagerduty_event_orchestration_router.router"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_router.router", "set.0.rule.#", "2"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_router.router", "set.0.rule.0.actions.0.dynamic_route_to.0.lookup_by", "service_name"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_router.router", "set.0.rule.0.actions.0.dynamic_route_to.0.source", "event.custom_details.pd_service_name"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_event_orchestration_router.router", "set.0.rule.0.actions.0.dynamic_route_to.0.regex", "(.*)"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyEventOrchestrationRouterConfigWithDynamicRouting(team, escalationPolicy, service, orchestration, "rule2"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("dynamic_route_to is only supported on the first rule of the start set"),
+			},
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationRouterConfigWithCatchAllToService(team, escalationPolicy, service, orchestration),
 				Check: resource.ComposeTestCheckFunc(
@@ -322,6 +342,50 @@ func testAccCheckPagerDutyEventOrchestrationRouterConfigWithMultipleRules(t, ep,
 	`)
 }
 
+func testAccCheckPagerDutyEventOrchestrationRouterConfigWithDynamicRouting(t, ep, s, o, dynamicRule string) string {
+	rule1Actions := "route_to = pagerduty_service.bar.id"
+	rule2Actions := rule1Actions
+	dynamic := `dynamic_route_to {
+						lookup_by = "service_name"
+						source = "event.custom_details.pd_service_name"
+						regex = "(.*)"
+					}`
+	if dynamicRule == "rule1" {
+		rule1Actions = dynamic
+	} else {
+		rule2Actions = dynamic
+	}
+
+	return fmt.Sprintf("%s%s", createBaseConfig(t, ep, s, o),
+		fmt.Sprintf(`resource "pagerduty_event_orchestration_router" "router" {
+			event_orchestration = pagerduty_event_orchestration.orch.id
+
+			catch_all {
+				actions {
+					route_to = "unrouted"
+				}
+			}
+			set {
+				id = "start"
+				rule {
+					label = "dynamic routing"
+					actions {
+						%s
+					}
+				}
+				rule {
+					condition {
+						expression = "event.severity matches part 'critical'"
+					}
+					actions {
+						%s
+					}
+				}
+			}
+		}
+	`, rule1Actions, rule2Actions))
+}
+
 func testAccCheckPagerDutyEventOrchestrationRouterConfigNoConditions(t, ep, s, o string) string {
 	return fmt.Sprintf("%s%s", createBaseConfig(t, ep, s, o),
 		`resource "pagerduty_event_orchestration_router" "router" {
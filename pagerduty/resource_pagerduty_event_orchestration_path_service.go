@@ -33,6 +33,10 @@ var eventOrchestrationPathServiceCatchAllActionsSchema = map[string]*schema.Sche
 		Type:     schema.TypeString,
 		Optional: true,
 	},
+	"escalation_policy": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
 	"annotate": {
 		Type:     schema.TypeString,
 		Optional: true,
@@ -110,6 +114,13 @@ var eventOrchestrationPathServiceCatchAllActionsSchema = map[string]*schema.Sche
 			Schema: eventOrchestrationPathExtractionsSchema,
 		},
 	},
+	"incident_custom_field_update": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: eventOrchestrationPathIncidentCustomFieldUpdatesSchema,
+		},
+	},
 }
 
 var eventOrchestrationPathServiceRuleActionsSchema = buildEventOrchestrationPathServiceRuleActionsSchema()
@@ -139,6 +150,11 @@ func resourcePagerDutyEventOrchestrationPathService() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"enable_event_orchestration_for_service": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"set": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -224,6 +240,13 @@ func resourcePagerDutyEventOrchestrationPathServiceRead(d *schema.ResourceData,
 		} else if path != nil {
 			setEventOrchestrationPathServiceProps(d, path)
 		}
+
+		if status, _, err := client.EventOrchestrationPaths.GetServiceActiveStatus(d.Id()); err != nil {
+			time.Sleep(2 * time.Second)
+			return resource.RetryableError(err)
+		} else if status != nil {
+			d.Set("enable_event_orchestration_for_service", status.Active)
+		}
 		return nil
 	})
 
@@ -260,9 +283,37 @@ func resourcePagerDutyEventOrchestrationPathServiceUpdate(d *schema.ResourceData
 
 	setEventOrchestrationPathServiceProps(d, servicePath)
 
+	if err := updateEventOrchestrationPathServiceActiveStatus(d, client); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// updateEventOrchestrationPathServiceActiveStatus switches the service
+// between its service orchestration and its event rules, when configured.
+func updateEventOrchestrationPathServiceActiveStatus(d *schema.ResourceData, client *pagerduty.Client) error {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || raw.GetAttr("enable_event_orchestration_for_service").IsNull() {
+		return nil
+	}
+	if !d.IsNewResource() && !d.HasChange("enable_event_orchestration_for_service") {
+		return nil
+	}
+	active := d.Get("enable_event_orchestration_for_service").(bool)
+
+	log.Printf("[INFO] Setting PagerDuty Event Orchestration Service Path %s active status to %t", d.Id(), active)
+
+	return resource.Retry(30*time.Second, func() *resource.RetryError {
+		status, _, err := client.EventOrchestrationPaths.UpdateServiceActiveStatus(d.Id(), active)
+		if err != nil {
+			return resource.RetryableError(err)
+		}
+		d.Set("enable_event_orchestration_for_service", status.Active)
+		return nil
+	})
+}
+
 func resourcePagerDutyEventOrchestrationPathServiceDelete(d *schema.ResourceData, meta interface{}) error {
 	d.SetId("")
 	return nil
@@ -365,6 +416,7 @@ func expandServicePathActions(v interface{}) *pagerduty.EventOrchestrationPathRu
 		actions.Suppress = a["suppress"].(bool)
 		actions.Suspend = intTypeToIntPtr(a["suspend"].(int))
 		actions.Priority = a["priority"].(string)
+		actions.EscalationPolicy = a["escalation_policy"].(string)
 		actions.Annotate = a["annotate"].(string)
 		actions.Severity = a["severity"].(string)
 		actions.EventAction = a["event_action"].(string)
@@ -372,6 +424,7 @@ func expandServicePathActions(v interface{}) *pagerduty.EventOrchestrationPathRu
 		actions.AutomationActions = expandServicePathAutomationActions(a["automation_action"])
 		actions.Variables = expandEventOrchestrationPathVariables(a["variable"])
 		actions.Extractions = expandEventOrchestrationPathExtractions(a["extraction"])
+		actions.IncidentCustomFieldUpdates = expandEventOrchestrationPathIncidentCustomFieldUpdates(a["incident_custom_field_update"])
 	}
 
 	return actions
@@ -480,13 +533,14 @@ func flattenServicePathActions(actions *pagerduty.EventOrchestrationPathRuleActi
 	var actionsMap []map[string]interface{}
 
 	flattenedAction := map[string]interface{}{
-		"route_to":     actions.RouteTo,
-		"severity":     actions.Severity,
-		"event_action": actions.EventAction,
-		"suppress":     actions.Suppress,
-		"suspend":      actions.Suspend,
-		"priority":     actions.Priority,
-		"annotate":     actions.Annotate,
+		"route_to":          actions.RouteTo,
+		"severity":          actions.Severity,
+		"event_action":      actions.EventAction,
+		"suppress":          actions.Suppress,
+		"suspend":           actions.Suspend,
+		"priority":          actions.Priority,
+		"escalation_policy": actions.EscalationPolicy,
+		"annotate":          actions.Annotate,
 	}
 
 	if actions.Variables != nil {
@@ -501,6 +555,9 @@ func flattenServicePathActions(actions *pagerduty.EventOrchestrationPathRuleActi
 	if actions.AutomationActions != nil {
 		flattenedAction["automation_action"] = flattenServicePathAutomationActions(actions.AutomationActions)
 	}
+	if actions.IncidentCustomFieldUpdates != nil {
+		flattenedAction["incident_custom_field_update"] = flattenEventOrchestrationPathIncidentCustomFieldUpdates(actions.IncidentCustomFieldUpdates)
+	}
 
 	actionsMap = append(actionsMap, flattenedAction)
 
@@ -121,6 +121,7 @@ func TestAccPagerDutyEventOrchestrationPathService_Basic(t *testing.T) {
 							resource.TestCheckResourceAttrSet(resourceName, "set.0.rule.0.id"),
 							resource.TestCheckResourceAttrSet(resourceName, "set.1.rule.0.id"),
 							resource.TestCheckResourceAttrSet(resourceName, "set.1.rule.1.id"),
+							resource.TestCheckResourceAttrPair(resourceName, "set.0.rule.0.actions.0.escalation_policy", "pagerduty_escalation_policy.foo", "id"),
 						}...,
 					)...,
 				),
@@ -171,6 +172,34 @@ func TestAccPagerDutyEventOrchestrationPathService_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyEventOrchestrationPathService_EnableForService(t *testing.T) {
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_service.serviceA"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationServicePathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathServiceEnableConfig(escalationPolicy, service, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_event_orchestration_for_service", "true"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathServiceEnableConfig(escalationPolicy, service, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_event_orchestration_for_service", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyEventOrchestrationServicePathDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -277,6 +306,23 @@ func createBaseServicePathConfig(ep, s string) string {
 	`, ep, s)
 }
 
+func testAccCheckPagerDutyEventOrchestrationPathServiceEnableConfig(ep, s string, enabled bool) string {
+	return fmt.Sprintf("%s%s", createBaseServicePathConfig(ep, s), fmt.Sprintf(
+		`resource "pagerduty_event_orchestration_service" "serviceA" {
+			service = pagerduty_service.bar.id
+			enable_event_orchestration_for_service = %t
+
+			set {
+				id = "start"
+			}
+
+			catch_all {
+				actions { }
+			}
+		}
+	`, enabled))
+}
+
 func testAccCheckPagerDutyEventOrchestrationPathServiceDefaultConfig(ep, s string) string {
 	return fmt.Sprintf("%s%s", createBaseServicePathConfig(ep, s),
 		`resource "pagerduty_event_orchestration_service" "serviceA" {
@@ -484,6 +530,7 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceAllActionsConfig(ep, s st
 						route_to = "set-1"
 						priority = "P0IN2KQ"
 						annotate = "Routed through an event orchestration"
+						escalation_policy = pagerduty_escalation_policy.foo.id
 						pagerduty_automation_action {
 							action_id = "01CSB5SMOKCKVRI5GN0LJG7SMB"
 						}
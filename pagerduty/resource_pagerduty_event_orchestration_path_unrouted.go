@@ -25,6 +25,10 @@ func resourcePagerDutyEventOrchestrationPathUnrouted() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"self": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"set": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -171,9 +175,17 @@ func resourcePagerDutyEventOrchestrationPathUnroutedRead(d *schema.ResourceData,
 		log.Printf("[INFO] Reading PagerDuty Event Orchestration Path of type: %s for orchestration: %s", "unrouted", d.Id())
 
 		if unroutedPath, _, err := client.EventOrchestrationPaths.Get(d.Id(), "unrouted"); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if unroutedPath != nil {
+			d.Set("event_orchestration", d.Id())
+			d.Set("self", unroutedPath.Self)
+
 			if unroutedPath.Sets != nil {
 				d.Set("set", flattenUnroutedSets(unroutedPath.Sets))
 			}
@@ -222,8 +234,10 @@ func performUnroutedPathUpdate(d *schema.ResourceData, unroutedPath *pagerduty.E
 		}
 		d.SetId(unroutedPath.Parent.ID)
 		d.Set("event_orchestration", unroutedPath.Parent.ID)
-		if unroutedPath.Sets != nil {
-			d.Set("set", flattenUnroutedSets(unroutedPath.Sets))
+		d.Set("self", updatedPath.Self)
+		// Rules are read from the response so the IDs of new rules are known
+		if updatedPath.Sets != nil {
+			d.Set("set", flattenUnroutedSets(updatedPath.Sets))
 		}
 		if updatedPath.CatchAll != nil {
 			d.Set("catch_all", flattenUnroutedCatchAll(updatedPath.CatchAll))
@@ -415,7 +429,7 @@ func flattenUnroutedCatchAllActions(actions *pagerduty.EventOrchestrationPathRul
 	if actions.Variables != nil {
 		flattenedAction["variable"] = flattenEventOrchestrationPathVariables(actions.Variables)
 	}
-	if actions.Variables != nil {
+	if actions.Extractions != nil {
 		flattenedAction["extraction"] = flattenEventOrchestrationPathExtractions(actions.Extractions)
 	}
 
@@ -42,6 +42,10 @@ func TestAccPagerDutyEventOrchestrationPathUnrouted_Basic(t *testing.T) {
 					testAccCheckPagerDutyEventOrchestrationPathUnroutedExists("pagerduty_event_orchestration_unrouted.unrouted"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_event_orchestration_unrouted.unrouted", "set.0.rule.0.condition.0.expression", "event.summary matches part 'rds'"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_event_orchestration_unrouted.unrouted", "set.0.rule.0.id"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_event_orchestration_unrouted.unrouted", "self"),
 				),
 			},
 			{
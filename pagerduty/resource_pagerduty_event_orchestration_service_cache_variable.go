@@ -0,0 +1,10 @@
+package pagerduty
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyEventOrchestrationServiceCacheVariable() *schema.Resource {
+	return buildEventOrchestrationCacheVariableResource(pagerduty.CacheVariableTypeService, "service")
+}
@@ -0,0 +1,62 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAccPagerDutyEventOrchestrationServiceCacheVariable_Basic(t *testing.T) {
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_service_cache_variable.cv"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationCacheVariableDestroy(pagerduty.CacheVariableTypeService, "service"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationServiceCacheVariableConfig(escalationPolicy, service, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationCacheVariableExists(resourceName, pagerduty.CacheVariableTypeService, "service"),
+					resource.TestCheckResourceAttrPair(resourceName, "service", "pagerduty_service.bar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.type", "external_data"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.data_type", "number"),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationServiceCacheVariableConfig(escalationPolicy, service, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccCheckPagerDutyEventOrchestrationCacheVariableImportID(resourceName, "service"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationServiceCacheVariableConfig(ep, s string, disabled bool) string {
+	return fmt.Sprintf("%s%s", createBaseServicePathConfig(ep, s), fmt.Sprintf(`
+resource "pagerduty_event_orchestration_service_cache_variable" "cv" {
+	service  = pagerduty_service.bar.id
+	name     = "deploy_in_progress"
+	disabled = %t
+
+	configuration {
+		type        = "external_data"
+		data_type   = "number"
+		ttl_seconds = 3600
+	}
+}
+`, disabled))
+}
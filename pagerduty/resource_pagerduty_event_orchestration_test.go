@@ -79,6 +79,12 @@ func TestAccPagerDutyEventOrchestration_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"pagerduty_event_orchestration.foo", "team.#", "0",
 					),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_event_orchestration.foo", "integration.0.label",
+					),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_event_orchestration.foo", "integration.0.parameters.0.routing_key",
+					),
 				),
 			},
 			{
@@ -1,6 +1,8 @@
 package pagerduty
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -69,6 +71,11 @@ func resourcePagerDutyExtensionServiceNow() *schema.Resource {
 				Type:      schema.TypeString,
 				Required:  true,
 				Sensitive: true,
+				StateFunc: hashExtensionServiceNowPassword,
+			},
+			"snow_password_version": {
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"summary": {
 				Type:     schema.TypeString,
@@ -110,7 +117,7 @@ func buildExtensionServiceNowStruct(d *schema.ResourceData) *pagerduty.Extension
 
 	var config = &PagerDutyExtensionServiceNowConfig{
 		User:        d.Get("snow_user").(string),
-		Password:    d.Get("snow_password").(string),
+		Password:    configuredExtensionServiceNowPassword(d),
 		SyncOptions: d.Get("sync_options").(string),
 		Target:      d.Get("target").(string),
 		TaskType:    d.Get("task_type").(string),
@@ -152,7 +159,6 @@ func fetchPagerDutyExtensionServiceNowCreate(d *schema.ResourceData, meta interf
 		var config = new(PagerDutyExtensionServiceNowConfig)
 		json.Unmarshal(b, config)
 		d.Set("snow_user", config.User)
-		d.Set("snow_password", config.Password)
 		d.Set("sync_options", config.SyncOptions)
 		d.Set("target", config.Target)
 		d.Set("task_type", config.TaskType)
@@ -243,6 +249,34 @@ func resourcePagerDutyExtensionServiceNowImport(d *schema.ResourceData, meta int
 	return []*schema.ResourceData{d}, err
 }
 
+// configuredExtensionServiceNowPassword returns the password from the
+// configuration, as the state only holds its hash. An update replaces the
+// whole extension config, so the password is sent with every update.
+func configuredExtensionServiceNowPassword(d *schema.ResourceData) string {
+	raw := d.GetRawConfig()
+	if raw.IsNull() {
+		return d.Get("snow_password").(string)
+	}
+
+	password := raw.GetAttr("snow_password")
+	if !password.IsKnown() || password.IsNull() {
+		return ""
+	}
+	return password.AsString()
+}
+
+// hashExtensionServiceNowPassword keeps the ServiceNow password out of the
+// state, while still detecting its changes.
+func hashExtensionServiceNowPassword(v interface{}) string {
+	password, ok := v.(string)
+	if !ok || password == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(password))
+	return hex.EncodeToString(sum[:])
+}
+
 func expandServiceNowServiceObjects(v interface{}) []*pagerduty.ServiceReference {
 	var services []*pagerduty.ServiceReference
 
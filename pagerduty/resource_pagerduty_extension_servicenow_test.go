@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -46,6 +47,19 @@ func testSweepExtensionServiceNow(region string) error {
 	return nil
 }
 
+func TestHashExtensionServiceNowPassword(t *testing.T) {
+	hash := hashExtensionServiceNowPassword("zorz")
+	if hash == "zorz" || len(hash) != 64 {
+		t.Errorf("expected the SHA-256 hex digest of the password, got %s", hash)
+	}
+	if hashExtensionServiceNowPassword("zorz-rotated") == hash {
+		t.Errorf("expected a rotated password to change its hash")
+	}
+	if hash := hashExtensionServiceNowPassword(""); hash != "" {
+		t.Errorf("expected no hash for an empty password, got %s", hash)
+	}
+}
+
 func TestAccPagerDutyExtensionServiceNow_Basic(t *testing.T) {
 	extension_name := resource.PrefixedUniqueId("tf-")
 	extension_name_updated := resource.PrefixedUniqueId("tf-")
@@ -73,7 +87,7 @@ func TestAccPagerDutyExtensionServiceNow_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"pagerduty_extension_servicenow.foo", "snow_user", "meeps"),
 					resource.TestCheckResourceAttr(
-						"pagerduty_extension_servicenow.foo", "snow_password", "zorz"),
+						"pagerduty_extension_servicenow.foo", "snow_password", hashExtensionServiceNowPassword("zorz")),
 					resource.TestCheckResourceAttr(
 						"pagerduty_extension_servicenow.foo", "sync_options", "manual_sync"),
 					resource.TestCheckResourceAttr(
@@ -99,7 +113,7 @@ func TestAccPagerDutyExtensionServiceNow_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"pagerduty_extension_servicenow.foo", "snow_user", "meeps"),
 					resource.TestCheckResourceAttr(
-						"pagerduty_extension_servicenow.foo", "snow_password", "zorz"),
+						"pagerduty_extension_servicenow.foo", "snow_password", hashExtensionServiceNowPassword("zorz")),
 					resource.TestCheckResourceAttr(
 						"pagerduty_extension_servicenow.foo", "sync_options", "manual_sync"),
 					resource.TestCheckResourceAttr(
@@ -110,6 +124,15 @@ func TestAccPagerDutyExtensionServiceNow_Basic(t *testing.T) {
 						"pagerduty_extension_servicenow.foo", "referer", "None"),
 				),
 			},
+			// Changing only the name must keep the password of the extension
+			{
+				Config: testAccCheckPagerDutyExtensionServiceNowConfig(name, extension_name, url_updated, "true", "pd-users"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_servicenow.foo", "name", extension_name),
+					testAccCheckPagerDutyExtensionServiceNowPasswordSet("pagerduty_extension_servicenow.foo"),
+				),
+			},
 		},
 	})
 }
@@ -155,6 +178,31 @@ func testAccCheckPagerDutyExtensionServiceNowExists(n string) resource.TestCheck
 	}
 }
 
+func testAccCheckPagerDutyExtensionServiceNowPasswordSet(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.Extensions.Get(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		b, _ := json.Marshal(found.Config)
+		var config = new(PagerDutyExtensionServiceNowConfig)
+		json.Unmarshal(b, config)
+		if config.Password == "" {
+			return fmt.Errorf("Extension %s has no ServiceNow password anymore", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckPagerDutyExtensionServiceNowConfig(name string, extension_name string, url string, notify_types string, restrict string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -0,0 +1,250 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// genericV2WebhookEvents maps the notify_types of a Generic V2 Webhook
+// extension config to the equivalent v3 webhook subscription events.
+var genericV2WebhookEvents = map[string][]string{
+	"resolve":     {"incident.resolved"},
+	"acknowledge": {"incident.acknowledged", "incident.unacknowledged"},
+	"assignments": {"incident.reassigned", "incident.escalated", "incident.delegated"},
+}
+
+func resourcePagerDutyExtensionWebhookMigration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyExtensionWebhookMigrationCreate,
+		Read:   resourcePagerDutyExtensionWebhookMigrationRead,
+		Delete: resourcePagerDutyExtensionWebhookMigrationDelete,
+		Schema: map[string]*schema.Schema{
+			"extension_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"disable_extension": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"endpoint_url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"webhook_subscription_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// expandGenericV2WebhookEvents returns the v3 events matching the config of a
+// Generic V2 Webhook extension. Triggered incidents are always sent by v2
+// webhooks, and an extension without notify_types sends every event.
+func expandGenericV2WebhookEvents(config interface{}) []string {
+	events := []string{"incident.triggered"}
+
+	var notifyTypes map[string]interface{}
+	if c, ok := config.(map[string]interface{}); ok {
+		notifyTypes, _ = c["notify_types"].(map[string]interface{})
+	}
+
+	for _, t := range []string{"resolve", "acknowledge", "assignments"} {
+		if notifyTypes != nil {
+			if enabled, _ := notifyTypes[t].(bool); !enabled {
+				continue
+			}
+		}
+		events = append(events, genericV2WebhookEvents[t]...)
+	}
+
+	if notifyTypes == nil {
+		events = append(events, "incident.annotated")
+	}
+
+	return events
+}
+
+func setPagerDutyExtensionTemporarilyDisabled(client *pagerduty.Client, id string, disabled bool) error {
+	extension, _, err := client.Extensions.Get(id)
+	if err != nil {
+		return err
+	}
+
+	extension.TemporarilyDisabled = &disabled
+
+	_, _, err = client.Extensions.Update(id, extension)
+	return err
+}
+
+func resourcePagerDutyExtensionWebhookMigrationCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	extensionID := d.Get("extension_id").(string)
+
+	extension, _, err := client.Extensions.Get(extensionID)
+	if err != nil {
+		return err
+	}
+
+	if extension.EndpointURL == "" {
+		return fmt.Errorf("extension %s has no endpoint_url to migrate to a webhook subscription", extensionID)
+	}
+
+	description := d.Get("description").(string)
+	if description == "" {
+		description = fmt.Sprintf("Migrated from extension %s", extension.Name)
+	}
+
+	events := expandGenericV2WebhookEvents(extension.Config)
+
+	active := true
+
+	var ids []string
+	for _, obj := range extension.ExtensionObjects {
+		if obj.Type != "service_reference" {
+			continue
+		}
+
+		sub := &pagerduty.WebhookSubscription{
+			Type:        "webhook_subscription",
+			Active:      &active,
+			Description: description,
+			DeliveryMethod: pagerduty.DeliveryMethod{
+				Type: "http_delivery_method",
+				URL:  extension.EndpointURL,
+			},
+			Events: events,
+			Filter: pagerduty.Filter{
+				ID:   obj.ID,
+				Type: "service_reference",
+			},
+		}
+
+		log.Printf("[INFO] Creating PagerDuty webhook subscription for extension %s on service %s", extensionID, obj.ID)
+
+		sub, _, err = client.WebhookSubscriptions.Create(sub)
+		if err != nil {
+			// Subscriptions created so far are still tracked so they get
+			// removed on destroy
+			if len(ids) > 0 {
+				d.SetId(strings.Join(ids, ","))
+				d.Set("webhook_subscription_ids", ids)
+			}
+			return err
+		}
+		ids = append(ids, sub.ID)
+	}
+
+	if len(ids) == 0 {
+		return fmt.Errorf("extension %s is not attached to any service", extensionID)
+	}
+
+	d.SetId(strings.Join(ids, ","))
+
+	if d.Get("disable_extension").(bool) {
+		log.Printf("[INFO] Disabling PagerDuty extension %s", extensionID)
+
+		if err := setPagerDutyExtensionTemporarilyDisabled(client, extensionID, true); err != nil {
+			return err
+		}
+	}
+
+	return resourcePagerDutyExtensionWebhookMigrationRead(d, meta)
+}
+
+func resourcePagerDutyExtensionWebhookMigrationRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty extension webhook migration %s", d.Id())
+
+	var ids []string
+	var sub *pagerduty.WebhookSubscription
+	for _, id := range strings.Split(d.Id(), ",") {
+		retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+			s, _, err := client.WebhookSubscriptions.Get(id)
+			if err != nil {
+				if isErrCode(err, 404) {
+					return nil
+				}
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(err)
+			}
+			sub = s
+			ids = append(ids, s.ID)
+			return nil
+		})
+		if retryErr != nil {
+			return retryErr
+		}
+	}
+
+	if len(ids) == 0 {
+		log.Printf("[WARN] Removing %s because its webhook subscriptions are gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("webhook_subscription_ids", ids)
+	d.Set("endpoint_url", sub.DeliveryMethod.URL)
+	d.Set("events", sub.Events)
+
+	return nil
+}
+
+func resourcePagerDutyExtensionWebhookMigrationDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range strings.Split(d.Id(), ",") {
+		log.Printf("[INFO] Deleting PagerDuty webhook subscription %s", id)
+
+		if _, err := client.WebhookSubscriptions.Delete(id); err != nil && !isErrCode(err, 404) {
+			return err
+		}
+	}
+
+	if d.Get("disable_extension").(bool) {
+		extensionID := d.Get("extension_id").(string)
+
+		log.Printf("[INFO] Re-enabling PagerDuty extension %s", extensionID)
+
+		if err := setPagerDutyExtensionTemporarilyDisabled(client, extensionID, false); err != nil && !isErrCode(err, 404) {
+			return err
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package pagerduty
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestExpandGenericV2WebhookEvents(t *testing.T) {
+	config := map[string]interface{}{
+		"restrict": "any",
+		"notify_types": map[string]interface{}{
+			"resolve":     true,
+			"acknowledge": false,
+			"assignments": true,
+		},
+	}
+
+	expected := []string{
+		"incident.triggered",
+		"incident.resolved",
+		"incident.reassigned",
+		"incident.escalated",
+		"incident.delegated",
+	}
+	if events := expandGenericV2WebhookEvents(config); !reflect.DeepEqual(events, expected) {
+		t.Fatalf("expected %v, got %v", expected, events)
+	}
+
+	if events := expandGenericV2WebhookEvents(nil); len(events) != 8 {
+		t.Fatalf("expected every event for an extension without notify_types, got %v", events)
+	}
+}
+
+func TestAccPagerDutyExtensionWebhookMigration_Basic(t *testing.T) {
+	extension_name := resource.PrefixedUniqueId("tf-")
+	name := resource.PrefixedUniqueId("tf-")
+	url := "https://example.com/recieve_a_pagerduty_webhook"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyExtensionWebhookMigrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyExtensionWebhookMigrationConfig(name, extension_name, url),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_webhook_migration.foo", "webhook_subscription_ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_webhook_migration.foo", "endpoint_url", url),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_webhook_migration.foo", "events.#", "3"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension_webhook_migration.foo", "events.1", "incident.acknowledged"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyExtensionWebhookMigrationDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_extension_webhook_migration" {
+			continue
+		}
+
+		for _, id := range strings.Split(r.Primary.ID, ",") {
+			if _, _, err := client.WebhookSubscriptions.Get(id); err == nil {
+				return fmt.Errorf("Webhook subscription still exists")
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyExtensionWebhookMigrationConfig(name string, extension_name string, url string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "pagerduty_extension_webhook_migration" "foo" {
+  extension_id = pagerduty_extension.foo.id
+}
+`, strings.Replace(testAccCheckPagerDutyExtensionConfig(name, extension_name, url, "false", "any"), `"acknowledge": false`, `"acknowledge": true`, 1))
+}
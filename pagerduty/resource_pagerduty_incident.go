@@ -0,0 +1,349 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyIncident() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePagerDutyIncidentCreate,
+		ReadContext:   resourcePagerDutyIncidentRead,
+		UpdateContext: resourcePagerDutyIncidentUpdate,
+		DeleteContext: resourcePagerDutyIncidentDelete,
+		CustomizeDiff: customizePagerDutyIncidentDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"urgency": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validateValueFunc([]string{
+					"high",
+					"low",
+				}),
+			},
+			"priority": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"body": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"incident_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"escalation_policy": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"assignees"},
+			},
+			"assignees": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"escalation_policy"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validateValueFunc([]string{
+					"triggered",
+					"acknowledged",
+					"resolved",
+				}),
+			},
+			"from": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"incident_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// customizePagerDutyIncidentDiff rejects the status changes the incidents API
+// can't make, which would otherwise only fail at apply time.
+func customizePagerDutyIncidentDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("status") || !diff.NewValueKnown("status") {
+		return nil
+	}
+
+	o, n := diff.GetChange("status")
+	switch {
+	case o.(string) == "resolved":
+		return fmt.Errorf("incident %s is resolved and can't be reopened, create a new incident instead", diff.Id())
+	case n.(string) == "triggered":
+		return fmt.Errorf("incident %s is %s and can't be moved back to triggered", diff.Id(), o.(string))
+	}
+
+	return nil
+}
+
+func buildIncidentStruct(d *schema.ResourceData) *pagerduty.Incident {
+	incident := &pagerduty.Incident{
+		Type:  "incident",
+		Title: d.Get("title").(string),
+		Service: &pagerduty.ServiceReference{
+			ID:   d.Get("service").(string),
+			Type: "service_reference",
+		},
+		Urgency:     d.Get("urgency").(string),
+		IncidentKey: d.Get("incident_key").(string),
+	}
+
+	if v, ok := d.GetOk("priority"); ok {
+		incident.Priority = &pagerduty.PriorityReference{
+			ID:   v.(string),
+			Type: "priority_reference",
+		}
+	}
+
+	if v, ok := d.GetOk("body"); ok {
+		incident.Body = &pagerduty.IncidentBody{
+			Type:    "incident_body",
+			Details: v.(string),
+		}
+	}
+
+	if v, ok := d.GetOk("escalation_policy"); ok {
+		incident.EscalationPolicy = &pagerduty.EscalationPolicyReference{
+			ID:   v.(string),
+			Type: "escalation_policy_reference",
+		}
+	}
+
+	if v, ok := d.GetOk("assignees"); ok {
+		incident.Assignments = expandIncidentAssignments(v.(*schema.Set))
+	}
+
+	return incident
+}
+
+func resourcePagerDutyIncidentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	incident := buildIncidentStruct(d)
+
+	log.Printf("[INFO] Creating PagerDuty incident %s", incident.Title)
+
+	createdIncident, _, err := client.Incidents.CreateContext(ctx, d.Get("from").(string), incident)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(createdIncident.ID)
+
+	// Incidents are always created as triggered, so any other configured
+	// status is applied right after.
+	if status := d.Get("status").(string); status != "" && status != createdIncident.Status {
+		update := &pagerduty.Incident{
+			Type:   "incident_reference",
+			Status: status,
+		}
+		if _, _, err := client.Incidents.UpdateContext(ctx, d.Id(), d.Get("from").(string), update); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourcePagerDutyIncidentRead(ctx, d, meta)
+}
+
+func resourcePagerDutyIncidentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading PagerDuty incident %s", d.Id())
+
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		incident, _, err := client.Incidents.GetContext(ctx, d.Id())
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		d.Set("title", incident.Title)
+		d.Set("urgency", incident.Urgency)
+		d.Set("incident_key", incident.IncidentKey)
+		d.Set("status", incident.Status)
+		d.Set("incident_number", incident.IncidentNumber)
+		d.Set("html_url", incident.HTMLURL)
+
+		if incident.Service != nil {
+			d.Set("service", incident.Service.ID)
+		}
+
+		if incident.Priority != nil {
+			d.Set("priority", incident.Priority.ID)
+		} else {
+			d.Set("priority", "")
+		}
+
+		if incident.EscalationPolicy != nil {
+			d.Set("escalation_policy", incident.EscalationPolicy.ID)
+		}
+
+		// Resolved incidents have no assignments anymore, and keeping the last
+		// ones avoids planning to reassign them.
+		if incident.Status != "resolved" {
+			if err := d.Set("assignees", flattenIncidentAssignments(incident.Assignments)); err != nil {
+				return resource.NonRetryableError(err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourcePagerDutyIncidentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only the changed attributes are sent, as e.g. sending the assignees
+	// again would reassign the incident.
+	incident := &pagerduty.Incident{
+		Type: "incident_reference",
+	}
+
+	if d.HasChange("title") {
+		incident.Title = d.Get("title").(string)
+	}
+	if d.HasChange("urgency") {
+		incident.Urgency = d.Get("urgency").(string)
+	}
+	if d.HasChange("status") {
+		incident.Status = d.Get("status").(string)
+	}
+	if d.HasChange("priority") {
+		if v, ok := d.GetOk("priority"); ok {
+			incident.Priority = &pagerduty.PriorityReference{
+				ID:   v.(string),
+				Type: "priority_reference",
+			}
+		}
+	}
+	if d.HasChange("escalation_policy") {
+		if v, ok := d.GetOk("escalation_policy"); ok {
+			incident.EscalationPolicy = &pagerduty.EscalationPolicyReference{
+				ID:   v.(string),
+				Type: "escalation_policy_reference",
+			}
+		}
+	}
+	if d.HasChange("assignees") {
+		if v, ok := d.GetOk("assignees"); ok {
+			incident.Assignments = expandIncidentAssignments(v.(*schema.Set))
+		}
+	}
+
+	// Changing only the from email doesn't change the incident.
+	if !d.HasChanges("title", "urgency", "status", "priority", "escalation_policy", "assignees") {
+		return resourcePagerDutyIncidentRead(ctx, d, meta)
+	}
+
+	log.Printf("[INFO] Updating PagerDuty incident %s", d.Id())
+
+	if _, _, err := client.Incidents.UpdateContext(ctx, d.Id(), d.Get("from").(string), incident); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourcePagerDutyIncidentRead(ctx, d, meta)
+}
+
+// Incidents can't be deleted, so deleting one resolves it.
+func resourcePagerDutyIncidentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Resolving PagerDuty incident %s", d.Id())
+
+	if d.Get("status").(string) != "resolved" {
+		incident := &pagerduty.Incident{
+			Type:   "incident_reference",
+			Status: "resolved",
+		}
+		if _, _, err := client.Incidents.UpdateContext(ctx, d.Id(), d.Get("from").(string), incident); err != nil && !isErrCode(err, 404) {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandIncidentAssignments(v *schema.Set) []*pagerduty.IncidentAssignment {
+	var assignments []*pagerduty.IncidentAssignment
+
+	for _, id := range v.List() {
+		assignments = append(assignments, &pagerduty.IncidentAssignment{
+			Assignee: pagerduty.UserReference{
+				ID:   id.(string),
+				Type: "user_reference",
+			},
+		})
+	}
+
+	return assignments
+}
+
+func flattenIncidentAssignments(v []*pagerduty.IncidentAssignment) *schema.Set {
+	var assignees []interface{}
+
+	for _, a := range v {
+		assignees = append(assignees, a.Assignee.ID)
+	}
+
+	return schema.NewSet(schema.HashString, assignees)
+}
@@ -0,0 +1,250 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyIncidentCustomField() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourcePagerDutyIncidentCustomFieldRead,
+		UpdateContext: resourcePagerDutyIncidentCustomFieldUpdate,
+		DeleteContext: resourcePagerDutyIncidentCustomFieldDelete,
+		CreateContext: resourcePagerDutyIncidentCustomFieldCreate,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: validateIncidentCustomFieldDefaultValue,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"data_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateValueFunc([]string{
+					pagerduty.IncidentCustomFieldDataTypeBool,
+					pagerduty.IncidentCustomFieldDataTypeInt,
+					pagerduty.IncidentCustomFieldDataTypeFloat,
+					pagerduty.IncidentCustomFieldDataTypeString,
+					pagerduty.IncidentCustomFieldDataTypeDateTime,
+					pagerduty.IncidentCustomFieldDataTypeURL,
+				}),
+			},
+			"field_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateValueFunc([]string{
+					pagerduty.IncidentCustomFieldFieldTypeSingleValue,
+					pagerduty.IncidentCustomFieldFieldTypeSingleValueFixed,
+					pagerduty.IncidentCustomFieldFieldTypeMultiValue,
+					pagerduty.IncidentCustomFieldFieldTypeMultiValueFixed,
+				}),
+			},
+			"default_value": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+// The default value is JSON encoded, as its type depends on the data type and
+// the field type: multi-value fields have a list of values as default.
+func validateIncidentCustomFieldDefaultValue(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("default_value") {
+		return nil
+	}
+	raw := d.Get("default_value").(string)
+	if raw == "" {
+		return nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		return err
+	}
+
+	_, isList := v.([]interface{})
+	multiValue := strings.HasPrefix(d.Get("field_type").(string), pagerduty.IncidentCustomFieldFieldTypeMultiValue)
+	if multiValue && !isList {
+		return fmt.Errorf("default_value must be a JSON list of values for field_type %s", d.Get("field_type"))
+	}
+	if !multiValue && isList {
+		return fmt.Errorf("default_value must be a single JSON value for field_type %s", d.Get("field_type"))
+	}
+
+	return nil
+}
+
+func resourcePagerDutyIncidentCustomFieldCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	field, err := buildIncidentCustomFieldStruct(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating PagerDuty incident custom field %s", field.Name)
+
+	createdField, _, err := client.IncidentCustomFields.CreateContext(ctx, field)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := flattenIncidentCustomField(d, createdField); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourcePagerDutyIncidentCustomFieldRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading PagerDuty incident custom field %s", d.Id())
+
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		field, _, err := client.IncidentCustomFields.GetContext(ctx, d.Id())
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		if err := flattenIncidentCustomField(d, field); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourcePagerDutyIncidentCustomFieldUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	field, err := buildIncidentCustomFieldStruct(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Updating PagerDuty incident custom field %s", d.Id())
+
+	updatedField, _, err := client.IncidentCustomFields.UpdateContext(ctx, d.Id(), field)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := flattenIncidentCustomField(d, updatedField); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourcePagerDutyIncidentCustomFieldDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting PagerDuty incident custom field %s", d.Id())
+
+	_, err = client.IncidentCustomFields.DeleteContext(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func buildIncidentCustomFieldStruct(d *schema.ResourceData) (*pagerduty.IncidentCustomField, error) {
+	field := &pagerduty.IncidentCustomField{
+		Name:        d.Get("name").(string),
+		DisplayName: d.Get("display_name").(string),
+		DataType:    d.Get("data_type").(string),
+		FieldType:   d.Get("field_type").(string),
+	}
+
+	// The description is always sent, so that it can be removed
+	description := d.Get("description").(string)
+	field.Description = &description
+
+	// An unset default value is sent as null, so that it can be removed
+	if v, ok := d.GetOk("default_value"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &field.DefaultValue); err != nil {
+			return nil, err
+		}
+	} else {
+		field.DefaultValue = json.RawMessage("null")
+	}
+
+	return field, nil
+}
+
+func flattenIncidentCustomField(d *schema.ResourceData, field *pagerduty.IncidentCustomField) error {
+	d.SetId(field.ID)
+	d.Set("name", field.Name)
+	d.Set("display_name", field.DisplayName)
+	d.Set("data_type", field.DataType)
+	d.Set("field_type", field.FieldType)
+	if field.Description != nil {
+		d.Set("description", *field.Description)
+	}
+
+	if field.DefaultValue != nil {
+		defaultValue, err := json.Marshal(field.DefaultValue)
+		if err != nil {
+			return err
+		}
+		d.Set("default_value", string(defaultValue))
+	} else {
+		d.Set("default_value", nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyIncidentCustomFieldOption() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourcePagerDutyIncidentCustomFieldOptionRead,
+		DeleteContext: resourcePagerDutyIncidentCustomFieldOptionDelete,
+		CreateContext: resourcePagerDutyIncidentCustomFieldOptionCreate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePagerDutyIncidentCustomFieldOptionImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"field": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateValueFunc([]string{
+					pagerduty.IncidentCustomFieldDataTypeString,
+				}),
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourcePagerDutyIncidentCustomFieldOptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fieldID := d.Get("field").(string)
+	option := &pagerduty.IncidentCustomFieldOption{
+		Type: "field_option",
+		Data: &pagerduty.IncidentCustomFieldOptionData{
+			DataType: d.Get("data_type").(string),
+			Value:    d.Get("value").(string),
+		},
+	}
+
+	log.Printf("[INFO] Creating option %s of PagerDuty incident custom field %s", option.Data.Value, fieldID)
+
+	createdOption, _, err := client.IncidentCustomFields.CreateFieldOptionContext(ctx, fieldID, option)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattenIncidentCustomFieldOption(d, fieldID, createdOption)
+	return nil
+}
+
+func resourcePagerDutyIncidentCustomFieldOptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fieldID := d.Get("field").(string)
+
+	log.Printf("[INFO] Reading option %s of PagerDuty incident custom field %s", d.Id(), fieldID)
+
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		option, _, err := client.IncidentCustomFields.GetFieldOptionContext(ctx, fieldID, d.Id())
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		flattenIncidentCustomFieldOption(d, fieldID, option)
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourcePagerDutyIncidentCustomFieldOptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fieldID := d.Get("field").(string)
+
+	log.Printf("[INFO] Deleting option %s of PagerDuty incident custom field %s", d.Id(), fieldID)
+
+	// The options are deleted along with their field
+	if _, err := client.IncidentCustomFields.DeleteFieldOptionContext(ctx, fieldID, d.Id()); err != nil && !isErrCode(err, 404) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourcePagerDutyIncidentCustomFieldOptionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	ids := strings.Split(d.Id(), ":")
+
+	if len(ids) == 1 {
+		resp, _, err := client.IncidentCustomFields.ListFieldOptionsContext(ctx, ids[0])
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		var childIDs []string
+		for _, o := range resp.FieldOptions {
+			childIDs = append(childIDs, fmt.Sprintf("%s:%s", ids[0], o.ID))
+		}
+		return []*schema.ResourceData{}, importByParentIDError("pagerduty_incident_custom_field_option", ids[0], childIDs)
+	}
+
+	if len(ids) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_incident_custom_field_option. Expecting an importation ID formed as '<field_id>:<field_option_id>'")
+	}
+	fieldID, id := ids[0], ids[1]
+
+	option, _, err := client.IncidentCustomFields.GetFieldOptionContext(ctx, fieldID, id)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	flattenIncidentCustomFieldOption(d, fieldID, option)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenIncidentCustomFieldOption(d *schema.ResourceData, fieldID string, option *pagerduty.IncidentCustomFieldOption) {
+	d.SetId(option.ID)
+	d.Set("field", fieldID)
+	if option.Data != nil {
+		d.Set("data_type", option.Data.DataType)
+		d.Set("value", option.Data.Value)
+	}
+}
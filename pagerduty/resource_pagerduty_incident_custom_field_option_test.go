@@ -0,0 +1,98 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyIncidentCustomFieldOption_Basic(t *testing.T) {
+	fieldName := fmt.Sprintf("tf_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckIncidentCustomFields(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPagerDutyIncidentCustomFieldOptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentCustomFieldOptionConfig(fieldName, "us-east"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentCustomFieldOptionExists("pagerduty_incident_custom_field_option.test"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_incident_custom_field_option.test", "field", "pagerduty_incident_custom_field.test", "id"),
+					resource.TestCheckResourceAttr("pagerduty_incident_custom_field_option.test", "data_type", "string"),
+					resource.TestCheckResourceAttr("pagerduty_incident_custom_field_option.test", "value", "us-east"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyIncidentCustomFieldOptionConfig(fieldName, "eu-west"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentCustomFieldOptionExists("pagerduty_incident_custom_field_option.test"),
+					resource.TestCheckResourceAttr("pagerduty_incident_custom_field_option.test", "value", "eu-west"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentCustomFieldOptionConfig(fieldName, value string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_incident_custom_field" "test" {
+  name         = "%s"
+  display_name = "Region"
+  data_type    = "string"
+  field_type   = "single_value_fixed"
+}
+
+resource "pagerduty_incident_custom_field_option" "test" {
+  field     = pagerduty_incident_custom_field.test.id
+  data_type = "string"
+  value     = "%s"
+}
+`, fieldName, value)
+}
+
+func testAccCheckPagerDutyIncidentCustomFieldOptionDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_incident_custom_field_option" {
+			continue
+		}
+
+		if _, _, err := client.IncidentCustomFields.GetFieldOption(r.Primary.Attributes["field"], r.Primary.ID); err == nil {
+			return fmt.Errorf("incident custom field option still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyIncidentCustomFieldOptionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no incident custom field option ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.IncidentCustomFields.GetFieldOption(rs.Primary.Attributes["field"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("incident custom field option not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
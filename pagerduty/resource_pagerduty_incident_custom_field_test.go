@@ -0,0 +1,199 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func init() {
+	resource.AddTestSweepers("pagerduty_incident_custom_fields", &resource.Sweeper{
+		Name: "pagerduty_incident_custom_fields",
+		F:    testSweepIncidentCustomField,
+	})
+}
+
+func testSweepIncidentCustomField(region string) error {
+	config, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		return err
+	}
+
+	resp, _, err := client.IncidentCustomFields.List(nil)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range resp.Fields {
+		if strings.HasPrefix(f.Name, "tf_") {
+			log.Printf("Destroying incident custom field %s (%s)", f.Name, f.ID)
+			if _, err := client.IncidentCustomFields.Delete(f.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func TestBuildIncidentCustomFieldStruct_DefaultValue(t *testing.T) {
+	for _, tc := range []struct {
+		defaultValue string
+		want         string
+	}{
+		{defaultValue: `"foo"`, want: `"default_value":"foo"`},
+		{defaultValue: "", want: `"default_value":null`},
+	} {
+		d := schema.TestResourceDataRaw(t, resourcePagerDutyIncidentCustomField().Schema, map[string]interface{}{
+			"name":          "foo",
+			"display_name":  "Foo",
+			"data_type":     "string",
+			"field_type":    "single_value",
+			"default_value": tc.defaultValue,
+		})
+
+		field, err := buildIncidentCustomFieldStruct(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := json.Marshal(&pagerduty.IncidentCustomFieldPayload{Field: field})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(b), tc.want) {
+			t.Errorf("expected %s to contain %s", b, tc.want)
+		}
+	}
+}
+
+func TestAccPagerDutyIncidentCustomField_Basic(t *testing.T) {
+	fieldName := fmt.Sprintf("tf_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckIncidentCustomFields(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPagerDutyIncidentCustomFieldDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentCustomFieldConfig(fieldName, "Region", `"us-east"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentCustomFieldExists("pagerduty_incident_custom_field.test"),
+					resource.TestCheckResourceAttr("pagerduty_incident_custom_field.test", "name", fieldName),
+					resource.TestCheckResourceAttr("pagerduty_incident_custom_field.test", "display_name", "Region"),
+					resource.TestCheckResourceAttr("pagerduty_incident_custom_field.test", "data_type", "string"),
+					resource.TestCheckResourceAttr("pagerduty_incident_custom_field.test", "field_type", "single_value"),
+					resource.TestCheckResourceAttr("pagerduty_incident_custom_field.test", "default_value", `"us-east"`),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyIncidentCustomFieldConfig(fieldName, "Affected Region", `"eu-west"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentCustomFieldExists("pagerduty_incident_custom_field.test"),
+					resource.TestCheckResourceAttr("pagerduty_incident_custom_field.test", "display_name", "Affected Region"),
+					resource.TestCheckResourceAttr("pagerduty_incident_custom_field.test", "default_value", `"eu-west"`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyIncidentCustomField_MultiValueWithSingleDefault(t *testing.T) {
+	config := `
+resource "pagerduty_incident_custom_field" "test" {
+  name          = "tf_ignored"
+  display_name  = "Ignored"
+  data_type     = "string"
+  field_type    = "multi_value"
+  default_value = "\"us-east\""
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckIncidentCustomFields(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("default_value must be a JSON list of values for field_type multi_value"),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentCustomFieldConfig(name, displayName, defaultValue string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_incident_custom_field" "test" {
+  name          = "%s"
+  display_name  = "%s"
+  description   = "The region affected by the incident"
+  data_type     = "string"
+  field_type    = "single_value"
+  default_value = jsonencode(%s)
+}
+`, name, displayName, defaultValue)
+}
+
+func testAccCheckPagerDutyIncidentCustomFieldDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_incident_custom_field" {
+			continue
+		}
+
+		if _, _, err := client.IncidentCustomFields.Get(r.Primary.ID); err == nil {
+			return fmt.Errorf("incident custom field still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyIncidentCustomFieldExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no incident custom field ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.IncidentCustomFields.Get(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("incident custom field not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccPreCheckIncidentCustomFields(t *testing.T) {
+	if v := os.Getenv("PAGERDUTY_ACC_INCIDENT_CUSTOM_FIELDS"); v == "" {
+		t.Skip("PAGERDUTY_ACC_INCIDENT_CUSTOM_FIELDS not set. Skipping Incident Custom Fields-related test")
+	}
+}
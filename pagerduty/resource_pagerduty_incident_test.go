@@ -0,0 +1,153 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyIncident_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	title := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	titleUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyIncidentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentConfig(name, title, "high", "triggered"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentExists("pagerduty_incident.foo"),
+					resource.TestCheckResourceAttr("pagerduty_incident.foo", "title", title),
+					resource.TestCheckResourceAttr("pagerduty_incident.foo", "urgency", "high"),
+					resource.TestCheckResourceAttr("pagerduty_incident.foo", "status", "triggered"),
+					resource.TestCheckResourceAttrPair("pagerduty_incident.foo", "escalation_policy", "pagerduty_escalation_policy.foo", "id"),
+					resource.TestCheckResourceAttrSet("pagerduty_incident.foo", "incident_number"),
+				),
+			},
+			{
+				ResourceName:            "pagerduty_incident.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"from", "body"},
+			},
+			{
+				Config: testAccCheckPagerDutyIncidentConfig(name, titleUpdated, "low", "acknowledged"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentExists("pagerduty_incident.foo"),
+					resource.TestCheckResourceAttr("pagerduty_incident.foo", "title", titleUpdated),
+					resource.TestCheckResourceAttr("pagerduty_incident.foo", "urgency", "low"),
+					resource.TestCheckResourceAttr("pagerduty_incident.foo", "status", "acknowledged"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyIncidentConfig(name, titleUpdated, "low", "triggered"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("can't be moved back to triggered"),
+			},
+			{
+				Config: testAccCheckPagerDutyIncidentConfig(name, titleUpdated, "low", "resolved"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_incident.foo", "status", "resolved"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyIncidentConfig(name, titleUpdated, "low", "acknowledged"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is resolved and can't be reopened"),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_incident" {
+			continue
+		}
+
+		incident, _, err := client.Incidents.Get(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if incident.Status != "resolved" {
+			return fmt.Errorf("Incident %s is still %s", r.Primary.ID, incident.Status)
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyIncidentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No incident ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.Incidents.Get(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Incident not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyIncidentConfig(name, title, urgency, status string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]s"
+  email = "%[1]s@foo.test"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%[1]s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[1]s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+
+  incident_urgency_rule {
+    type    = "constant"
+    urgency = "high"
+  }
+}
+
+resource "pagerduty_incident" "foo" {
+  title   = "%[2]s"
+  service = pagerduty_service.foo.id
+  urgency = "%[3]s"
+  status  = "%[4]s"
+  body    = "Gameday exercise"
+  from    = pagerduty_user.foo.email
+}
+`, name, title, urgency, status)
+}
@@ -0,0 +1,160 @@
+package pagerduty
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyIncidentType() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourcePagerDutyIncidentTypeRead,
+		UpdateContext: resourcePagerDutyIncidentTypeUpdate,
+		DeleteContext: resourcePagerDutyIncidentTypeDelete,
+		CreateContext: resourcePagerDutyIncidentTypeCreate,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parent_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourcePagerDutyIncidentTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	it := buildIncidentTypeStruct(d)
+	it.Name = d.Get("name").(string)
+	it.ParentType = d.Get("parent_type").(string)
+
+	log.Printf("[INFO] Creating PagerDuty incident type %s", it.Name)
+
+	createdType, _, err := client.IncidentTypes.CreateContext(ctx, it)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattenIncidentType(d, createdType)
+	return nil
+}
+
+func resourcePagerDutyIncidentTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading PagerDuty incident type %s", d.Id())
+
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		it, _, err := client.IncidentTypes.GetContext(ctx, d.Id())
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		flattenIncidentType(d, it)
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourcePagerDutyIncidentTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Updating PagerDuty incident type %s", d.Id())
+
+	updatedType, _, err := client.IncidentTypes.UpdateContext(ctx, d.Id(), buildIncidentTypeStruct(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattenIncidentType(d, updatedType)
+	return nil
+}
+
+// Incident types can't be deleted, so they are disabled instead
+func resourcePagerDutyIncidentTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Disabling PagerDuty incident type %s, as incident types can't be deleted", d.Id())
+
+	enabled := false
+	if _, _, err := client.IncidentTypes.UpdateContext(ctx, d.Id(), &pagerduty.IncidentType{Enabled: &enabled}); err != nil && !isErrCode(err, 404) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func buildIncidentTypeStruct(d *schema.ResourceData) *pagerduty.IncidentType {
+	enabled := d.Get("enabled").(bool)
+	description := d.Get("description").(string)
+
+	return &pagerduty.IncidentType{
+		DisplayName: d.Get("display_name").(string),
+		Description: &description,
+		Enabled:     &enabled,
+	}
+}
+
+func flattenIncidentType(d *schema.ResourceData, it *pagerduty.IncidentType) {
+	d.SetId(it.ID)
+	d.Set("name", it.Name)
+	d.Set("display_name", it.DisplayName)
+	if it.Description != nil {
+		d.Set("description", *it.Description)
+	}
+	if it.Enabled != nil {
+		d.Set("enabled", *it.Enabled)
+	}
+	if it.Parent != nil {
+		d.Set("parent_type", it.Parent.ID)
+	}
+}
@@ -0,0 +1,326 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyIncidentTypeCustomField() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourcePagerDutyIncidentTypeCustomFieldRead,
+		UpdateContext: resourcePagerDutyIncidentTypeCustomFieldUpdate,
+		DeleteContext: resourcePagerDutyIncidentTypeCustomFieldDelete,
+		CreateContext: resourcePagerDutyIncidentTypeCustomFieldCreate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePagerDutyIncidentTypeCustomFieldImport,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := validateIncidentCustomFieldDefaultValue(ctx, d, meta); err != nil {
+				return err
+			}
+			if o, ok := d.GetOk("field_options"); ok && o.(*schema.Set).Len() > 0 && !strings.HasSuffix(d.Get("field_type").(string), "_fixed") {
+				return fmt.Errorf("field_options can only be specified for the field types single_value_fixed and multi_value_fixed")
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"incident_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"data_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateValueFunc([]string{
+					pagerduty.IncidentCustomFieldDataTypeBool,
+					pagerduty.IncidentCustomFieldDataTypeInt,
+					pagerduty.IncidentCustomFieldDataTypeFloat,
+					pagerduty.IncidentCustomFieldDataTypeString,
+					pagerduty.IncidentCustomFieldDataTypeDateTime,
+					pagerduty.IncidentCustomFieldDataTypeURL,
+				}),
+			},
+			"field_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateValueFunc([]string{
+					pagerduty.IncidentCustomFieldFieldTypeSingleValue,
+					pagerduty.IncidentCustomFieldFieldTypeSingleValueFixed,
+					pagerduty.IncidentCustomFieldFieldTypeMultiValue,
+					pagerduty.IncidentCustomFieldFieldTypeMultiValueFixed,
+				}),
+			},
+			"default_value": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"field_options": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourcePagerDutyIncidentTypeCustomFieldCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	typeID := d.Get("incident_type").(string)
+	field, err := buildIncidentTypeCustomFieldStruct(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, v := range d.Get("field_options").(*schema.Set).List() {
+		field.FieldOptions = append(field.FieldOptions, buildIncidentTypeCustomFieldOptionStruct(field.DataType, v.(string)))
+	}
+
+	log.Printf("[INFO] Creating PagerDuty custom field %s of incident type %s", field.Name, typeID)
+
+	createdField, _, err := client.IncidentTypes.CreateCustomFieldContext(ctx, typeID, field)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(createdField.ID)
+
+	return resourcePagerDutyIncidentTypeCustomFieldRead(ctx, d, meta)
+}
+
+func resourcePagerDutyIncidentTypeCustomFieldRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	typeID := d.Get("incident_type").(string)
+
+	log.Printf("[INFO] Reading PagerDuty custom field %s of incident type %s", d.Id(), typeID)
+
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		field, _, err := client.IncidentTypes.GetCustomFieldContext(ctx, typeID, d.Id())
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		if err := flattenIncidentTypeCustomField(d, field); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourcePagerDutyIncidentTypeCustomFieldUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	typeID := d.Get("incident_type").(string)
+
+	if d.HasChanges("display_name", "description", "default_value", "enabled") {
+		field, err := buildIncidentTypeCustomFieldStruct(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		log.Printf("[INFO] Updating PagerDuty custom field %s of incident type %s", d.Id(), typeID)
+
+		if _, _, err := client.IncidentTypes.UpdateCustomFieldContext(ctx, typeID, d.Id(), field); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("field_options") {
+		if err := updateIncidentTypeCustomFieldOptions(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourcePagerDutyIncidentTypeCustomFieldRead(ctx, d, meta)
+}
+
+// updateIncidentTypeCustomFieldOptions removes the options which are no
+// longer configured, then adds the new ones. The options which are kept
+// aren't touched, so the incidents using them keep their value.
+func updateIncidentTypeCustomFieldOptions(ctx context.Context, client *pagerduty.Client, d *schema.ResourceData) error {
+	typeID := d.Get("incident_type").(string)
+
+	field, _, err := client.IncidentTypes.GetCustomFieldContext(ctx, typeID, d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, n := d.GetChange("field_options")
+	oldOptions, newOptions := o.(*schema.Set), n.(*schema.Set)
+
+	for _, option := range field.FieldOptions {
+		if option.Data == nil || newOptions.Contains(option.Data.Value) || !oldOptions.Contains(option.Data.Value) {
+			continue
+		}
+		log.Printf("[INFO] Removing option %s of PagerDuty custom field %s of incident type %s", option.Data.Value, d.Id(), typeID)
+		if _, err := client.IncidentTypes.DeleteCustomFieldOptionContext(ctx, typeID, d.Id(), option.ID); err != nil && !isErrCode(err, 404) {
+			return err
+		}
+	}
+
+	for _, v := range newOptions.Difference(oldOptions).List() {
+		log.Printf("[INFO] Adding option %s to PagerDuty custom field %s of incident type %s", v, d.Id(), typeID)
+		option := buildIncidentTypeCustomFieldOptionStruct(d.Get("data_type").(string), v.(string))
+		if _, _, err := client.IncidentTypes.CreateCustomFieldOptionContext(ctx, typeID, d.Id(), option); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourcePagerDutyIncidentTypeCustomFieldDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	typeID := d.Get("incident_type").(string)
+
+	log.Printf("[INFO] Deleting PagerDuty custom field %s of incident type %s", d.Id(), typeID)
+
+	if _, err := client.IncidentTypes.DeleteCustomFieldContext(ctx, typeID, d.Id()); err != nil && !isErrCode(err, 404) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourcePagerDutyIncidentTypeCustomFieldImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	ids := strings.Split(d.Id(), ":")
+
+	if len(ids) == 1 {
+		resp, _, err := client.IncidentTypes.ListCustomFieldsContext(ctx, ids[0])
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		var childIDs []string
+		for _, f := range resp.Fields {
+			childIDs = append(childIDs, fmt.Sprintf("%s:%s", ids[0], f.ID))
+		}
+		return []*schema.ResourceData{}, importByParentIDError("pagerduty_incident_type_custom_field", ids[0], childIDs)
+	}
+
+	if len(ids) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_incident_type_custom_field. Expecting an importation ID formed as '<incident_type_id>:<field_id>'")
+	}
+	typeID, id := ids[0], ids[1]
+
+	field, _, err := client.IncidentTypes.GetCustomFieldContext(ctx, typeID, id)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.Set("incident_type", typeID)
+	if err := flattenIncidentTypeCustomField(d, field); err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildIncidentTypeCustomFieldStruct(d *schema.ResourceData) (*pagerduty.IncidentCustomField, error) {
+	field, err := buildIncidentCustomFieldStruct(d)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := d.Get("enabled").(bool)
+	field.Enabled = &enabled
+
+	return field, nil
+}
+
+func buildIncidentTypeCustomFieldOptionStruct(dataType, value string) *pagerduty.IncidentCustomFieldOption {
+	return &pagerduty.IncidentCustomFieldOption{
+		Type: "field_option",
+		Data: &pagerduty.IncidentCustomFieldOptionData{
+			DataType: dataType,
+			Value:    value,
+		},
+	}
+}
+
+func flattenIncidentTypeCustomField(d *schema.ResourceData, field *pagerduty.IncidentCustomField) error {
+	if err := flattenIncidentCustomField(d, field); err != nil {
+		return err
+	}
+
+	if field.Enabled != nil {
+		d.Set("enabled", *field.Enabled)
+	}
+
+	var options []string
+	for _, o := range field.FieldOptions {
+		if o.Data != nil {
+			options = append(options, o.Data.Value)
+		}
+	}
+	d.Set("field_options", options)
+
+	return nil
+}
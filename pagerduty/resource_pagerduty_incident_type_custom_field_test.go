@@ -0,0 +1,137 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyIncidentTypeCustomField_Basic(t *testing.T) {
+	typeName := fmt.Sprintf("tf_%s", acctest.RandString(5))
+	fieldName := fmt.Sprintf("tf_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPagerDutyIncidentTypeCustomFieldDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentTypeCustomFieldConfig(typeName, fieldName, "Region", `["us-east", "eu-west"]`, `jsonencode("us-east")`, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentTypeCustomFieldExists("pagerduty_incident_type_custom_field.test"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_incident_type_custom_field.test", "incident_type", "pagerduty_incident_type.test", "id"),
+					resource.TestCheckResourceAttr("pagerduty_incident_type_custom_field.test", "name", fieldName),
+					resource.TestCheckResourceAttr("pagerduty_incident_type_custom_field.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("pagerduty_incident_type_custom_field.test", "default_value", `"us-east"`),
+					resource.TestCheckResourceAttr("pagerduty_incident_type_custom_field.test", "field_options.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyIncidentTypeCustomFieldConfig(typeName, fieldName, "Affected Region", `["us-east", "ap-south"]`, `jsonencode("us-east")`, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentTypeCustomFieldExists("pagerduty_incident_type_custom_field.test"),
+					resource.TestCheckResourceAttr("pagerduty_incident_type_custom_field.test", "display_name", "Affected Region"),
+					resource.TestCheckResourceAttr("pagerduty_incident_type_custom_field.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("pagerduty_incident_type_custom_field.test", "field_options.#", "2"),
+					resource.TestCheckTypeSetElemAttr("pagerduty_incident_type_custom_field.test", "field_options.*", "ap-south"),
+				),
+			},
+			// Removing the default value must remove it in PagerDuty too
+			{
+				Config: testAccCheckPagerDutyIncidentTypeCustomFieldConfig(typeName, fieldName, "Affected Region", `["us-east", "ap-south"]`, "null", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentTypeCustomFieldExists("pagerduty_incident_type_custom_field.test"),
+					resource.TestCheckNoResourceAttr("pagerduty_incident_type_custom_field.test", "default_value"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyIncidentTypeCustomField_OptionsWithoutFixedType(t *testing.T) {
+	config := `
+resource "pagerduty_incident_type_custom_field" "test" {
+  incident_type = "ignored"
+  name          = "tf_ignored"
+  display_name  = "Ignored"
+  data_type     = "string"
+  field_type    = "single_value"
+  field_options = ["us-east"]
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("field_options can only be specified for the field types single_value_fixed and multi_value_fixed"),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentTypeCustomFieldConfig(typeName, fieldName, displayName, options, defaultValue string, enabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "pagerduty_incident_type_custom_field" "test" {
+  incident_type = pagerduty_incident_type.test.id
+  name          = "%s"
+  display_name  = "%s"
+  data_type     = "string"
+  field_type    = "single_value_fixed"
+  default_value = %s
+  field_options = %s
+  enabled       = %t
+}
+`, testAccCheckPagerDutyIncidentTypeConfig(typeName, "Major Incident", true), fieldName, displayName, defaultValue, options, enabled)
+}
+
+func testAccCheckPagerDutyIncidentTypeCustomFieldDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_incident_type_custom_field" {
+			continue
+		}
+
+		if _, _, err := client.IncidentTypes.GetCustomField(r.Primary.Attributes["incident_type"], r.Primary.ID); err == nil {
+			return fmt.Errorf("incident type custom field still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyIncidentTypeCustomFieldExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no incident type custom field ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.IncidentTypes.GetCustomField(rs.Primary.Attributes["incident_type"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("incident type custom field not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,100 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyIncidentType_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPagerDutyIncidentTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentTypeConfig(name, "Major Incident", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentTypeExists("pagerduty_incident_type.test"),
+					resource.TestCheckResourceAttr("pagerduty_incident_type.test", "name", name),
+					resource.TestCheckResourceAttr("pagerduty_incident_type.test", "display_name", "Major Incident"),
+					resource.TestCheckResourceAttr("pagerduty_incident_type.test", "enabled", "true"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_incident_type.test", "parent_type", "data.pagerduty_incident_type.base", "id"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyIncidentTypeConfig(name, "Major Incident (SEV1)", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentTypeExists("pagerduty_incident_type.test"),
+					resource.TestCheckResourceAttr("pagerduty_incident_type.test", "display_name", "Major Incident (SEV1)"),
+					resource.TestCheckResourceAttr("pagerduty_incident_type.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentTypeConfig(name, displayName string, enabled bool) string {
+	return fmt.Sprintf(`
+data "pagerduty_incident_type" "base" {
+  display_name = "Base Incident"
+}
+
+resource "pagerduty_incident_type" "test" {
+  name         = "%s"
+  display_name = "%s"
+  description  = "Incidents impacting customers"
+  parent_type  = data.pagerduty_incident_type.base.id
+  enabled      = %t
+}
+`, name, displayName, enabled)
+}
+
+// Incident types can't be deleted, destroying them disables them
+func testAccCheckPagerDutyIncidentTypeDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_incident_type" {
+			continue
+		}
+
+		it, _, err := client.IncidentTypes.Get(r.Primary.ID)
+		if err == nil && it.Enabled != nil && *it.Enabled {
+			return fmt.Errorf("incident type is still enabled")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyIncidentTypeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no incident type ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.IncidentTypes.Get(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("incident type not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
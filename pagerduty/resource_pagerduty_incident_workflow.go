@@ -2,9 +2,13 @@ package pagerduty
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -22,7 +26,12 @@ func resourcePagerDutyIncidentWorkflow() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: customizeIncidentWorkflowDiff(),
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := customizeIncidentWorkflowDiff()(ctx, d, meta); err != nil {
+				return err
+			}
+			return validateIncidentWorkflowStepInputs(ctx, d, meta)
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -33,50 +42,92 @@ func resourcePagerDutyIncidentWorkflow() *schema.Resource {
 				Optional: true,
 				Default:  "Managed by Terraform",
 			},
+			"team": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"step": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"action": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"input": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"value": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"generated": {
-										Type:     schema.TypeBool,
-										Computed: true,
-									},
-								},
-							},
-						},
+					Schema: incidentWorkflowStepSchema(incidentWorkflowInlineStepsMaxDepth, true),
+				},
+			},
+		},
+	}
+}
+
+// incidentWorkflowInlineStepsMaxDepth bounds how deeply steps can be nested
+// in inline steps inputs, since the schema of a step can't refer to itself.
+const incidentWorkflowInlineStepsMaxDepth = 5
+
+// incidentWorkflowStepSchema returns the schema of a step whose inline steps
+// inputs hold steps themselves, down to the given depth. Only the top-level
+// steps have an ID.
+func incidentWorkflowStepSchema(depth int, topLevel bool) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"action": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"input": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"value": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"generated": {
+						Type:     schema.TypeBool,
+						Computed: true,
 					},
 				},
 			},
 		},
 	}
+
+	if topLevel {
+		s["id"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+
+	if depth > 0 {
+		s["inline_steps_input"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"step": {
+						Type:     schema.TypeList,
+						Required: true,
+						Elem: &schema.Resource{
+							Schema: incidentWorkflowStepSchema(depth-1, false),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return s
 }
 
 // It is allowed for an incident workflow to return more inputs for a step than are present in the
@@ -84,7 +135,7 @@ func resourcePagerDutyIncidentWorkflow() *schema.Resource {
 // These inputs get persisted in the state with `generated` set to `true`
 // but then should not be removed by a `terraform apply`
 func customizeIncidentWorkflowDiff() schema.CustomizeDiffFunc {
-	inputCountRegex := regexp.MustCompile(`^(step\.(\d+)\.input)\.#$`)
+	inputCountRegex := regexp.MustCompile(`^((step\.\d+(?:\.inline_steps_input\.\d+\.step\.\d+)*)\.input)\.#$`)
 
 	nameDoesNotExistAlready := func(inputs []interface{}, name string) bool {
 		for _, v := range inputs {
@@ -111,8 +162,19 @@ func customizeIncidentWorkflowDiff() schema.CustomizeDiffFunc {
 		return result, addedNames
 	}
 
-	updateStepInput := func(step interface{}, index int64, input interface{}) {
-		step.([]interface{})[index].(map[string]interface{})["input"] = input
+	// updateStepInput sets the inputs of the step at the given path, e.g.
+	// step.0.inline_steps_input.1.step.2, walking through the inline steps.
+	updateStepInput := func(steps interface{}, stepPath string, input interface{}) {
+		parts := strings.Split(stepPath, ".")[1:]
+		var m map[string]interface{}
+		for i := 0; i < len(parts); i += 2 {
+			index, _ := strconv.Atoi(parts[i])
+			m = steps.([]interface{})[index].(map[string]interface{})
+			if i+1 < len(parts) {
+				steps = m[parts[i+1]]
+			}
+		}
+		m["input"] = input
 	}
 
 	return func(ctx context.Context, d *schema.ResourceDiff, _ interface{}) error {
@@ -130,9 +192,9 @@ func customizeIncidentWorkflowDiff() schema.CustomizeDiffFunc {
 					inputsFromState, inputsAfterDiff := d.GetChange(inputKey)
 
 					replacementInput, addedNames := addMissingGeneratedInputs(inputsFromState.([]interface{}), inputsAfterDiff.([]interface{}))
-					stepIndex, _ := strconv.ParseInt(indexMatch[2], 10, 32)
-					updateStepInput(newStep, stepIndex, replacementInput)
-					log.Printf("[INFO] Updating diff for step %d to include generated inputs %v.", stepIndex, addedNames)
+					stepPath := indexMatch[2]
+					updateStepInput(newStep, stepPath, replacementInput)
+					log.Printf("[INFO] Updating diff for %s to include generated inputs %v.", stepPath, addedNames)
 					needToSetNew = true
 				}
 			}
@@ -149,6 +211,152 @@ func customizeIncidentWorkflowDiff() schema.CustomizeDiffFunc {
 	}
 }
 
+// incidentWorkflowActions caches the actions of the catalog looked up while
+// planning, since steps of different workflows often share the same actions.
+var (
+	incidentWorkflowActionsMu sync.Mutex
+	incidentWorkflowActions   = make(map[string]*pagerduty.IncidentWorkflowAction)
+)
+
+func getIncidentWorkflowAction(ctx context.Context, client *pagerduty.Client, id string) (*pagerduty.IncidentWorkflowAction, error) {
+	incidentWorkflowActionsMu.Lock()
+	defer incidentWorkflowActionsMu.Unlock()
+
+	if a, ok := incidentWorkflowActions[id]; ok {
+		return a, nil
+	}
+
+	a, _, err := client.IncidentWorkflows.GetActionContext(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	incidentWorkflowActions[id] = a
+
+	return a, nil
+}
+
+// validateIncidentWorkflowStepInputs checks the inputs of each step against
+// the definition of its action in the actions catalog, so that unknown,
+// missing or mistyped inputs are reported at plan time rather than by the
+// workflow run.
+func validateIncidentWorkflowStepInputs(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	return validateIncidentWorkflowStepsInputs(ctx, d, client, "step")
+}
+
+// validateIncidentWorkflowStepsInputs validates the steps of the list at the
+// given key, then the steps of their inline steps inputs.
+func validateIncidentWorkflowStepsInputs(ctx context.Context, d *schema.ResourceDiff, client *pagerduty.Client, key string) error {
+	n := d.Get(key + ".#").(int)
+	for si := 0; si < n; si++ {
+		prefix := fmt.Sprintf("%s.%d", key, si)
+		if err := validateIncidentWorkflowStepInputsAt(ctx, d, client, prefix); err != nil {
+			return err
+		}
+
+		// The steps at the maximum depth have no inline_steps_input
+		isn, _ := d.Get(prefix + ".inline_steps_input.#").(int)
+		for isi := 0; isi < isn; isi++ {
+			if err := validateIncidentWorkflowStepsInputs(ctx, d, client, fmt.Sprintf("%s.inline_steps_input.%d.step", prefix, isi)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateIncidentWorkflowStepInputsAt(ctx context.Context, d *schema.ResourceDiff, client *pagerduty.Client, prefix string) error {
+	if !d.NewValueKnown(prefix + ".action") {
+		return nil
+	}
+
+	actionID := d.Get(prefix + ".action").(string)
+	action, err := getIncidentWorkflowAction(ctx, client, actionID)
+	if err != nil {
+		if isErrCode(err, 404) {
+			return fmt.Errorf("%s: action %s was not found in the incident workflow actions catalog", prefix, actionID)
+		}
+		log.Printf("[WARN] Unable to get incident workflow action %s, skipping the validation of the inputs of %s: %s", actionID, prefix, err)
+		return nil
+	}
+
+	// Unknown values are nil, they are only checked once known
+	inputs := make(map[string]*string)
+	for ii := range d.Get(prefix + ".input").([]interface{}) {
+		ip := fmt.Sprintf("%s.input.%d", prefix, ii)
+		if d.Get(ip + ".generated").(bool) {
+			continue
+		}
+		var value *string
+		if d.NewValueKnown(ip + ".value") {
+			v := d.Get(ip + ".value").(string)
+			value = &v
+		}
+		inputs[d.Get(ip+".name").(string)] = value
+	}
+	// Inline steps inputs are inputs of the action as well, their steps are
+	// validated on their own
+	isn, _ := d.Get(prefix + ".inline_steps_input.#").(int)
+	for isi := 0; isi < isn; isi++ {
+		inputs[d.Get(fmt.Sprintf("%s.inline_steps_input.%d.name", prefix, isi)).(string)] = nil
+	}
+
+	if err := validateIncidentWorkflowActionInputs(action, inputs); err != nil {
+		return fmt.Errorf("%s: %s", prefix, err)
+	}
+
+	return nil
+}
+
+func validateIncidentWorkflowActionInputs(action *pagerduty.IncidentWorkflowAction, inputs map[string]*string) error {
+	if action == nil || action.Metadata == nil {
+		return nil
+	}
+
+	definitions := make(map[string]*pagerduty.IncidentWorkflowActionInputDefinition)
+	var names []string
+	for _, def := range action.Metadata.Inputs {
+		definitions[def.Name] = def
+		names = append(names, def.Name)
+	}
+
+	for name, value := range inputs {
+		def, ok := definitions[name]
+		if !ok {
+			sort.Strings(names)
+			return fmt.Errorf("action %s has no input %q, expected one of: %s", action.ID, name, strings.Join(names, ", "))
+		}
+		// Values with templates are only known when the workflow runs
+		if value == nil || strings.Contains(*value, "{{") {
+			continue
+		}
+
+		switch strings.ToLower(def.ParameterType) {
+		case "boolean":
+			if _, err := strconv.ParseBool(*value); err != nil {
+				return fmt.Errorf("input %q of action %s must be a boolean, got %q", name, action.ID, *value)
+			}
+		case "integer", "number":
+			if _, err := strconv.ParseFloat(*value, 64); err != nil {
+				return fmt.Errorf("input %q of action %s must be a number, got %q", name, action.ID, *value)
+			}
+		}
+	}
+
+	for _, def := range action.Metadata.Inputs {
+		if _, ok := inputs[def.Name]; !ok && def.IsRequired && def.DefaultValue == nil {
+			return fmt.Errorf("input %q is required by action %s", def.Name, action.ID)
+		}
+	}
+
+	return nil
+}
+
 func resourcePagerDutyIncidentWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -268,16 +476,7 @@ func createNonGeneratedInputNamesFromWorkflowStepsInResourceData(d *schema.Resou
 			for _, v := range steps {
 				stepData := v.(map[string]interface{})
 				if id, idOk := stepData["id"]; idOk {
-					nonGeneratedInputNamesForStep := make([]string, 0)
-					if sdis, inputOk := stepData["input"]; inputOk {
-						for _, sdi := range sdis.([]interface{}) {
-							inputData := sdi.(map[string]interface{})
-							if b, ok := inputData["generated"]; !ok || !b.(bool) {
-								nonGeneratedInputNamesForStep = append(nonGeneratedInputNamesForStep, inputData["name"].(string))
-							}
-						}
-					}
-					nonGeneratedInputNames[id.(string)] = nonGeneratedInputNamesForStep
+					addNonGeneratedInputNamesFromStepData(nonGeneratedInputNames, id.(string), stepData)
 				}
 			}
 		}
@@ -285,29 +484,73 @@ func createNonGeneratedInputNamesFromWorkflowStepsInResourceData(d *schema.Resou
 	return nonGeneratedInputNames
 }
 
+// Inline steps have no ID of their own, so their inputs are keyed by their
+// position under the top-level step, e.g. STEPID/Loop Steps/0.
+func inlineIncidentWorkflowStepKey(parentKey, inlineStepsInputName string, index int) string {
+	return fmt.Sprintf("%s/%s/%d", parentKey, inlineStepsInputName, index)
+}
+
+func addNonGeneratedInputNamesFromStepData(nonGeneratedInputNames map[string][]string, key string, stepData map[string]interface{}) {
+	nonGeneratedInputNamesForStep := make([]string, 0)
+	if sdis, inputOk := stepData["input"]; inputOk {
+		for _, sdi := range sdis.([]interface{}) {
+			inputData := sdi.(map[string]interface{})
+			if b, ok := inputData["generated"]; !ok || !b.(bool) {
+				nonGeneratedInputNamesForStep = append(nonGeneratedInputNamesForStep, inputData["name"].(string))
+			}
+		}
+	}
+	nonGeneratedInputNames[key] = nonGeneratedInputNamesForStep
+
+	if isis, ok := stepData["inline_steps_input"]; ok && isis != nil {
+		for _, isi := range isis.([]interface{}) {
+			inlineStepsInputData := isi.(map[string]interface{})
+			name := inlineStepsInputData["name"].(string)
+			for i, v := range inlineStepsInputData["step"].([]interface{}) {
+				addNonGeneratedInputNamesFromStepData(nonGeneratedInputNames, inlineIncidentWorkflowStepKey(key, name, i), v.(map[string]interface{}))
+			}
+		}
+	}
+}
+
 func createNonGeneratedInputNamesFromWorkflowStepsWithoutIDs(iw *pagerduty.IncidentWorkflow, stepIdMapping map[int]string) map[string][]string {
 	nonGeneratedInputNames := map[string][]string{}
 
 	if iw != nil {
 		for i, step := range iw.Steps {
-			nonGeneratedInputNamesForStep := make([]string, 0)
-			if step.Configuration != nil {
-				for _, input := range step.Configuration.Inputs {
-					nonGeneratedInputNamesForStep = append(nonGeneratedInputNamesForStep, input.Name)
-				}
-			}
-			nonGeneratedInputNames[stepIdMapping[i]] = nonGeneratedInputNamesForStep
+			addNonGeneratedInputNamesFromConfiguration(nonGeneratedInputNames, stepIdMapping[i], step.Configuration)
 		}
 	}
 	return nonGeneratedInputNames
 }
 
+func addNonGeneratedInputNamesFromConfiguration(nonGeneratedInputNames map[string][]string, key string, c *pagerduty.IncidentWorkflowActionConfiguration) {
+	nonGeneratedInputNamesForStep := make([]string, 0)
+	if c != nil {
+		for _, input := range c.Inputs {
+			nonGeneratedInputNamesForStep = append(nonGeneratedInputNamesForStep, input.Name)
+		}
+		for _, isi := range c.InlineStepsInputs {
+			if isi.Value == nil {
+				continue
+			}
+			for i, step := range isi.Value.Steps {
+				addNonGeneratedInputNamesFromConfiguration(nonGeneratedInputNames, inlineIncidentWorkflowStepKey(key, isi.Name, i), step.Configuration)
+			}
+		}
+	}
+	nonGeneratedInputNames[key] = nonGeneratedInputNamesForStep
+}
+
 func flattenIncidentWorkflow(d *schema.ResourceData, iw *pagerduty.IncidentWorkflow, includeSteps bool, nonGeneratedInputNames map[string][]string) error {
 	d.SetId(iw.ID)
 	d.Set("name", iw.Name)
 	if iw.Description != nil {
 		d.Set("description", *(iw.Description))
 	}
+	if iw.Team != nil {
+		d.Set("team", iw.Team.ID)
+	}
 
 	if includeSteps {
 		steps := flattenIncidentWorkflowSteps(iw, nonGeneratedInputNames)
@@ -320,22 +563,50 @@ func flattenIncidentWorkflow(d *schema.ResourceData, iw *pagerduty.IncidentWorkf
 func flattenIncidentWorkflowSteps(iw *pagerduty.IncidentWorkflow, nonGeneratedInputNames map[string][]string) []map[string]interface{} {
 	newSteps := make([]map[string]interface{}, len(iw.Steps))
 	for i, s := range iw.Steps {
-		nonGeneratedInputNamesForStep, ok := nonGeneratedInputNames[s.ID]
-		if !ok {
-			nonGeneratedInputNamesForStep = make([]string, 0)
-		}
-
-		m := make(map[string]interface{})
+		m := flattenIncidentWorkflowStepConfiguration(s.Configuration, s.ID, nonGeneratedInputNames, incidentWorkflowInlineStepsMaxDepth)
 		m["id"] = s.ID
 		m["name"] = s.Name
-		m["action"] = s.Configuration.ActionID
-		m["input"] = flattenIncidentWorkflowStepInput(s.Configuration.Inputs, nonGeneratedInputNamesForStep)
 
 		newSteps[i] = m
 	}
 	return newSteps
 }
 
+// flattenIncidentWorkflowStepConfiguration flattens the action, the inputs
+// and the inline steps inputs of the step with the given key. Inline steps
+// nested deeper than the schema allows are left out.
+func flattenIncidentWorkflowStepConfiguration(c *pagerduty.IncidentWorkflowActionConfiguration, key string, nonGeneratedInputNames map[string][]string, depth int) map[string]interface{} {
+	nonGeneratedInputNamesForStep, ok := nonGeneratedInputNames[key]
+	if !ok {
+		nonGeneratedInputNamesForStep = make([]string, 0)
+	}
+
+	m := make(map[string]interface{})
+	m["action"] = c.ActionID
+	m["input"] = flattenIncidentWorkflowStepInput(c.Inputs, nonGeneratedInputNamesForStep)
+
+	if depth > 0 {
+		inlineStepsInputs := make([]interface{}, len(c.InlineStepsInputs))
+		for i, isi := range c.InlineStepsInputs {
+			var steps []interface{}
+			if isi.Value != nil {
+				for si, step := range isi.Value.Steps {
+					sm := flattenIncidentWorkflowStepConfiguration(step.Configuration, inlineIncidentWorkflowStepKey(key, isi.Name, si), nonGeneratedInputNames, depth-1)
+					sm["name"] = step.Name
+					steps = append(steps, sm)
+				}
+			}
+			inlineStepsInputs[i] = map[string]interface{}{
+				"name": isi.Name,
+				"step": steps,
+			}
+		}
+		m["inline_steps_input"] = inlineStepsInputs
+	}
+
+	return m
+}
+
 func flattenIncidentWorkflowStepInput(inputs []*pagerduty.IncidentWorkflowActionInput, nonGeneratedInputNames []string) *[]interface{} {
 	newInputs := make([]interface{}, len(inputs))
 
@@ -370,6 +641,12 @@ func buildIncidentWorkflowStruct(d *schema.ResourceData) (*pagerduty.IncidentWor
 		str := desc.(string)
 		iw.Description = &str
 	}
+	if team, ok := d.GetOk("team"); ok {
+		iw.Team = &pagerduty.TeamReference{
+			ID:   team.(string),
+			Type: "team_reference",
+		}
+	}
 
 	if steps, ok := d.GetOk("step"); ok {
 		iw.Steps = buildIncidentWorkflowStepsStruct(steps)
@@ -386,22 +663,29 @@ func buildIncidentWorkflowStepsStruct(s interface{}) []*pagerduty.IncidentWorkfl
 	for i, v := range steps {
 		stepData := v.(map[string]interface{})
 		step := pagerduty.IncidentWorkflowStep{
-			Name: stepData["name"].(string),
-			Configuration: &pagerduty.IncidentWorkflowActionConfiguration{
-				ActionID: stepData["action"].(string),
-			},
+			Name:          stepData["name"].(string),
+			Configuration: buildIncidentWorkflowActionConfigurationStruct(stepData),
 		}
 		if id, ok := stepData["id"]; ok {
 			step.ID = id.(string)
 		}
 
-		step.Configuration.Inputs = buildIncidentWorkflowInputsStruct(stepData["input"])
-
 		newSteps[i] = &step
 	}
 	return newSteps
 }
 
+func buildIncidentWorkflowActionConfigurationStruct(stepData map[string]interface{}) *pagerduty.IncidentWorkflowActionConfiguration {
+	c := &pagerduty.IncidentWorkflowActionConfiguration{
+		ActionID: stepData["action"].(string),
+		Inputs:   buildIncidentWorkflowInputsStruct(stepData["input"]),
+	}
+	if isis, ok := stepData["inline_steps_input"]; ok && isis != nil {
+		c.InlineStepsInputs = buildIncidentWorkflowInlineStepsInputsStruct(isis)
+	}
+	return c
+}
+
 func buildIncidentWorkflowInputsStruct(in interface{}) []*pagerduty.IncidentWorkflowActionInput {
 	inputs := in.([]interface{})
 	newInputs := make([]*pagerduty.IncidentWorkflowActionInput, len(inputs))
@@ -417,3 +701,29 @@ func buildIncidentWorkflowInputsStruct(in interface{}) []*pagerduty.IncidentWork
 	}
 	return newInputs
 }
+
+func buildIncidentWorkflowInlineStepsInputsStruct(in interface{}) []*pagerduty.IncidentWorkflowActionInlineStepsInput {
+	inlineStepsInputs := in.([]interface{})
+	newInlineStepsInputs := make([]*pagerduty.IncidentWorkflowActionInlineStepsInput, len(inlineStepsInputs))
+
+	for i, v := range inlineStepsInputs {
+		inlineStepsInputData := v.(map[string]interface{})
+		steps := inlineStepsInputData["step"].([]interface{})
+		value := &pagerduty.IncidentWorkflowActionInlineStepsInputValue{
+			Steps: make([]*pagerduty.IncidentWorkflowActionInlineStep, len(steps)),
+		}
+		for si, sv := range steps {
+			stepData := sv.(map[string]interface{})
+			value.Steps[si] = &pagerduty.IncidentWorkflowActionInlineStep{
+				Name:          stepData["name"].(string),
+				Configuration: buildIncidentWorkflowActionConfigurationStruct(stepData),
+			}
+		}
+
+		newInlineStepsInputs[i] = &pagerduty.IncidentWorkflowActionInlineStepsInput{
+			Name:  inlineStepsInputData["name"].(string),
+			Value: value,
+		}
+	}
+	return newInlineStepsInputs
+}
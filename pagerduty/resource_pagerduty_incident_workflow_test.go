@@ -91,6 +91,51 @@ func TestAccPagerDutyIncidentWorkflow_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyIncidentWorkflow_Team(t *testing.T) {
+	workflowName := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckIncidentWorkflows(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPagerDutyIncidentWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentWorkflowConfigWithTeam(workflowName, team),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentWorkflowExists("pagerduty_incident_workflow.test"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_incident_workflow.test", "team", "pagerduty_team.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentWorkflowConfigWithTeam(name, team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+  name = "%s"
+}
+
+resource "pagerduty_incident_workflow" "test" {
+  name = "%s"
+  team = pagerduty_team.foo.id
+  step {
+    name           = "Example Step"
+    action         = "pagerduty.com:incident-workflows:send-status-update:1"
+    input {
+      name = "Message"
+      value = "first update"
+    }
+  }
+}
+`, team, name)
+}
+
 func testAccCheckPagerDutyIncidentWorkflowConfig(name string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_incident_workflow" "test" {
@@ -227,6 +272,104 @@ func TestFlattenIncidentWorkflowStepsOneGenerated(t *testing.T) {
 	}
 }
 
+func TestFlattenIncidentWorkflowStepsInlineSteps(t *testing.T) {
+	stepData := []interface{}{
+		map[string]interface{}{
+			"id":     "abc-123",
+			"name":   "Repeat",
+			"action": "pagerduty.com:logic:repeat:1",
+			"input": []interface{}{
+				map[string]interface{}{"name": "Count", "value": "3"},
+			},
+			"inline_steps_input": []interface{}{
+				map[string]interface{}{
+					"name": "Steps",
+					"step": []interface{}{
+						map[string]interface{}{
+							"name":   "Send Status Update",
+							"action": "pagerduty.com:incident-workflows:send-status-update:1",
+							"input": []interface{}{
+								map[string]interface{}{"name": "Message", "value": "Hello"},
+							},
+							"inline_steps_input": []interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	iw := &pagerduty.IncidentWorkflow{Steps: buildIncidentWorkflowStepsStruct(stepData)}
+	isi := iw.Steps[0].Configuration.InlineStepsInputs
+	if len(isi) != 1 || isi[0].Name != "Steps" || len(isi[0].Value.Steps) != 1 {
+		t.Fatalf("unexpected inline steps inputs: %v", isi)
+	}
+	inline := isi[0].Value.Steps[0]
+	if inline.Name != "Send Status Update" || inline.Configuration.Inputs[0].Value != "Hello" {
+		t.Fatalf("unexpected inline step: %v", inline)
+	}
+
+	// The workflow returned by PagerDuty has a generated input in the inline step
+	nonGeneratedInputNames := createNonGeneratedInputNamesFromWorkflowStepsWithoutIDs(iw, map[int]string{0: "abc-123"})
+	inline.Configuration.Inputs = append(inline.Configuration.Inputs, &pagerduty.IncidentWorkflowActionInput{Name: "Status", Value: "Investigating"})
+
+	r := flattenIncidentWorkflowSteps(iw, nonGeneratedInputNames)
+	flattenedInline := r[0]["inline_steps_input"].([]interface{})[0].(map[string]interface{})["step"].([]interface{})[0].(map[string]interface{})
+	if flattenedInline["name"] != "Send Status Update" {
+		t.Errorf("unexpected flattened inline step: %v", flattenedInline)
+	}
+	inputs := *(flattenedInline["input"].(*[]interface{}))
+	if len(inputs) != 2 {
+		t.Fatalf("flattened inline step had wrong number of inputs. want 2 got %v", len(inputs))
+	}
+	if _, hadGen := inputs[0].(map[string]interface{})["generated"]; hadGen {
+		t.Errorf("was not expecting input Message to be generated")
+	}
+	if gen, hadGen := inputs[1].(map[string]interface{})["generated"]; !hadGen || !(gen.(bool)) {
+		t.Errorf("was expecting input Status to be generated")
+	}
+}
+
+func TestValidateIncidentWorkflowActionInputs(t *testing.T) {
+	defaultValue := "Investigating"
+	action := &pagerduty.IncidentWorkflowAction{
+		ID: "pagerduty.com:incident-workflows:send-status-update:1",
+		Metadata: &pagerduty.IncidentWorkflowActionMetadata{
+			Inputs: []*pagerduty.IncidentWorkflowActionInputDefinition{
+				{Name: "Message", ParameterType: "String", IsRequired: true},
+				{Name: "Status", ParameterType: "String", IsRequired: true, DefaultValue: &defaultValue},
+				{Name: "Notify Subscribers", ParameterType: "Boolean"},
+				{Name: "Delay", ParameterType: "Integer"},
+			},
+		},
+	}
+	str := func(s string) *string { return &s }
+
+	cases := []struct {
+		name    string
+		inputs  map[string]*string
+		wantErr bool
+	}{
+		{"valid", map[string]*string{"Message": str("hello"), "Notify Subscribers": str("true"), "Delay": str("5")}, false},
+		{"unknown values", map[string]*string{"Message": nil, "Delay": nil}, false},
+		{"templated values", map[string]*string{"Message": str("hello"), "Delay": str("{{incident.urgency}}")}, false},
+		{"unknown input", map[string]*string{"Message": str("hello"), "Mesage": str("hello")}, true},
+		{"missing required input", map[string]*string{"Delay": str("5")}, true},
+		{"invalid boolean", map[string]*string{"Message": str("hello"), "Notify Subscribers": str("yes")}, true},
+		{"invalid number", map[string]*string{"Message": str("hello"), "Delay": str("five")}, true},
+	}
+
+	for _, c := range cases {
+		err := validateIncidentWorkflowActionInputs(action, c.inputs)
+		if c.wantErr && err == nil {
+			t.Errorf("%s: expected an error", c.name)
+		}
+		if !c.wantErr && err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+		}
+	}
+}
+
 func testAccPreCheckIncidentWorkflows(t *testing.T) {
 	if v := os.Getenv("PAGERDUTY_ACC_INCIDENT_WORKFLOWS"); v == "" {
 		t.Skip("PAGERDUTY_ACC_INCIDENT_WORKFLOWS not set. Skipping Incident Workflows-related test")
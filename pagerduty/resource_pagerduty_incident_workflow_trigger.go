@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -36,7 +38,7 @@ func resourcePagerDutyIncidentWorkflowTrigger() *schema.Resource {
 				Required: true,
 			},
 			"services": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -50,6 +52,25 @@ func resourcePagerDutyIncidentWorkflowTrigger() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"permissions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"restricted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"team_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -71,12 +92,14 @@ func resourcePagerDutyIncidentWorkflowTriggerCreate(ctx context.Context, d *sche
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	d.SetId(createdWorkflowTrigger.ID)
 
-	err = flattenIncidentWorkflowTrigger(d, createdWorkflowTrigger)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	return nil
+	// Services are associated once the trigger exists, so that large lists
+	// are applied in concurrent batches rather than in a single request.
+	services := expandStringList(d.Get("services").(*schema.Set).List())
+	diags := associateIncidentWorkflowTriggerServices(ctx, client, d.Id(), services, true)
+
+	return append(diags, resourcePagerDutyIncidentWorkflowTriggerRead(ctx, d, meta)...)
 }
 
 func resourcePagerDutyIncidentWorkflowTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -99,18 +122,27 @@ func resourcePagerDutyIncidentWorkflowTriggerUpdate(ctx context.Context, d *sche
 		return diag.FromErr(err)
 	}
 
-	log.Printf("[INFO] Updating PagerDuty incident workflow trigger %s", d.Id())
+	var diags diag.Diagnostics
+	o, n := d.GetChange("services")
+	oldServices, newServices := o.(*schema.Set), n.(*schema.Set)
 
-	updatedWorkflowTrigger, _, err := client.IncidentWorkflowTriggers.UpdateContext(ctx, d.Id(), iwt)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	// Services are removed before the trigger is updated, as it can't be
+	// subscribed to all services while still having some listed.
+	removed := expandStringList(oldServices.Difference(newServices).List())
+	diags = append(diags, associateIncidentWorkflowTriggerServices(ctx, client, d.Id(), removed, false)...)
 
-	err = flattenIncidentWorkflowTrigger(d, updatedWorkflowTrigger)
-	if err != nil {
-		return diag.FromErr(err)
+	if d.HasChanges("subscribed_to_all_services", "condition", "permissions") {
+		log.Printf("[INFO] Updating PagerDuty incident workflow trigger %s", d.Id())
+
+		if _, _, err := client.IncidentWorkflowTriggers.UpdateContext(ctx, d.Id(), iwt); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
 	}
-	return nil
+
+	added := expandStringList(newServices.Difference(oldServices).List())
+	diags = append(diags, associateIncidentWorkflowTriggerServices(ctx, client, d.Id(), added, true)...)
+
+	return append(diags, resourcePagerDutyIncidentWorkflowTriggerRead(ctx, d, meta)...)
 }
 
 func resourcePagerDutyIncidentWorkflowTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -138,10 +170,25 @@ func validateIncidentWorkflowTrigger(_ context.Context, d *schema.ResourceDiff,
 
 	s, hadServices := d.GetOk("services")
 	all := d.Get("subscribed_to_all_services").(bool)
-	if all && hadServices && len(s.([]interface{})) > 0 {
+	if all && hadServices && s.(*schema.Set).Len() > 0 {
 		return fmt.Errorf("when subscribed_to_all_services is true, services must either be not defined or empty")
 	}
 
+	if p, ok := d.GetOk("permissions"); ok && len(p.([]interface{})) > 0 && p.([]interface{})[0] != nil {
+		if triggerType != "manual" && d.HasChange("permissions") {
+			return fmt.Errorf("permissions can only be specified for triggers of type manual")
+		}
+		permissions := p.([]interface{})[0].(map[string]interface{})
+		restricted := permissions["restricted"].(bool)
+		teamID := permissions["team_id"].(string)
+		if restricted && teamID == "" && d.NewValueKnown("permissions.0.team_id") {
+			return fmt.Errorf("when permissions are restricted, team_id must be specified")
+		}
+		if !restricted && teamID != "" {
+			return fmt.Errorf("team_id can only be specified when permissions are restricted")
+		}
+	}
+
 	return nil
 }
 
@@ -172,6 +219,91 @@ func fetchIncidentWorkflowTrigger(ctx context.Context, d *schema.ResourceData, m
 	})
 }
 
+// incidentWorkflowTriggerServiceWorkers bounds how many service associations
+// are sent to PagerDuty at the same time.
+const incidentWorkflowTriggerServiceWorkers = 10
+
+// associateIncidentWorkflowTriggerServices associates (or disassociates) each
+// of the services with the trigger, using a bounded pool of concurrent
+// requests. Every service that couldn't be applied is reported as its own
+// warning, so the rest of the list isn't held back by a single failure and
+// the trigger is kept in the state. Read only records the services actually
+// associated, so the next apply retries the missing ones.
+func associateIncidentWorkflowTriggerServices(ctx context.Context, client *pagerduty.Client, triggerID string, serviceIDs []string, associate bool) diag.Diagnostics {
+	action := "disassociate"
+	if associate {
+		action = "associate"
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		failures = make(map[string]error)
+		jobs     = make(chan string)
+	)
+
+	for i := 0; i < incidentWorkflowTriggerServiceWorkers && i < len(serviceIDs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				if err := applyIncidentWorkflowTriggerService(ctx, client, triggerID, id, associate); err != nil {
+					mu.Lock()
+					failures[id] = err
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	log.Printf("[INFO] Attempting to %s %d services with PagerDuty incident workflow trigger %s", action, len(serviceIDs), triggerID)
+	for _, id := range serviceIDs {
+		jobs <- id
+	}
+	close(jobs)
+	wg.Wait()
+
+	failed := make([]string, 0, len(failures))
+	for id := range failures {
+		failed = append(failed, id)
+	}
+	sort.Strings(failed)
+
+	var diags diag.Diagnostics
+	for _, id := range failed {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Failed to %s service %s with incident workflow trigger %s", action, id, triggerID),
+			Detail:   failures[id].Error(),
+		})
+	}
+	return diags
+}
+
+func applyIncidentWorkflowTriggerService(ctx context.Context, client *pagerduty.Client, triggerID, serviceID string, associate bool) error {
+	return resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		var err error
+		if associate {
+			_, _, err = client.IncidentWorkflowTriggers.AssociateServiceContext(ctx, triggerID, serviceID)
+		} else {
+			_, err = client.IncidentWorkflowTriggers.DisassociateServiceContext(ctx, triggerID, serviceID)
+			if isErrCode(err, 404) {
+				return nil
+			}
+		}
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 404) {
+				return resource.NonRetryableError(err)
+			}
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			time.Sleep(30 * time.Second)
+			return resource.RetryableError(err)
+		}
+		return nil
+	})
+}
+
 func flattenIncidentWorkflowTrigger(d *schema.ResourceData, t *pagerduty.IncidentWorkflowTrigger) error {
 	d.SetId(t.ID)
 	d.Set("type", t.TriggerType.String())
@@ -181,6 +313,14 @@ func flattenIncidentWorkflowTrigger(d *schema.ResourceData, t *pagerduty.Inciden
 	if t.Condition != nil {
 		d.Set("condition", t.Condition)
 	}
+	if t.Permissions != nil {
+		d.Set("permissions", []map[string]interface{}{
+			{
+				"restricted": t.Permissions.Restricted,
+				"team_id":    t.Permissions.TeamID,
+			},
+		})
+	}
 
 	return nil
 }
@@ -205,25 +345,18 @@ func buildIncidentWorkflowTriggerStruct(d *schema.ResourceData, forUpdate bool)
 		iwt.TriggerType = pagerduty.IncidentWorkflowTriggerTypeFromString(d.Get("type").(string))
 	}
 
-	if services, ok := d.GetOk("services"); ok {
-		iwt.Services = buildIncidentWorkflowTriggerServices(services)
-	}
-
 	if condition, ok := d.GetOk("condition"); ok {
 		condStr := condition.(string)
 		iwt.Condition = &condStr
 	}
 
-	return &iwt, nil
-}
-
-func buildIncidentWorkflowTriggerServices(s interface{}) []*pagerduty.ServiceReference {
-	services := s.([]interface{})
-	newServices := make([]*pagerduty.ServiceReference, len(services))
-	for i, v := range services {
-		newServices[i] = &pagerduty.ServiceReference{
-			ID: v.(string),
+	if p, ok := d.GetOk("permissions"); ok && len(p.([]interface{})) > 0 && p.([]interface{})[0] != nil {
+		permissions := p.([]interface{})[0].(map[string]interface{})
+		iwt.Permissions = &pagerduty.IncidentWorkflowTriggerPermissions{
+			Restricted: permissions["restricted"].(bool),
+			TeamID:     permissions["team_id"].(string),
 		}
 	}
-	return newServices
+
+	return &iwt, nil
 }
@@ -1,10 +1,15 @@
 package pagerduty
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -55,6 +60,63 @@ func testSweepIncidentWorkflowTrigger(region string) error {
 	return nil
 }
 
+func TestAssociateIncidentWorkflowTriggerServices(t *testing.T) {
+	var (
+		mu         sync.Mutex
+		associated []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != "POST" || r.URL.Path != "/incident_workflows/triggers/PTRIG01/services" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":2100,"message":"Not Found"}}`))
+			return
+		}
+
+		var body struct {
+			Service struct {
+				ID string `json:"id"`
+			} `json:"service"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.Service.ID == "PBROKEN" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"code":2001,"message":"Invalid Input Provided"}}`))
+			return
+		}
+
+		mu.Lock()
+		associated = append(associated, body.Service.ID)
+		mu.Unlock()
+		w.Write([]byte(`{"trigger":{"id":"PTRIG01"}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: server.URL, SkipCredsValidation: true}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var services []string
+	for i := 0; i < 50; i++ {
+		services = append(services, fmt.Sprintf("PSVC%03d", i))
+	}
+	services = append(services, "PBROKEN")
+
+	diags := associateIncidentWorkflowTriggerServices(context.Background(), client, "PTRIG01", services, true)
+
+	if len(associated) != 50 {
+		t.Errorf("expected 50 associated services, got %d", len(associated))
+	}
+	if len(diags) != 1 {
+		t.Fatalf("expected a single diagnostic for the failed service, got %#v", diags)
+	}
+	if want := "Failed to associate service PBROKEN with incident workflow trigger PTRIG01"; diags[0].Summary != want {
+		t.Errorf("expected summary %q, got %q", want, diags[0].Summary)
+	}
+}
+
 func TestAccPagerDutyIncidentWorkflowTrigger_BadType(t *testing.T) {
 	config := `
 resource "pagerduty_incident_workflow_trigger" "my_first_workflow_trigger" {
@@ -102,6 +164,32 @@ resource "pagerduty_incident_workflow_trigger" "my_first_workflow_trigger" {
 	})
 }
 
+func TestAccPagerDutyIncidentWorkflowTrigger_RestrictedPermissionsWithoutTeam(t *testing.T) {
+	config := `
+resource "pagerduty_incident_workflow_trigger" "my_first_workflow_trigger" {
+  type             = "manual"
+  workflow         = "ignored"
+  subscribed_to_all_services = true
+  permissions {
+    restricted = true
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckIncidentWorkflows(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("when permissions are restricted, team_id must be specified"),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyIncidentWorkflowTrigger_ConditionalTypeWithoutCondition(t *testing.T) {
 	config := `
 resource "pagerduty_incident_workflow_trigger" "my_first_workflow_trigger" {
@@ -171,6 +259,10 @@ func TestAccPagerDutyIncidentWorkflowTrigger_BasicManual(t *testing.T) {
 					testAccCheckPagerDutyIncidentWorkflowTriggerExists("pagerduty_incident_workflow_trigger.test"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_incident_workflow_trigger.test", "type", "manual"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_workflow_trigger.test", "permissions.0.restricted", "true"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_incident_workflow_trigger.test", "permissions.0.team_id", "pagerduty_team.foo", "id"),
 				),
 			},
 		},
@@ -183,13 +275,21 @@ func testAccCheckPagerDutyIncidentWorkflowTriggerConfigManualSingleService(usern
 
 %s
 
+resource "pagerduty_team" "foo" {
+  name = "%s"
+}
+
 resource "pagerduty_incident_workflow_trigger" "test" {
   type       = "manual"
   workflow   = pagerduty_incident_workflow.test.id
   services   = [pagerduty_service.foo.id]
   subscribed_to_all_services = false
+  permissions {
+    restricted = true
+    team_id    = pagerduty_team.foo.id
+  }
 }
-`, testAccCheckPagerDutyServiceConfig(username, email, escalationPolicy, service), testAccCheckPagerDutyIncidentWorkflowConfig(workflow))
+`, testAccCheckPagerDutyServiceConfig(username, email, escalationPolicy, service), testAccCheckPagerDutyIncidentWorkflowConfig(workflow), workflow)
 }
 
 func TestAccPagerDutyIncidentWorkflowTrigger_BasicConditionalAllServices(t *testing.T) {
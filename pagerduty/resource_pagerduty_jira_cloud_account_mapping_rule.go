@@ -0,0 +1,561 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyJiraCloudAccountMappingRule() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourcePagerDutyJiraCloudAccountMappingRuleRead,
+		UpdateContext: resourcePagerDutyJiraCloudAccountMappingRuleUpdate,
+		DeleteContext: resourcePagerDutyJiraCloudAccountMappingRuleDelete,
+		CreateContext: resourcePagerDutyJiraCloudAccountMappingRuleCreate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePagerDutyJiraCloudAccountMappingRuleImport,
+		},
+		CustomizeDiff: validateJiraCloudCustomFields,
+		Schema: map[string]*schema.Schema{
+			"account_mapping": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"jira": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"autocreate_jql": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"create_issue_on_incident_trigger": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"custom_fields": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"source_incident_field": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"target_issue_field": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"target_issue_field_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validateValueFunc([]string{
+														pagerduty.JiraCloudCustomFieldTypeAttribute,
+														pagerduty.JiraCloudCustomFieldTypeConst,
+														pagerduty.JiraCloudCustomFieldTypeJiraValue,
+													}),
+												},
+												"value": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ValidateFunc:     validation.StringIsJSON,
+													DiffSuppressFunc: structure.SuppressJsonDiff,
+													StateFunc: func(v interface{}) string {
+														json, _ := structure.NormalizeJsonString(v)
+														return json
+													},
+												},
+											},
+										},
+									},
+									"issue_type": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem:     jiraCloudReferenceSchema(),
+									},
+									"priorities": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"jira_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"pagerduty_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"project": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"status_mapping": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"triggered": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem:     jiraCloudReferenceSchema(),
+												},
+												"acknowledged": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem:     jiraCloudReferenceSchema(),
+												},
+												"resolved": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem:     jiraCloudReferenceSchema(),
+												},
+											},
+										},
+									},
+									"sync_notes_user": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"autocreate_jql_disabled_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"autocreate_jql_disabled_until": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func jiraCloudReferenceSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+// validateJiraCloudCustomFields checks that the custom fields mapped from an
+// incident field have one, and that the other ones have a value.
+func validateJiraCloudCustomFields(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for i, raw := range d.Get("config.0.jira.0.custom_fields").([]interface{}) {
+		field, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		prefix := fmt.Sprintf("config.0.jira.0.custom_fields.%d", i)
+
+		switch field["type"].(string) {
+		case pagerduty.JiraCloudCustomFieldTypeAttribute:
+			if d.NewValueKnown(prefix+".source_incident_field") && field["source_incident_field"].(string) == "" {
+				return fmt.Errorf("%s.source_incident_field must be set for the type %s", prefix, pagerduty.JiraCloudCustomFieldTypeAttribute)
+			}
+		case pagerduty.JiraCloudCustomFieldTypeConst, pagerduty.JiraCloudCustomFieldTypeJiraValue:
+			if d.NewValueKnown(prefix+".value") && field["value"].(string) == "" {
+				return fmt.Errorf("%s.value must be set for the type %s", prefix, field["type"])
+			}
+		}
+	}
+	return nil
+}
+
+func resourcePagerDutyJiraCloudAccountMappingRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountMappingID := d.Get("account_mapping").(string)
+	rule, err := buildJiraCloudAccountMappingRuleStruct(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating PagerDuty Jira Cloud rule %s of account mapping %s", rule.Name, accountMappingID)
+
+	createdRule, _, err := client.JiraCloud.CreateAccountMappingRuleContext(ctx, accountMappingID, rule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(createdRule.ID)
+
+	return resourcePagerDutyJiraCloudAccountMappingRuleRead(ctx, d, meta)
+}
+
+func resourcePagerDutyJiraCloudAccountMappingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountMappingID := d.Get("account_mapping").(string)
+
+	log.Printf("[INFO] Reading PagerDuty Jira Cloud rule %s of account mapping %s", d.Id(), accountMappingID)
+
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		rule, _, err := client.JiraCloud.GetAccountMappingRuleContext(ctx, accountMappingID, d.Id())
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		if err := flattenJiraCloudAccountMappingRule(d, rule); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourcePagerDutyJiraCloudAccountMappingRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountMappingID := d.Get("account_mapping").(string)
+	rule, err := buildJiraCloudAccountMappingRuleStruct(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Updating PagerDuty Jira Cloud rule %s of account mapping %s", d.Id(), accountMappingID)
+
+	if _, _, err := client.JiraCloud.UpdateAccountMappingRuleContext(ctx, accountMappingID, d.Id(), rule); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourcePagerDutyJiraCloudAccountMappingRuleRead(ctx, d, meta)
+}
+
+func resourcePagerDutyJiraCloudAccountMappingRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountMappingID := d.Get("account_mapping").(string)
+
+	log.Printf("[INFO] Deleting PagerDuty Jira Cloud rule %s of account mapping %s", d.Id(), accountMappingID)
+
+	if _, err := client.JiraCloud.DeleteAccountMappingRuleContext(ctx, accountMappingID, d.Id()); err != nil && !isErrCode(err, 404) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourcePagerDutyJiraCloudAccountMappingRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	ids := strings.Split(d.Id(), ":")
+
+	if len(ids) == 1 {
+		resp, _, err := client.JiraCloud.ListAccountMappingRulesContext(ctx, ids[0])
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		var childIDs []string
+		for _, r := range resp.Rules {
+			childIDs = append(childIDs, fmt.Sprintf("%s:%s", ids[0], r.ID))
+		}
+		return []*schema.ResourceData{}, importByParentIDError("pagerduty_jira_cloud_account_mapping_rule", ids[0], childIDs)
+	}
+
+	if len(ids) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_jira_cloud_account_mapping_rule. Expecting an importation ID formed as '<account_mapping_id>:<rule_id>'")
+	}
+	accountMappingID, id := ids[0], ids[1]
+
+	rule, _, err := client.JiraCloud.GetAccountMappingRuleContext(ctx, accountMappingID, id)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(rule.ID)
+	d.Set("account_mapping", accountMappingID)
+	if err := flattenJiraCloudAccountMappingRule(d, rule); err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildJiraCloudAccountMappingRuleStruct(d *schema.ResourceData) (*pagerduty.JiraCloudAccountMappingRule, error) {
+	config := d.Get("config.0").(map[string]interface{})
+
+	jira, err := expandJiraCloudAccountMappingRuleJira(config["jira"].([]interface{})[0].(map[string]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pagerduty.JiraCloudAccountMappingRule{
+		Name: d.Get("name").(string),
+		Config: &pagerduty.JiraCloudAccountMappingRuleConfig{
+			Service: &pagerduty.ServiceReference{
+				ID:   config["service"].(string),
+				Type: "service_reference",
+			},
+			Jira: jira,
+		},
+	}, nil
+}
+
+func expandJiraCloudAccountMappingRuleJira(m map[string]interface{}) (*pagerduty.JiraCloudAccountMappingRuleJira, error) {
+	jira := &pagerduty.JiraCloudAccountMappingRuleJira{
+		CreateIssueOnIncidentTrigger: m["create_issue_on_incident_trigger"].(bool),
+		CustomFields:                 []*pagerduty.JiraCloudCustomField{},
+		IssueType:                    expandJiraCloudReference(m["issue_type"]),
+		Priorities:                   []*pagerduty.JiraCloudPriorityMapping{},
+	}
+
+	if v := m["autocreate_jql"].(string); v != "" {
+		jira.AutocreateJQL = &v
+	}
+	if v := m["sync_notes_user"].(string); v != "" {
+		jira.SyncNotesUser = &pagerduty.UserReference{
+			ID:   v,
+			Type: "user_reference",
+		}
+	}
+
+	for _, raw := range m["custom_fields"].([]interface{}) {
+		f := raw.(map[string]interface{})
+		field := &pagerduty.JiraCloudCustomField{
+			SourceIncidentField:  f["source_incident_field"].(string),
+			TargetIssueField:     f["target_issue_field"].(string),
+			TargetIssueFieldName: f["target_issue_field_name"].(string),
+			Type:                 f["type"].(string),
+		}
+		if v := f["value"].(string); v != "" {
+			if err := json.Unmarshal([]byte(v), &field.Value); err != nil {
+				return nil, fmt.Errorf("invalid value of the custom field %s: %w", field.TargetIssueField, err)
+			}
+		}
+		jira.CustomFields = append(jira.CustomFields, field)
+	}
+
+	for _, raw := range m["priorities"].([]interface{}) {
+		p := raw.(map[string]interface{})
+		jira.Priorities = append(jira.Priorities, &pagerduty.JiraCloudPriorityMapping{
+			JiraID:      p["jira_id"].(string),
+			PagerDutyID: p["pagerduty_id"].(string),
+		})
+	}
+
+	if project := m["project"].([]interface{}); len(project) > 0 && project[0] != nil {
+		p := project[0].(map[string]interface{})
+		jira.Project = &pagerduty.JiraCloudProject{
+			ID:   p["id"].(string),
+			Key:  p["key"].(string),
+			Name: p["name"].(string),
+		}
+	}
+
+	if statusMapping := m["status_mapping"].([]interface{}); len(statusMapping) > 0 && statusMapping[0] != nil {
+		s := statusMapping[0].(map[string]interface{})
+		jira.StatusMapping = &pagerduty.JiraCloudStatusMapping{
+			Triggered:    expandJiraCloudReference(s["triggered"]),
+			Acknowledged: expandJiraCloudReference(s["acknowledged"]),
+			Resolved:     expandJiraCloudReference(s["resolved"]),
+		}
+	}
+
+	return jira, nil
+}
+
+func expandJiraCloudReference(v interface{}) *pagerduty.JiraCloudReference {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	return &pagerduty.JiraCloudReference{
+		ID:   m["id"].(string),
+		Name: m["name"].(string),
+	}
+}
+
+func flattenJiraCloudAccountMappingRule(d *schema.ResourceData, rule *pagerduty.JiraCloudAccountMappingRule) error {
+	d.Set("name", rule.Name)
+	if rule.AccountMapping != nil {
+		d.Set("account_mapping", rule.AccountMapping.ID)
+	}
+	d.Set("autocreate_jql_disabled_reason", rule.AutocreateJQLDisabledReason)
+	d.Set("autocreate_jql_disabled_until", rule.AutocreateJQLDisabledUntil)
+
+	if rule.Config == nil {
+		return nil
+	}
+
+	config := map[string]interface{}{}
+	if rule.Config.Service != nil {
+		config["service"] = rule.Config.Service.ID
+	}
+	if rule.Config.Jira != nil {
+		jira, err := flattenJiraCloudAccountMappingRuleJira(rule.Config.Jira)
+		if err != nil {
+			return err
+		}
+		config["jira"] = []interface{}{jira}
+	}
+
+	return d.Set("config", []interface{}{config})
+}
+
+func flattenJiraCloudAccountMappingRuleJira(jira *pagerduty.JiraCloudAccountMappingRuleJira) (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"create_issue_on_incident_trigger": jira.CreateIssueOnIncidentTrigger,
+		"issue_type":                       flattenJiraCloudReference(jira.IssueType),
+	}
+
+	if jira.AutocreateJQL != nil {
+		m["autocreate_jql"] = *jira.AutocreateJQL
+	}
+	if jira.SyncNotesUser != nil {
+		m["sync_notes_user"] = jira.SyncNotesUser.ID
+	}
+
+	var customFields []interface{}
+	for _, f := range jira.CustomFields {
+		field := map[string]interface{}{
+			"source_incident_field":   f.SourceIncidentField,
+			"target_issue_field":      f.TargetIssueField,
+			"target_issue_field_name": f.TargetIssueFieldName,
+			"type":                    f.Type,
+		}
+		if f.Value != nil {
+			value, err := json.Marshal(f.Value)
+			if err != nil {
+				return nil, err
+			}
+			field["value"] = string(value)
+		}
+		customFields = append(customFields, field)
+	}
+	m["custom_fields"] = customFields
+
+	var priorities []interface{}
+	for _, p := range jira.Priorities {
+		priorities = append(priorities, map[string]interface{}{
+			"jira_id":      p.JiraID,
+			"pagerduty_id": p.PagerDutyID,
+		})
+	}
+	m["priorities"] = priorities
+
+	if jira.Project != nil {
+		m["project"] = []interface{}{map[string]interface{}{
+			"id":   jira.Project.ID,
+			"key":  jira.Project.Key,
+			"name": jira.Project.Name,
+		}}
+	}
+
+	if jira.StatusMapping != nil {
+		m["status_mapping"] = []interface{}{map[string]interface{}{
+			"triggered":    flattenJiraCloudReference(jira.StatusMapping.Triggered),
+			"acknowledged": flattenJiraCloudReference(jira.StatusMapping.Acknowledged),
+			"resolved":     flattenJiraCloudReference(jira.StatusMapping.Resolved),
+		}}
+	}
+
+	return m, nil
+}
+
+func flattenJiraCloudReference(ref *pagerduty.JiraCloudReference) []interface{} {
+	if ref == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"id":   ref.ID,
+		"name": ref.Name,
+	}}
+}
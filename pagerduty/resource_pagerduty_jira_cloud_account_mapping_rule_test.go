@@ -0,0 +1,239 @@
+package pagerduty
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBuildJiraCloudAccountMappingRuleStruct(t *testing.T) {
+	r := resourcePagerDutyJiraCloudAccountMappingRule()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"account_mapping": "PAM1",
+		"name":            "foo",
+		"config": []interface{}{map[string]interface{}{
+			"service": "PSV1",
+			"jira": []interface{}{map[string]interface{}{
+				"custom_fields": []interface{}{
+					map[string]interface{}{
+						"target_issue_field":      "customfield_10001",
+						"target_issue_field_name": "Team",
+						"type":                    "const",
+						"value":                   `"SRE"`,
+					},
+				},
+				"issue_type": []interface{}{map[string]interface{}{"id": "10001", "name": "Incident"}},
+				"project":    []interface{}{map[string]interface{}{"id": "10100", "key": "ITS", "name": "IT Support"}},
+				"status_mapping": []interface{}{map[string]interface{}{
+					"triggered": []interface{}{map[string]interface{}{"id": "1", "name": "Open"}},
+				}},
+				"sync_notes_user": "PUS1",
+			}},
+		}},
+	})
+
+	rule, err := buildJiraCloudAccountMappingRuleStruct(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jira := rule.Config.Jira
+	if rule.Config.Service.ID != "PSV1" {
+		t.Errorf("expected the service PSV1, got %v", rule.Config.Service)
+	}
+	if jira.CustomFields[0].Value != "SRE" {
+		t.Errorf("expected the custom field value to be decoded from JSON, got %v", jira.CustomFields[0].Value)
+	}
+	if jira.AutocreateJQL != nil {
+		t.Errorf("expected no autocreate JQL, got %v", *jira.AutocreateJQL)
+	}
+	if jira.StatusMapping.Triggered.ID != "1" || jira.StatusMapping.Acknowledged != nil {
+		t.Errorf("expected only the triggered status to be mapped, got %v", jira.StatusMapping)
+	}
+	if jira.SyncNotesUser.ID != "PUS1" || jira.SyncNotesUser.Type != "user_reference" {
+		t.Errorf("expected the sync notes user PUS1, got %v", jira.SyncNotesUser)
+	}
+}
+
+func TestAccPagerDutyJiraCloudAccountMappingRule_Basic(t *testing.T) {
+	accountMapping := os.Getenv("PAGERDUTY_ACC_JIRA_CLOUD_ACCOUNT_MAPPING")
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckJiraCloud(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyJiraCloudAccountMappingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyJiraCloudAccountMappingRuleConfig(accountMapping, name, username, email, escalationPolicy, service, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyJiraCloudAccountMappingRuleExists("pagerduty_jira_cloud_account_mapping_rule.foo"),
+					resource.TestCheckResourceAttr("pagerduty_jira_cloud_account_mapping_rule.foo", "name", name),
+					resource.TestCheckResourceAttr("pagerduty_jira_cloud_account_mapping_rule.foo", "config.0.jira.0.create_issue_on_incident_trigger", "false"),
+					resource.TestCheckResourceAttr("pagerduty_jira_cloud_account_mapping_rule.foo", "config.0.jira.0.custom_fields.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyJiraCloudAccountMappingRuleConfig(accountMapping, name, username, email, escalationPolicy, service, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyJiraCloudAccountMappingRuleExists("pagerduty_jira_cloud_account_mapping_rule.foo"),
+					resource.TestCheckResourceAttr("pagerduty_jira_cloud_account_mapping_rule.foo", "config.0.jira.0.create_issue_on_incident_trigger", "true"),
+				),
+			},
+			{
+				ResourceName:      "pagerduty_jira_cloud_account_mapping_rule.foo",
+				ImportStateIdFunc: testAccCheckPagerDutyJiraCloudAccountMappingRuleID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPreCheckJiraCloud(t *testing.T) {
+	if v := os.Getenv("PAGERDUTY_ACC_JIRA_CLOUD_ACCOUNT_MAPPING"); v == "" {
+		t.Skip("PAGERDUTY_ACC_JIRA_CLOUD_ACCOUNT_MAPPING not set. Skipping Jira Cloud-related test")
+	}
+}
+
+func testAccCheckPagerDutyJiraCloudAccountMappingRuleID(s *terraform.State) (string, error) {
+	rs := s.RootModule().Resources["pagerduty_jira_cloud_account_mapping_rule.foo"]
+	return fmt.Sprintf("%s:%s", rs.Primary.Attributes["account_mapping"], rs.Primary.ID), nil
+}
+
+func testAccCheckPagerDutyJiraCloudAccountMappingRuleDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_jira_cloud_account_mapping_rule" {
+			continue
+		}
+
+		if _, _, err := client.JiraCloud.GetAccountMappingRule(r.Primary.Attributes["account_mapping"], r.Primary.ID); err == nil {
+			return fmt.Errorf("Jira Cloud account mapping rule still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyJiraCloudAccountMappingRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Jira Cloud account mapping rule ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.JiraCloud.GetAccountMappingRule(rs.Primary.Attributes["account_mapping"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Jira Cloud account mapping rule not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyJiraCloudAccountMappingRuleConfig(accountMapping, name, username, email, escalationPolicy, service string, createIssue bool) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[3]s"
+  email = "%[4]s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%[5]s"
+  num_loops = 1
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[6]s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+data "pagerduty_priority" "p1" {
+  name = "P1"
+}
+
+resource "pagerduty_jira_cloud_account_mapping_rule" "foo" {
+  account_mapping = "%[1]s"
+  name            = "%[2]s"
+  config {
+    service = pagerduty_service.foo.id
+    jira {
+      autocreate_jql                   = "priority = Highest"
+      create_issue_on_incident_trigger = %[7]t
+      custom_fields {
+        source_incident_field   = "incident_description"
+        target_issue_field      = "description"
+        target_issue_field_name = "Description"
+        type                    = "attribute"
+      }
+      custom_fields {
+        target_issue_field      = "security"
+        target_issue_field_name = "Security Level"
+        type                    = "jira_value"
+        value = jsonencode({
+          displayName = "Sec Level 1"
+          id          = "10000"
+        })
+      }
+      issue_type {
+        id   = "10001"
+        name = "Incident"
+      }
+      priorities {
+        jira_id      = "1"
+        pagerduty_id = data.pagerduty_priority.p1.id
+      }
+      project {
+        id   = "10100"
+        key  = "ITS"
+        name = "IT Support"
+      }
+      status_mapping {
+        acknowledged {
+          id   = "2"
+          name = "In Progress"
+        }
+        resolved {
+          id   = "3"
+          name = "Resolved"
+        }
+        triggered {
+          id   = "1"
+          name = "Open"
+        }
+      }
+      sync_notes_user = pagerduty_user.foo.id
+    }
+  }
+}
+`, accountMapping, name, username, email, escalationPolicy, service, createIssue)
+}
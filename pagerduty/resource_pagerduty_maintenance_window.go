@@ -1,11 +1,14 @@
 package pagerduty
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
@@ -18,6 +21,7 @@ func resourcePagerDutyMaintenanceWindow() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizePagerDutyMaintenanceWindowDiff,
 		Schema: map[string]*schema.Schema{
 			"start_time": {
 				Type:             schema.TypeString,
@@ -44,10 +48,231 @@ func resourcePagerDutyMaintenanceWindow() *schema.Resource {
 				Optional: true,
 				Default:  "Managed by Terraform",
 			},
+
+			"recurrence": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"frequency": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validateValueFunc([]string{
+								"daily",
+								"weekly",
+							}),
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"horizon_in_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      28,
+							ValidateFunc: validation.IntBetween(1, 365),
+						},
+						"time_zone": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateTimeZone,
+						},
+						"until": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateRFC3339,
+						},
+					},
+				},
+			},
+
+			"windows": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// maintenanceWindowOccurrence is a single instance of a recurring maintenance
+// window.
+type maintenanceWindowOccurrence struct {
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// maintenanceWindowRecurrence is the expanded form of the recurrence block.
+type maintenanceWindowRecurrence struct {
+	Frequency string
+	Interval  int
+	Horizon   time.Duration
+	Location  *time.Location
+	Until     *time.Time
+}
+
+func expandMaintenanceWindowRecurrence(v interface{}) (*maintenanceWindowRecurrence, error) {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0].(map[string]interface{})
+
+	recurrence := &maintenanceWindowRecurrence{
+		Frequency: raw["frequency"].(string),
+		Interval:  raw["interval"].(int),
+		Horizon:   time.Duration(raw["horizon_in_days"].(int)) * 24 * time.Hour,
+	}
+
+	if tz := raw["time_zone"].(string); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, err
+		}
+		recurrence.Location = loc
+	}
+
+	if until := raw["until"].(string); until != "" {
+		t, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			return nil, err
+		}
+		recurrence.Until = &t
+	}
+
+	return recurrence, nil
+}
+
+// maintenanceWindowOccurrences returns the occurrences of a recurring
+// maintenance window which haven't ended yet and start within the recurrence
+// horizon. The first occurrence spans from start to end; the following ones
+// keep the same wall clock time in the recurrence time zone, so that they
+// don't shift across daylight saving time changes.
+func maintenanceWindowOccurrences(start, end time.Time, r *maintenanceWindowRecurrence, now time.Time) []maintenanceWindowOccurrence {
+	var occurrences []maintenanceWindowOccurrence
+
+	days := r.Interval
+	if r.Frequency == "weekly" {
+		days *= 7
+	}
+
+	if r.Location != nil {
+		start = start.In(r.Location)
+	}
+	duration := end.Sub(start)
+	horizon := now.Add(r.Horizon)
+
+	for i := 0; ; i++ {
+		s := start.AddDate(0, 0, i*days)
+		if !s.Before(horizon) || (r.Until != nil && s.After(*r.Until)) {
+			break
+		}
+
+		e := s.Add(duration)
+		if !e.After(now) {
+			continue
+		}
+
+		occurrences = append(occurrences, maintenanceWindowOccurrence{StartTime: s, EndTime: e})
+	}
+
+	return occurrences
+}
+
+func customizePagerDutyMaintenanceWindowDiff(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	o, n := diff.GetChange("recurrence")
+	if len(o.([]interface{})) != len(n.([]interface{})) {
+		return diff.ForceNew("recurrence")
+	}
+
+	recurrence, err := expandMaintenanceWindowRecurrence(n)
+	if err != nil || recurrence == nil {
+		return err
+	}
+
+	for _, key := range []string{"start_time", "end_time", "services", "description", "recurrence"} {
+		if diff.HasChange(key) {
+			return diff.SetNewComputed("windows")
+		}
+	}
+
+	// Upcoming occurrences are materialized as new maintenance windows and
+	// ended ones are dropped from the state on read, so compare the
+	// occurrences due now with the windows currently scheduled.
+	start, end, err := maintenanceWindowBounds(diff.Get("start_time").(string), diff.Get("end_time").(string))
+	if err != nil {
+		return err
+	}
+
+	scheduled := make(map[string]bool)
+	for _, w := range diff.Get("windows").([]interface{}) {
+		scheduled[maintenanceWindowKey(w.(map[string]interface{}))] = true
+	}
+
+	occurrences := maintenanceWindowOccurrences(start, end, recurrence, time.Now())
+	if len(occurrences) != len(scheduled) {
+		return diff.SetNewComputed("windows")
+	}
+	for _, occurrence := range occurrences {
+		if !scheduled[maintenanceWindowOccurrenceKey(occurrence)] {
+			return diff.SetNewComputed("windows")
+		}
+	}
+
+	return nil
+}
+
+func maintenanceWindowBounds(startTime, endTime string) (time.Time, time.Time, error) {
+	start, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		return start, start, err
+	}
+
+	end, err := time.Parse(time.RFC3339, endTime)
+	if err != nil {
+		return start, end, err
+	}
+
+	if !end.After(start) {
+		return start, end, fmt.Errorf("end_time %s must be after start_time %s", endTime, startTime)
+	}
+
+	return start, end, nil
+}
+
+func maintenanceWindowKey(w map[string]interface{}) string {
+	start, _ := time.Parse(time.RFC3339, w["start_time"].(string))
+	end, _ := time.Parse(time.RFC3339, w["end_time"].(string))
+
+	return fmt.Sprintf("%d-%d", start.Unix(), end.Unix())
+}
+
+func maintenanceWindowOccurrenceKey(o maintenanceWindowOccurrence) string {
+	return fmt.Sprintf("%d-%d", o.StartTime.Unix(), o.EndTime.Unix())
+}
+
 func buildMaintenanceWindowStruct(d *schema.ResourceData) *pagerduty.MaintenanceWindow {
 	window := &pagerduty.MaintenanceWindow{
 		StartTime: d.Get("start_time").(string),
@@ -68,6 +293,15 @@ func resourcePagerDutyMaintenanceWindowCreate(d *schema.ResourceData, meta inter
 		return err
 	}
 
+	recurrence, err := expandMaintenanceWindowRecurrence(d.Get("recurrence"))
+	if err != nil {
+		return err
+	}
+
+	if recurrence != nil {
+		return resourcePagerDutyRecurringMaintenanceWindowCreate(d, client, recurrence)
+	}
+
 	window := buildMaintenanceWindowStruct(d)
 
 	log.Printf("[INFO] Creating PagerDuty maintenance window")
@@ -90,6 +324,10 @@ func resourcePagerDutyMaintenanceWindowRead(d *schema.ResourceData, meta interfa
 
 	log.Printf("[INFO] Reading PagerDuty maintenance window %s", d.Id())
 
+	if _, ok := d.GetOk("recurrence"); ok {
+		return resourcePagerDutyRecurringMaintenanceWindowRead(d, client)
+	}
+
 	return resource.Retry(2*time.Minute, func() *resource.RetryError {
 		window, _, err := client.MaintenanceWindows.Get(d.Id())
 		if err != nil {
@@ -120,6 +358,15 @@ func resourcePagerDutyMaintenanceWindowUpdate(d *schema.ResourceData, meta inter
 		return err
 	}
 
+	recurrence, err := expandMaintenanceWindowRecurrence(d.Get("recurrence"))
+	if err != nil {
+		return err
+	}
+
+	if recurrence != nil {
+		return resourcePagerDutyRecurringMaintenanceWindowUpdate(d, client, recurrence)
+	}
+
 	window := buildMaintenanceWindowStruct(d)
 
 	log.Printf("[INFO] Updating PagerDuty maintenance window %s", d.Id())
@@ -139,19 +386,208 @@ func resourcePagerDutyMaintenanceWindowDelete(d *schema.ResourceData, meta inter
 
 	log.Printf("[INFO] Deleting PagerDuty maintenance window %s", d.Id())
 
-	if _, err := client.MaintenanceWindows.Delete(d.Id()); err != nil {
+	if _, ok := d.GetOk("recurrence"); ok {
+		for _, w := range d.Get("windows").([]interface{}) {
+			if err := deletePagerDutyMaintenanceWindow(client, w.(map[string]interface{})["id"].(string)); err != nil {
+				return err
+			}
+		}
+
+		d.SetId("")
+
+		return nil
+	}
+
+	if err := deletePagerDutyMaintenanceWindow(client, d.Id()); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func deletePagerDutyMaintenanceWindow(client *pagerduty.Client, id string) error {
+	if _, err := client.MaintenanceWindows.Delete(id); err != nil {
 		// 405: The maintenance window can't be deleted because it has already ended. This can be considered deleted
 		// from terraform's perspective.
-		if !isErrCode(err, 405) {
+		if !isErrCode(err, 405) && !isErrCode(err, 404) {
 			return err
 		}
 	}
 
-	d.SetId("")
+	return nil
+}
+
+func resourcePagerDutyRecurringMaintenanceWindowCreate(d *schema.ResourceData, client *pagerduty.Client, recurrence *maintenanceWindowRecurrence) error {
+	start, end, err := maintenanceWindowBounds(d.Get("start_time").(string), d.Get("end_time").(string))
+	if err != nil {
+		return err
+	}
+
+	occurrences := maintenanceWindowOccurrences(start, end, recurrence, time.Now())
+	if len(occurrences) == 0 {
+		return fmt.Errorf("the recurrence of the maintenance window has no occurrence within the next %d days", d.Get("recurrence.0.horizon_in_days").(int))
+	}
+
+	log.Printf("[INFO] Creating %d occurrences of PagerDuty maintenance window", len(occurrences))
+
+	var windows []interface{}
+	for _, occurrence := range occurrences {
+		w, err := createPagerDutyMaintenanceWindowOccurrence(d, client, occurrence)
+		if err != nil {
+			d.Set("windows", windows)
+			return err
+		}
+
+		// The ID of the first occurrence identifies the whole recurrence.
+		if d.Id() == "" {
+			d.SetId(w["id"].(string))
+		}
+		windows = append(windows, w)
+	}
+
+	d.Set("windows", windows)
 
 	return nil
 }
 
+func resourcePagerDutyRecurringMaintenanceWindowRead(d *schema.ResourceData, client *pagerduty.Client) error {
+	var windows []interface{}
+	now := time.Now()
+
+	for _, v := range d.Get("windows").([]interface{}) {
+		w := v.(map[string]interface{})
+
+		retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+			window, _, err := client.MaintenanceWindows.Get(w["id"].(string))
+			if err != nil {
+				// A deleted occurrence is dropped, so that it gets scheduled
+				// again on the next apply.
+				if isErrCode(err, 404) {
+					return nil
+				}
+
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(err)
+			}
+
+			if end, err := time.Parse(time.RFC3339, window.EndTime); err == nil && !end.After(now) {
+				return nil
+			}
+
+			if len(windows) == 0 {
+				d.Set("description", window.Description)
+				if err := d.Set("services", flattenServices(window.Services)); err != nil {
+					return resource.NonRetryableError(err)
+				}
+			}
+			windows = append(windows, w)
+
+			return nil
+		})
+		if retryErr != nil {
+			return retryErr
+		}
+	}
+
+	d.Set("windows", windows)
+
+	return nil
+}
+
+func resourcePagerDutyRecurringMaintenanceWindowUpdate(d *schema.ResourceData, client *pagerduty.Client, recurrence *maintenanceWindowRecurrence) error {
+	o, _ := d.GetChange("windows")
+	scheduled := make(map[string]map[string]interface{})
+	var keys []string
+	for _, v := range o.([]interface{}) {
+		w := v.(map[string]interface{})
+		key := maintenanceWindowKey(w)
+		scheduled[key] = w
+		keys = append(keys, key)
+	}
+
+	var windows []interface{}
+
+	// On error, the windows reconciled so far and the scheduled ones not
+	// deleted yet are kept in the state, so that none of them is orphaned.
+	setWindows := func() {
+		all := append([]interface{}{}, windows...)
+		for _, key := range keys {
+			if w, ok := scheduled[key]; ok {
+				all = append(all, w)
+			}
+		}
+		d.Set("windows", all)
+	}
+
+	start, end, err := maintenanceWindowBounds(d.Get("start_time").(string), d.Get("end_time").(string))
+	if err != nil {
+		setWindows()
+		return err
+	}
+
+	log.Printf("[INFO] Reconciling occurrences of PagerDuty maintenance window %s", d.Id())
+
+	for _, occurrence := range maintenanceWindowOccurrences(start, end, recurrence, time.Now()) {
+		key := maintenanceWindowOccurrenceKey(occurrence)
+
+		w, ok := scheduled[key]
+		if !ok {
+			if w, err = createPagerDutyMaintenanceWindowOccurrence(d, client, occurrence); err != nil {
+				setWindows()
+				return err
+			}
+			windows = append(windows, w)
+			continue
+		}
+
+		if d.HasChanges("services", "description") {
+			window := buildMaintenanceWindowStruct(d)
+			window.StartTime = w["start_time"].(string)
+			window.EndTime = w["end_time"].(string)
+
+			if _, _, err := client.MaintenanceWindows.Update(w["id"].(string), window); err != nil {
+				setWindows()
+				return err
+			}
+		}
+		delete(scheduled, key)
+		windows = append(windows, w)
+	}
+
+	for _, key := range keys {
+		if w, ok := scheduled[key]; ok {
+			if err := deletePagerDutyMaintenanceWindow(client, w["id"].(string)); err != nil {
+				setWindows()
+				return err
+			}
+			delete(scheduled, key)
+		}
+	}
+
+	d.Set("windows", windows)
+
+	return nil
+}
+
+func createPagerDutyMaintenanceWindowOccurrence(d *schema.ResourceData, client *pagerduty.Client, occurrence maintenanceWindowOccurrence) (map[string]interface{}, error) {
+	window := buildMaintenanceWindowStruct(d)
+	window.StartTime = occurrence.StartTime.Format(time.RFC3339)
+	window.EndTime = occurrence.EndTime.Format(time.RFC3339)
+
+	window, _, err := client.MaintenanceWindows.Create(window)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"id":         window.ID,
+		"start_time": occurrence.StartTime.Format(time.RFC3339),
+		"end_time":   occurrence.EndTime.Format(time.RFC3339),
+	}, nil
+}
+
 func expandServices(v *schema.Set) []*pagerduty.ServiceReference {
 	var services []*pagerduty.ServiceReference
 
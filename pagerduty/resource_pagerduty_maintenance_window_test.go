@@ -70,6 +70,81 @@ func TestAccPagerDutyMaintenanceWindow_Basic(t *testing.T) {
 	})
 }
 
+func TestMaintenanceWindowOccurrences(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2022, time.October, 21, 18, 0, 0, 0, loc)
+	end := start.Add(4 * time.Hour)
+	now := time.Date(2022, time.October, 29, 12, 0, 0, 0, loc)
+
+	occurrences := maintenanceWindowOccurrences(start, end, &maintenanceWindowRecurrence{
+		Frequency: "weekly",
+		Interval:  1,
+		Horizon:   21 * 24 * time.Hour,
+		Location:  loc,
+	}, now)
+
+	var got []string
+	for _, o := range occurrences {
+		got = append(got, o.StartTime.Format(time.RFC3339))
+	}
+
+	// The first two occurrences have ended and the following ones keep their
+	// wall clock time across the end of daylight saving time on November 6th.
+	expected := []string{
+		"2022-11-04T18:00:00-04:00",
+		"2022-11-11T18:00:00-05:00",
+		"2022-11-18T18:00:00-05:00",
+	}
+	if strings.Join(got, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected the occurrences %v, got %v", expected, got)
+	}
+
+	until := time.Date(2022, time.November, 5, 0, 0, 0, 0, loc)
+	occurrences = maintenanceWindowOccurrences(start, end, &maintenanceWindowRecurrence{
+		Frequency: "daily",
+		Interval:  2,
+		Horizon:   21 * 24 * time.Hour,
+		Until:     &until,
+	}, now)
+
+	if len(occurrences) != 4 {
+		t.Errorf("expected 4 occurrences until %s, got %v", until, occurrences)
+	}
+}
+
+func TestAccPagerDutyMaintenanceWindow_Recurrence(t *testing.T) {
+	window := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	windowStartTime := timeNowInAccLoc().Add(24 * time.Hour).Truncate(time.Hour).Format(time.RFC3339)
+	windowEndTime := timeNowInAccLoc().Add(26 * time.Hour).Truncate(time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyMaintenanceWindowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyMaintenanceWindowConfigRecurrence(window, windowStartTime, windowEndTime, 21),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					resource.TestCheckResourceAttr("pagerduty_maintenance_window.foo", "windows.#", "3"),
+					resource.TestCheckResourceAttr("pagerduty_maintenance_window.foo", "windows.0.start_time", windowStartTime),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyMaintenanceWindowConfigRecurrence(window, windowStartTime, windowEndTime, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					resource.TestCheckResourceAttr("pagerduty_maintenance_window.foo", "windows.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyMaintenanceWindowDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -219,3 +294,43 @@ resource "pagerduty_maintenance_window" "foo" {
 }
 `, desc, start, end)
 }
+
+func testAccCheckPagerDutyMaintenanceWindowConfigRecurrence(desc, start, end string, horizon int) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]v"
+  email = "%[1]v@foo.test"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%[1]v"
+  num_loops = 2
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[1]v"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_maintenance_window" "foo" {
+  description = "%[1]v"
+  start_time  = "%[2]v"
+  end_time    = "%[3]v"
+  services    = [pagerduty_service.foo.id]
+
+  recurrence {
+    frequency       = "weekly"
+    horizon_in_days = %[4]d
+  }
+}
+`, desc, start, end, horizon)
+}
@@ -166,6 +166,12 @@ func resourcePagerDutyResponsePlay() *schema.Resource {
 			"runnability": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
+				ValidateFunc: validateValueFunc([]string{
+					"services",
+					"teams",
+					"responders",
+				}),
 			},
 			"conference_number": {
 				Type:     schema.TypeString,
@@ -234,7 +240,7 @@ func resourcePagerDutyResponsePlayCreate(d *schema.ResourceData, meta interface{
 
 	responsePlay := buildResponsePlayStruct(d)
 
-	log.Printf("[INFO] Creating PagerDuty response play: %s", responsePlay.ID)
+	log.Printf("[INFO] Creating PagerDuty response play: %s", responsePlay.Name)
 
 	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
 		if responsePlay, _, err := client.ResponsePlays.Create(responsePlay); err != nil {
@@ -264,11 +270,18 @@ func resourcePagerDutyResponsePlayRead(d *schema.ResourceData, meta interface{})
 
 	return resource.Retry(2*time.Minute, func() *resource.RetryError {
 		if responsePlay, _, err := client.ResponsePlays.Get(d.Id(), from); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if responsePlay != nil {
 			if responsePlay.Team != nil {
-				d.Set("team", []interface{}{responsePlay.Team})
+				d.Set("team", responsePlay.Team.ID)
+			} else {
+				d.Set("team", "")
 			}
 			log.Printf("[INFO] Read PagerDuty response play initial subscribers: %s", d.Get("subscriber"))
 			if err := d.Set("subscriber", flattenSubscribers(responsePlay.Subscribers)); err != nil {
@@ -326,7 +339,7 @@ func resourcePagerDutyResponsePlayDelete(d *schema.ResourceData, meta interface{
 	from := d.Get("from").(string)
 
 	retryErr := resource.Retry(30*time.Second, func() *resource.RetryError {
-		if _, err := client.ResponsePlays.Delete(d.Id(), from); err != nil {
+		if _, err := client.ResponsePlays.Delete(d.Id(), from); err != nil && !isErrCode(err, 404) {
 			return resource.RetryableError(err)
 		}
 		return nil
@@ -945,6 +945,19 @@ func resourcePagerDutyRulesetRuleImport(d *schema.ResourceData, meta interface{}
 
 	ids := strings.Split(d.Id(), ".")
 
+	if len(ids) == 1 {
+		resp, _, err := client.Rulesets.ListRules(ids[0])
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		var childIDs []string
+		for _, rule := range resp.Rules {
+			childIDs = append(childIDs, fmt.Sprintf("%s.%s", ids[0], rule.ID))
+		}
+		return []*schema.ResourceData{}, importByParentIDError("pagerduty_ruleset_rule", ids[0], childIDs)
+	}
+
 	if len(ids) != 2 {
 		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_ruleset_rule. Expecting an importation ID formed as '<ruleset_id>.<ruleset_rule_id>'")
 	}
@@ -47,15 +47,9 @@ func resourcePagerDutySchedule() *schema.Resource {
 			},
 
 			"time_zone": {
-				Type:     schema.TypeString,
-				Required: true,
-				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-					_, err := time.LoadLocation(val.(string))
-					if err != nil {
-						errs = append(errs, err)
-					}
-					return
-				},
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateTimeZone,
 			},
 
 			"overflow": {
@@ -126,6 +120,12 @@ func resourcePagerDutySchedule() *schema.Resource {
 							Computed: true,
 						},
 
+						"time_zone": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateTimeZone,
+						},
+
 						"restriction": {
 							Optional: true,
 							Type:     schema.TypeList,
@@ -187,12 +187,34 @@ func resourcePagerDutySchedule() *schema.Resource {
 					},
 				},
 			},
+
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"http_cal_url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"web_cal_url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 		},
 	}
 }
 
 func buildScheduleStruct(d *schema.ResourceData) (*pagerduty.Schedule, error) {
-	layers, err := expandScheduleLayers(d.Get("layer"))
+	raw, err := convertScheduleLayersTimeZone(d.Get("layer").([]interface{}), d.Get("time_zone").(string), true)
+	if err != nil {
+		return nil, err
+	}
+
+	layers, err := expandScheduleLayers(raw)
 	if err != nil {
 		return nil, err
 	}
@@ -259,12 +281,20 @@ func resourcePagerDutyScheduleRead(d *schema.ResourceData, meta interface{}) err
 			d.Set("name", schedule.Name)
 			d.Set("time_zone", schedule.TimeZone)
 			d.Set("description", schedule.Description)
+			d.Set("html_url", schedule.HTMLURL)
+			d.Set("http_cal_url", schedule.HTTPCalURL)
+			d.Set("web_cal_url", schedule.WebCalURL)
 
 			layers, err := flattenScheduleLayers(schedule.ScheduleLayers)
 			if err != nil {
 				return resource.NonRetryableError(err)
 			}
 
+			layers, err = readScheduleLayersTimeZone(d, layers, schedule.TimeZone)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
 			if err := d.Set("layer", layers); err != nil {
 				return resource.NonRetryableError(err)
 			}
@@ -310,6 +340,17 @@ func resourcePagerDutyScheduleUpdate(d *schema.ResourceData, meta interface{}) e
 
 	if d.HasChange("layer") {
 		oraw, nraw := d.GetChange("layer")
+		otz, ntz := d.GetChange("time_zone")
+
+		oraw, err := convertScheduleLayersTimeZone(oraw.([]interface{}), otz.(string), true)
+		if err != nil {
+			return err
+		}
+
+		nraw, err = convertScheduleLayersTimeZone(nraw.([]interface{}), ntz.(string), true)
+		if err != nil {
+			return err
+		}
 
 		osl, err := expandScheduleLayers(oraw.([]interface{}))
 		if err != nil {
@@ -547,6 +588,157 @@ func flattenScheduleLayers(v []*pagerduty.ScheduleLayer) ([]map[string]interface
 	return resultReversed, nil
 }
 
+// readScheduleLayersTimeZone keeps the time_zone of each configured layer and
+// converts the restrictions read from the API back into it. Layers are matched
+// by ID, as ended layers aren't read back. Layers without an ID yet, i.e. just
+// created, are matched by position, as they are read back in the order they
+// were sent.
+func readScheduleLayersTimeZone(d *schema.ResourceData, layers []map[string]interface{}, scheduleTimeZone string) ([]map[string]interface{}, error) {
+	configured := d.Get("layer").([]interface{})
+
+	byID := make(map[string]string)
+	for _, c := range configured {
+		if c == nil {
+			continue
+		}
+		layer := c.(map[string]interface{})
+		if id := layer["id"].(string); id != "" {
+			byID[id] = layer["time_zone"].(string)
+		}
+	}
+
+	for i, l := range layers {
+		tz, ok := byID[l["id"].(string)]
+		if !ok && i < len(configured) && configured[i] != nil {
+			layer := configured[i].(map[string]interface{})
+			if layer["id"].(string) == "" {
+				tz = layer["time_zone"].(string)
+			}
+		}
+		if tz == "" {
+			continue
+		}
+		l["time_zone"] = tz
+
+		converted, err := convertScheduleLayerTimeZone(l, scheduleTimeZone, false)
+		if err != nil {
+			return nil, err
+		}
+		layers[i] = converted
+	}
+
+	return layers, nil
+}
+
+// convertScheduleLayersTimeZone converts the restrictions of every layer with
+// its own time_zone into the schedule's time zone (toSchedule) or back.
+func convertScheduleLayersTimeZone(v []interface{}, scheduleTimeZone string, toSchedule bool) ([]interface{}, error) {
+	layers := make([]interface{}, len(v))
+
+	for i, sl := range v {
+		converted, err := convertScheduleLayerTimeZone(sl.(map[string]interface{}), scheduleTimeZone, toSchedule)
+		if err != nil {
+			return nil, err
+		}
+		layers[i] = converted
+	}
+
+	return layers, nil
+}
+
+// convertScheduleLayerTimeZone shifts the restrictions of a layer by the
+// difference between the UTC offsets of the layer's and the schedule's time
+// zones. PagerDuty only knows the schedule's time zone, so the offset is taken
+// once, at the layer's rotation_virtual_start, which keeps the conversion
+// exactly reversible. Restrictions therefore drift by an hour for the part of
+// the year when only one of both time zones observes daylight saving time.
+func convertScheduleLayerTimeZone(layer map[string]interface{}, scheduleTimeZone string, toSchedule bool) (map[string]interface{}, error) {
+	layerTimeZone, _ := layer["time_zone"].(string)
+	if layerTimeZone == "" || layerTimeZone == scheduleTimeZone {
+		return layer, nil
+	}
+
+	layerLoc, err := time.LoadLocation(layerTimeZone)
+	if err != nil {
+		return nil, err
+	}
+	scheduleLoc, err := time.LoadLocation(scheduleTimeZone)
+	if err != nil {
+		return nil, err
+	}
+	rvs, err := time.Parse(time.RFC3339, layer["rotation_virtual_start"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	_, layerOffset := rvs.In(layerLoc).Zone()
+	_, scheduleOffset := rvs.In(scheduleLoc).Zone()
+	delta := scheduleOffset - layerOffset
+	if !toSchedule {
+		delta = -delta
+	}
+
+	converted := make(map[string]interface{}, len(layer))
+	for k, v := range layer {
+		converted[k] = v
+	}
+
+	var restrictions []map[string]interface{}
+	switch rs := layer["restriction"].(type) {
+	case []interface{}:
+		for _, r := range rs {
+			restrictions = append(restrictions, r.(map[string]interface{}))
+		}
+	case []map[string]interface{}:
+		restrictions = rs
+	}
+
+	var convertedRestrictions []interface{}
+	for _, r := range restrictions {
+		cr, err := shiftScheduleLayerRestriction(r, delta)
+		if err != nil {
+			return nil, err
+		}
+		convertedRestrictions = append(convertedRestrictions, cr)
+	}
+	converted["restriction"] = convertedRestrictions
+
+	return converted, nil
+}
+
+// shiftScheduleLayerRestriction moves the start of a restriction by delta
+// seconds, wrapping around the day, or the week for weekly restrictions.
+func shiftScheduleLayerRestriction(r map[string]interface{}, delta int) (map[string]interface{}, error) {
+	start, err := time.Parse("15:04:05", r["start_time_of_day"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	const day = 24 * 3600
+	seconds := start.Hour()*3600 + start.Minute()*60 + start.Second()
+	period := day
+
+	dayOfWeek, _ := r["start_day_of_week"].(int)
+	weekly := r["type"].(string) == "weekly_restriction" && dayOfWeek > 0
+	if weekly {
+		seconds += (dayOfWeek - 1) * day
+		period = 7 * day
+	}
+
+	seconds = ((seconds+delta)%period + period) % period
+
+	shifted := make(map[string]interface{}, len(r))
+	for k, v := range r {
+		shifted[k] = v
+	}
+	shifted["start_time_of_day"] = fmt.Sprintf("%02d:%02d:%02d", seconds%day/3600, seconds%3600/60, seconds%60)
+	if weekly {
+		shifted["start_day_of_week"] = seconds/day + 1
+	}
+
+	return shifted, nil
+}
+
 // the expandShedTeams and flattenSchedTeams are based on the expandTeams and flattenTeams functions in the user
 // resource. added these functions here for maintainability
 func expandSchedTeams(v interface{}) []*pagerduty.TeamReference {
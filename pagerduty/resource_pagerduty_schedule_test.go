@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -49,6 +50,88 @@ func testSweepSchedule(region string) error {
 	return nil
 }
 
+func TestConvertScheduleLayerTimeZone(t *testing.T) {
+	layer := map[string]interface{}{
+		"time_zone":              "Asia/Tokyo",
+		"rotation_virtual_start": "2023-01-09T09:00:00+09:00",
+		"restriction": []interface{}{
+			map[string]interface{}{
+				"type":              "daily_restriction",
+				"start_time_of_day": "08:00:00",
+				"duration_seconds":  32400,
+			},
+			map[string]interface{}{
+				"type":              "weekly_restriction",
+				"start_time_of_day": "02:30:00",
+				"start_day_of_week": 1,
+				"duration_seconds":  3600,
+			},
+		},
+	}
+
+	converted, err := convertScheduleLayerTimeZone(layer, "Europe/Paris", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restrictions := converted["restriction"].([]interface{})
+	daily := restrictions[0].(map[string]interface{})
+	if daily["start_time_of_day"] != "00:00:00" {
+		t.Errorf("expected the daily restriction to start at 00:00:00 in Paris, got %s", daily["start_time_of_day"])
+	}
+	weekly := restrictions[1].(map[string]interface{})
+	if weekly["start_time_of_day"] != "18:30:00" || weekly["start_day_of_week"] != 7 {
+		t.Errorf("expected the weekly restriction to start on Sunday at 18:30:00 in Paris, got day %v at %s", weekly["start_day_of_week"], weekly["start_time_of_day"])
+	}
+
+	roundTrip, err := convertScheduleLayerTimeZone(converted, "Europe/Paris", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(roundTrip["restriction"], layer["restriction"]) {
+		t.Errorf("expected restrictions to round-trip to %#v, got %#v", layer["restriction"], roundTrip["restriction"])
+	}
+}
+
+func TestReadScheduleLayersTimeZone_EndedLayer(t *testing.T) {
+	d := resourcePagerDutySchedule().TestResourceData()
+	d.Set("layer", []interface{}{
+		map[string]interface{}{
+			"id":                     "PLAYER1",
+			"rotation_virtual_start": "2023-01-09T09:00:00+01:00",
+		},
+		map[string]interface{}{
+			"id":                     "PLAYER2",
+			"time_zone":              "Asia/Tokyo",
+			"rotation_virtual_start": "2023-01-09T09:00:00+09:00",
+		},
+	})
+
+	// PLAYER1 has ended, so it isn't read back anymore.
+	layers := []map[string]interface{}{
+		{
+			"id":                     "PLAYER2",
+			"rotation_virtual_start": "2023-01-09T09:00:00+09:00",
+			"restriction": []map[string]interface{}{
+				{"type": "daily_restriction", "start_time_of_day": "00:00:00", "duration_seconds": 32400},
+			},
+		},
+	}
+
+	layers, err := readScheduleLayersTimeZone(d, layers, "Europe/Paris")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if layers[0]["time_zone"] != "Asia/Tokyo" {
+		t.Errorf("expected PLAYER2 to keep its time_zone, got %v", layers[0]["time_zone"])
+	}
+	restriction := layers[0]["restriction"].([]interface{})[0].(map[string]interface{})
+	if restriction["start_time_of_day"] != "08:00:00" {
+		t.Errorf("expected the restriction of PLAYER2 to start at 08:00:00 in Tokyo, got %s", restriction["start_time_of_day"])
+	}
+}
+
 func TestAccPagerDutySchedule_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -85,6 +168,10 @@ func TestAccPagerDutySchedule_Basic(t *testing.T) {
 						"pagerduty_schedule.foo", "final_schedule.0.rendered_coverage_percentage", "0.00"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_schedule.foo", "layer.0.rotation_virtual_start", rotationVirtualStart),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_schedule.foo", "html_url"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_schedule.foo", "web_cal_url"),
 				),
 			},
 			{
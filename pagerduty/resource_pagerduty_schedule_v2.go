@@ -0,0 +1,349 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// resourcePagerDutyScheduleV2 is a variant of pagerduty_schedule where layers
+// are keyed by their name instead of their position, so adding, removing or
+// reordering layers doesn't produce index-shift diffs on the remaining ones.
+func resourcePagerDutyScheduleV2() *schema.Resource {
+	legacy := resourcePagerDutySchedule()
+
+	layerSchema := make(map[string]*schema.Schema)
+	for k, v := range legacy.Schema["layer"].Elem.(*schema.Resource).Schema {
+		layerSchema[k] = v
+	}
+	layerSchema["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	s := make(map[string]*schema.Schema)
+	for k, v := range legacy.Schema {
+		s[k] = v
+	}
+	s["layer"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		Set:      hashScheduleLayerName,
+		Elem: &schema.Resource{
+			Schema: layerSchema,
+		},
+	}
+
+	return &schema.Resource{
+		Create:        resourcePagerDutyScheduleV2Create,
+		Read:          resourcePagerDutyScheduleV2Read,
+		Update:        resourcePagerDutyScheduleV2Update,
+		Delete:        resourcePagerDutyScheduleDelete,
+		CustomizeDiff: customizePagerDutyScheduleV2Diff,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyScheduleV2Import,
+		},
+		Schema: s,
+	}
+}
+
+func hashScheduleLayerName(v interface{}) int {
+	return schema.HashString(v.(map[string]interface{})["name"])
+}
+
+// duplicateScheduleLayerName returns the first name used by more than one
+// layer, or an empty string when all layer names are unique.
+func duplicateScheduleLayerName(names []string) string {
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if seen[name] {
+			return name
+		}
+		seen[name] = true
+	}
+	return ""
+}
+
+func customizePagerDutyScheduleV2Diff(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	// Layers with the same name share a hash, so they are merged into one by
+	// the time they are read from the diff. The raw configuration still has
+	// all of them.
+	if raw := diff.GetRawConfig(); !raw.IsNull() && raw.GetAttr("layer").IsKnown() && !raw.GetAttr("layer").IsNull() {
+		var names []string
+		for _, l := range raw.GetAttr("layer").AsValueSlice() {
+			if name := l.GetAttr("name"); name.IsKnown() && !name.IsNull() {
+				names = append(names, name.AsString())
+			}
+		}
+		if name := duplicateScheduleLayerName(names); name != "" {
+			return fmt.Errorf("layer names must be unique within a schedule, %q is used by more than one layer", name)
+		}
+	}
+
+	for _, l := range diff.Get("layer").(*schema.Set).List() {
+		layer := l.(map[string]interface{})
+		for _, r := range layer["restriction"].([]interface{}) {
+			restriction := r.(map[string]interface{})
+			t := restriction["type"].(string)
+			if t == "daily_restriction" && restriction["start_day_of_week"].(int) != 0 {
+				return fmt.Errorf("start_day_of_week must only be set for a weekly_restriction schedule restriction type")
+			}
+			if t == "daily_restriction" && restriction["duration_seconds"].(int) >= 3600*24 {
+				return fmt.Errorf("duration_seconds for a daily_restriction schedule restriction type must be shorter than a day")
+			}
+		}
+	}
+	return nil
+}
+
+// readScheduleV2LayersTimeZone is the readScheduleLayersTimeZone counterpart
+// for layers keyed by name.
+func readScheduleV2LayersTimeZone(d *schema.ResourceData, layers []map[string]interface{}, scheduleTimeZone string) ([]map[string]interface{}, error) {
+	configured := make(map[string]string)
+	for _, l := range d.Get("layer").(*schema.Set).List() {
+		layer := l.(map[string]interface{})
+		configured[layer["name"].(string)] = layer["time_zone"].(string)
+	}
+
+	for i, l := range layers {
+		tz := configured[l["name"].(string)]
+		if tz == "" {
+			continue
+		}
+		l["time_zone"] = tz
+
+		converted, err := convertScheduleLayerTimeZone(l, scheduleTimeZone, false)
+		if err != nil {
+			return nil, err
+		}
+		layers[i] = converted
+	}
+
+	return layers, nil
+}
+
+func buildScheduleV2Struct(d *schema.ResourceData) (*pagerduty.Schedule, error) {
+	raw, err := convertScheduleLayersTimeZone(d.Get("layer").(*schema.Set).List(), d.Get("time_zone").(string), true)
+	if err != nil {
+		return nil, err
+	}
+
+	layers, err := expandScheduleLayers(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &pagerduty.Schedule{
+		Name:           d.Get("name").(string),
+		TimeZone:       d.Get("time_zone").(string),
+		ScheduleLayers: layers,
+	}
+
+	if attr, ok := d.GetOk("description"); ok {
+		schedule.Description = attr.(string)
+	}
+
+	if attr, ok := d.GetOk("teams"); ok {
+		schedule.Teams = expandSchedTeams(attr.([]interface{}))
+	}
+
+	return schedule, nil
+}
+
+func resourcePagerDutyScheduleV2Create(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	schedule, err := buildScheduleV2Struct(d)
+	if err != nil {
+		return err
+	}
+
+	o := &pagerduty.CreateScheduleOptions{}
+
+	if v, ok := d.GetOk("overflow"); ok {
+		o.Overflow = v.(bool)
+	}
+
+	log.Printf("[INFO] Creating PagerDuty schedule: %s", schedule.Name)
+
+	schedule, _, err = client.Schedules.Create(schedule, o)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(schedule.ID)
+
+	return resourcePagerDutyScheduleV2Read(d, meta)
+}
+
+func resourcePagerDutyScheduleV2Read(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty schedule: %s", d.Id())
+
+	return resource.Retry(30*time.Second, func() *resource.RetryError {
+		schedule, _, err := client.Schedules.Get(d.Id(), &pagerduty.GetScheduleOptions{})
+		if err != nil {
+			if isErrCode(err, 404) {
+				log.Printf("[WARN] Removing %s because it's gone", d.Id())
+				d.SetId("")
+				return nil
+			}
+			time.Sleep(2 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		d.Set("name", schedule.Name)
+		d.Set("time_zone", schedule.TimeZone)
+		d.Set("description", schedule.Description)
+		d.Set("html_url", schedule.HTMLURL)
+		d.Set("http_cal_url", schedule.HTTPCalURL)
+		d.Set("web_cal_url", schedule.WebCalURL)
+
+		layers, err := flattenScheduleLayers(schedule.ScheduleLayers)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		layers, err = readScheduleV2LayersTimeZone(d, layers, schedule.TimeZone)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		layerList := make([]interface{}, len(layers))
+		for i, l := range layers {
+			layerList[i] = l
+		}
+
+		if err := d.Set("layer", layerList); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if err := d.Set("teams", flattenShedTeams(schedule.Teams)); err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error setting teams: %s", err))
+		}
+		if err := d.Set("final_schedule", flattenScheFinalSchedule(schedule.FinalSchedule)); err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error setting final_schedule: %s", err))
+		}
+
+		return nil
+	})
+}
+
+func resourcePagerDutyScheduleV2Update(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	schedule, err := buildScheduleV2Struct(d)
+	if err != nil {
+		return err
+	}
+
+	opts := &pagerduty.UpdateScheduleOptions{}
+
+	if v, ok := d.GetOk("overflow"); ok {
+		opts.Overflow = v.(bool)
+	}
+
+	if d.HasChange("layer") {
+		oraw, _ := d.GetChange("layer")
+		otz, _ := d.GetChange("time_zone")
+
+		oraw, err := convertScheduleLayersTimeZone(oraw.(*schema.Set).List(), otz.(string), true)
+		if err != nil {
+			return err
+		}
+
+		osl, err := expandScheduleLayers(oraw)
+		if err != nil {
+			return err
+		}
+
+		// Layers are keyed by name, so the existing layer ID is carried over to
+		// the layer with the same name in the configuration.
+		oldByName := make(map[string]*pagerduty.ScheduleLayer, len(osl))
+		for _, o := range osl {
+			oldByName[o.Name] = o
+		}
+
+		newNames := make(map[string]bool, len(schedule.ScheduleLayers))
+		for _, n := range schedule.ScheduleLayers {
+			newNames[n.Name] = true
+			if o, ok := oldByName[n.Name]; ok && n.ID == "" {
+				n.ID = o.ID
+			}
+		}
+
+		// A schedule layer can never be removed but it can be ended.
+		for _, o := range osl {
+			if newNames[o.Name] {
+				continue
+			}
+
+			end, err := timeToUTC(time.Now().Format(time.RFC3339))
+			if err != nil {
+				return err
+			}
+			endStr := end.String()
+			o.End = &endStr
+			schedule.ScheduleLayers = append(schedule.ScheduleLayers, o)
+		}
+	}
+
+	log.Printf("[INFO] Updating PagerDuty schedule: %s", d.Id())
+
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if _, _, err := client.Schedules.Update(d.Id(), schedule, opts); err != nil {
+			return resource.RetryableError(err)
+		}
+		return nil
+	})
+	if retryErr != nil {
+		time.Sleep(2 * time.Second)
+		return retryErr
+	}
+
+	return resourcePagerDutyScheduleV2Read(d, meta)
+}
+
+// resourcePagerDutyScheduleV2Import also serves moving a schedule from
+// pagerduty_schedule, so it rejects schedules whose layers can't be keyed by
+// name instead of letting them be merged.
+func resourcePagerDutyScheduleV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	schedule, _, err := client.Schedules.Get(d.Id(), &pagerduty.GetScheduleOptions{})
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	layers, err := flattenScheduleLayers(schedule.ScheduleLayers)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	var names []string
+	for _, l := range layers {
+		names = append(names, l["name"].(string))
+	}
+	if name := duplicateScheduleLayerName(names); name != "" {
+		return []*schema.ResourceData{}, fmt.Errorf("schedule %s has more than one layer named %q, rename its layers in PagerDuty to import it as pagerduty_schedule_v2", d.Id(), name)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,237 @@
+package pagerduty
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestReadScheduleV2LayersTimeZone(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourcePagerDutyScheduleV2().Schema, map[string]interface{}{
+		"name":      "foo",
+		"time_zone": "Europe/Paris",
+		"layer": []interface{}{
+			map[string]interface{}{
+				"name":                         "tokyo",
+				"time_zone":                    "Asia/Tokyo",
+				"start":                        "2023-01-09T09:00:00+09:00",
+				"rotation_virtual_start":       "2023-01-09T09:00:00+09:00",
+				"rotation_turn_length_seconds": 86400,
+				"users":                        []interface{}{"PUSER"},
+			},
+			map[string]interface{}{
+				"name":                         "paris",
+				"start":                        "2023-01-09T09:00:00+01:00",
+				"rotation_virtual_start":       "2023-01-09T09:00:00+01:00",
+				"rotation_turn_length_seconds": 86400,
+				"users":                        []interface{}{"PUSER"},
+			},
+		},
+	})
+
+	// Layers are read back in any order, and in the schedule's time zone.
+	layers := []map[string]interface{}{
+		{
+			"name":                   "paris",
+			"rotation_virtual_start": "2023-01-09T09:00:00+01:00",
+			"restriction": []map[string]interface{}{
+				{"type": "daily_restriction", "start_time_of_day": "08:00:00", "duration_seconds": 3600},
+			},
+		},
+		{
+			"name":                   "tokyo",
+			"rotation_virtual_start": "2023-01-09T09:00:00+09:00",
+			"restriction": []map[string]interface{}{
+				{"type": "daily_restriction", "start_time_of_day": "00:00:00", "duration_seconds": 32400},
+			},
+		},
+	}
+
+	layers, err := readScheduleV2LayersTimeZone(d, layers, "Europe/Paris")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := layers[0]["time_zone"]; ok {
+		t.Errorf("expected the paris layer to have no time_zone, got %v", layers[0]["time_zone"])
+	}
+	paris := layers[0]["restriction"].([]map[string]interface{})[0]
+	if paris["start_time_of_day"] != "08:00:00" {
+		t.Errorf("expected the paris restriction to be unchanged, got %s", paris["start_time_of_day"])
+	}
+
+	if layers[1]["time_zone"] != "Asia/Tokyo" {
+		t.Errorf("expected the tokyo layer to keep its time_zone, got %v", layers[1]["time_zone"])
+	}
+	tokyo := layers[1]["restriction"].([]interface{})[0].(map[string]interface{})
+	if tokyo["start_time_of_day"] != "08:00:00" {
+		t.Errorf("expected the tokyo restriction to start at 08:00:00 in Tokyo, got %s", tokyo["start_time_of_day"])
+	}
+}
+
+func TestAccPagerDutyScheduleV2_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	location := "America/New_York"
+	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	rotationVirtualStart := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyScheduleV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyScheduleV2Config(username, email, schedule, location, start, rotationVirtualStart, []string{"primary", "secondary"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyScheduleExists("pagerduty_schedule_v2.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_schedule_v2.foo", "name", schedule),
+					resource.TestCheckResourceAttr(
+						"pagerduty_schedule_v2.foo", "layer.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"pagerduty_schedule_v2.foo", "layer.*", map[string]string{"name": "primary", "start": start}),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"pagerduty_schedule_v2.foo", "layer.*", map[string]string{"name": "secondary"}),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_schedule_v2.foo", "html_url"),
+				),
+			},
+			{
+				ResourceName:            "pagerduty_schedule_v2.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"overflow"},
+			},
+			// Reordering the layers in the configuration must not produce a diff
+			{
+				Config:   testAccCheckPagerDutyScheduleV2Config(username, email, schedule, location, start, rotationVirtualStart, []string{"secondary", "primary"}),
+				PlanOnly: true,
+			},
+			// Removing a layer ends it without touching the remaining one
+			{
+				Config: testAccCheckPagerDutyScheduleV2Config(username, email, schedule, location, start, rotationVirtualStart, []string{"secondary"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyScheduleExists("pagerduty_schedule_v2.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_schedule_v2.foo", "layer.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"pagerduty_schedule_v2.foo", "layer.*", map[string]string{"name": "secondary"}),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyScheduleV2DuplicateLayerConfig(username, email, schedule, location, start, rotationVirtualStart),
+				ExpectError: regexp.MustCompile(`"secondary" is used by more than one layer`),
+			},
+		},
+	})
+}
+
+func TestResourcePagerDutyScheduleV2Import_DuplicateLayerNames(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != "GET" || r.URL.Path != "/schedules/PSCHED1" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":2100,"message":"Not Found"}}`))
+			return
+		}
+		w.Write([]byte(`{"schedule":{"id":"PSCHED1","time_zone":"UTC","schedule_layers":[` +
+			`{"id":"PLAYER1","name":"Layer 1","start":"2023-01-09T09:00:00Z","rotation_virtual_start":"2023-01-09T09:00:00Z","rotation_turn_length_seconds":86400,"users":[]},` +
+			`{"id":"PLAYER2","name":"Layer 1","start":"2023-01-09T09:00:00Z","rotation_virtual_start":"2023-01-09T09:00:00Z","rotation_turn_length_seconds":3600,"users":[]}` +
+			`]}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: server.URL, SkipCredsValidation: true}
+
+	d := resourcePagerDutyScheduleV2().TestResourceData()
+	d.SetId("PSCHED1")
+
+	_, err := resourcePagerDutyScheduleV2Import(d, config)
+	if err == nil || !strings.Contains(err.Error(), `more than one layer named "Layer 1"`) {
+		t.Errorf("expected an error about the duplicate layer name, got %v", err)
+	}
+}
+
+func testAccCheckPagerDutyScheduleV2DuplicateLayerConfig(username, email, schedule, location, start, rotationVirtualStart string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_schedule_v2" "foo" {
+  name      = "%s"
+  time_zone = "%s"
+
+  layer {
+    name                         = "secondary"
+    start                        = "%s"
+    rotation_virtual_start       = "%s"
+    rotation_turn_length_seconds = 86400
+    users                        = [pagerduty_user.foo.id]
+  }
+
+  layer {
+    name                         = "secondary"
+    start                        = "%s"
+    rotation_virtual_start       = "%s"
+    rotation_turn_length_seconds = 3600
+    users                        = [pagerduty_user.foo.id]
+  }
+}
+`, username, email, schedule, location, start, rotationVirtualStart, start, rotationVirtualStart)
+}
+
+func testAccCheckPagerDutyScheduleV2Destroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_schedule_v2" {
+			continue
+		}
+
+		if _, _, err := client.Schedules.Get(r.Primary.ID, &pagerduty.GetScheduleOptions{}); err == nil {
+			return fmt.Errorf("Schedule still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyScheduleV2Config(username, email, schedule, location, start, rotationVirtualStart string, layers []string) string {
+	var layerBlocks string
+	for _, name := range layers {
+		layerBlocks += fmt.Sprintf(`
+  layer {
+    name                         = "%s"
+    start                        = "%s"
+    rotation_virtual_start       = "%s"
+    rotation_turn_length_seconds = 86400
+    users                        = [pagerduty_user.foo.id]
+  }
+`, name, start, rotationVirtualStart)
+	}
+
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_schedule_v2" "foo" {
+  name      = "%s"
+  time_zone = "%s"
+%s
+}
+`, username, email, schedule, location, layerBlocks)
+}
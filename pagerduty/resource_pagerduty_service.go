@@ -16,22 +16,13 @@ import (
 
 func resourcePagerDutyService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePagerDutyServiceCreate,
-		Read:   resourcePagerDutyServiceRead,
-		Update: resourcePagerDutyServiceUpdate,
-		Delete: resourcePagerDutyServiceDelete,
-		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
-			in := diff.Get("incident_urgency_rule.#").(int)
-			for i := 0; i <= in; i++ {
-				t := diff.Get(fmt.Sprintf("incident_urgency_rule.%d.type", i)).(string)
-				if t == "use_support_hours" && diff.Get(fmt.Sprintf("incident_urgency_rule.%d.urgency", i)).(string) != "" {
-					return fmt.Errorf("general urgency cannot be set for a use_support_hours incident urgency rule type")
-				}
-			}
-			return nil
-		},
+		Create:        resourcePagerDutyServiceCreate,
+		Read:          resourcePagerDutyServiceRead,
+		Update:        resourcePagerDutyServiceUpdate,
+		Delete:        resourcePagerDutyServiceDelete,
+		CustomizeDiff: customizePagerDutyServiceDiff,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourcePagerDutyServiceImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -161,6 +152,11 @@ func resourcePagerDutyService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"acknowledgement_timeout": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -273,23 +269,38 @@ func resourcePagerDutyService() *schema.Resource {
 						"type": {
 							Type:     schema.TypeString,
 							Optional: true,
+							ValidateFunc: validateValueFunc([]string{
+								"urgency_change",
+							}),
 						},
 						"to_urgency": {
 							Type:     schema.TypeString,
 							Optional: true,
+							ValidateFunc: validateValueFunc([]string{
+								"high",
+								"low",
+							}),
 						},
 						"at": {
 							Type:     schema.TypeList,
 							Optional: true,
+							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"type": {
 										Type:     schema.TypeString,
 										Optional: true,
+										ValidateFunc: validateValueFunc([]string{
+											"named_time",
+										}),
 									},
 									"name": {
 										Type:     schema.TypeString,
 										Optional: true,
+										ValidateFunc: validateValueFunc([]string{
+											"support_hours_start",
+											"support_hours_end",
+										}),
 									},
 								},
 							},
@@ -305,10 +316,101 @@ func resourcePagerDutyService() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"standards_score": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"passing": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"failing_standards": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"force_destroy_open_incidents": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"force_destroy_from": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
 
+// customizePagerDutyServiceDiff validates the combinations of
+// incident_urgency_rule, support_hours and scheduled_actions at plan time,
+// which the API would otherwise reject with a 400 on apply.
+func customizePagerDutyServiceDiff(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	ruleType := ""
+	if rules := diff.Get("incident_urgency_rule").([]interface{}); len(rules) > 0 && rules[0] != nil {
+		rule := rules[0].(map[string]interface{})
+		ruleType = rule["type"].(string)
+		during := rule["during_support_hours"].([]interface{})
+		outside := rule["outside_support_hours"].([]interface{})
+
+		switch ruleType {
+		case "use_support_hours":
+			if rule["urgency"].(string) != "" {
+				return fmt.Errorf("general urgency cannot be set for a use_support_hours incident urgency rule type")
+			}
+			if len(during) == 0 || len(outside) == 0 {
+				return fmt.Errorf("during_support_hours and outside_support_hours must be set for a use_support_hours incident urgency rule type")
+			}
+			if diff.Get("support_hours.#").(int) == 0 {
+				return fmt.Errorf("support_hours must be set when using a use_support_hours incident urgency rule type")
+			}
+		case "constant":
+			if rule["urgency"].(string) == "" && diff.NewValueKnown("incident_urgency_rule.0.urgency") {
+				return fmt.Errorf("urgency must be set for a constant incident urgency rule type")
+			}
+			if len(during) > 0 || len(outside) > 0 {
+				return fmt.Errorf("during_support_hours and outside_support_hours can only be set for a use_support_hours incident urgency rule type")
+			}
+		}
+	}
+
+	if diff.Get("scheduled_actions.#").(int) > 0 && ruleType != "" && ruleType != "use_support_hours" {
+		return fmt.Errorf("scheduled_actions can only be set for a use_support_hours incident urgency rule type")
+	}
+
+	if hours := diff.Get("support_hours").([]interface{}); len(hours) > 0 && hours[0] != nil {
+		sh := hours[0].(map[string]interface{})
+		if sh["type"].(string) == "fixed_time_per_day" {
+			for _, attr := range []string{"time_zone", "start_time", "end_time"} {
+				if sh[attr].(string) == "" && diff.NewValueKnown("support_hours.0."+attr) {
+					return fmt.Errorf("support_hours.%s must be set for a fixed_time_per_day support hours type", attr)
+				}
+			}
+			if len(sh["days_of_week"].([]interface{})) == 0 && diff.NewValueKnown("support_hours.0.days_of_week") {
+				return fmt.Errorf("support_hours.days_of_week must be set for a fixed_time_per_day support hours type")
+			}
+		}
+	}
+
+	// Resolving incidents requires a From header with an account token, which
+	// would otherwise only fail once the service is destroyed.
+	if diff.Get("force_destroy_open_incidents").(bool) && diff.Get("force_destroy_from").(string) == "" && diff.NewValueKnown("force_destroy_from") {
+		return fmt.Errorf("force_destroy_from must be set when force_destroy_open_incidents is true")
+	}
+
+	return nil
+}
+
 func buildServiceStruct(d *schema.ResourceData) (*pagerduty.Service, error) {
 	service := pagerduty.Service{
 		Name: d.Get("name").(string),
@@ -328,6 +430,16 @@ func buildServiceStruct(d *schema.ResourceData) (*pagerduty.Service, error) {
 		}
 	}
 
+	// The status of a service is otherwise driven by its incidents, so it's
+	// only sent when the service is being enabled or disabled.
+	if d.HasChange("enabled") {
+		if d.Get("enabled").(bool) {
+			service.Status = "active"
+		} else {
+			service.Status = "disabled"
+		}
+	}
+
 	if attr, ok := d.GetOk("acknowledgement_timeout"); ok {
 		if attr.(string) != "null" {
 			if val, err := strconv.Atoi(attr.(string)); err == nil {
@@ -420,11 +532,45 @@ func fetchService(d *schema.ResourceData, meta interface{}, errCallback func(err
 		if err := flattenService(d, service); err != nil {
 			return resource.NonRetryableError(err)
 		}
+
+		score, _, err := client.Standards.GetResourceScore("technical_services", service.ID)
+		if err != nil {
+			// Resource standards aren't available on every account, and the
+			// score is informational only, so it's left as is rather than
+			// failing or retrying the whole read
+			log.Printf("[WARN] Unable to read standards score of service %s: %s", service.ID, err)
+			return nil
+		}
+
+		if err := d.Set("standards_score", flattenServiceStandardsScore(score)); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		return nil
 
 	})
 }
 
+func flattenServiceStandardsScore(score *pagerduty.ResourceStandardScore) []interface{} {
+	if score == nil || score.Score == nil {
+		return nil
+	}
+
+	failing := []string{}
+	for _, standard := range score.Standards {
+		if standard.Active && !standard.Pass {
+			failing = append(failing, standard.Name)
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"passing":           score.Score.Passing,
+			"total":             score.Score.Total,
+			"failing_standards": failing,
+		},
+	}
+}
+
 func resourcePagerDutyServiceCreate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -480,6 +626,28 @@ func resourcePagerDutyServiceDelete(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	// A service with open incidents can't be removed till those incidents
+	// have been resolved.
+	openIncidents, err := listIncidentsOpenedOnService(client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if len(openIncidents) > 0 {
+		if !d.Get("force_destroy_open_incidents").(bool) {
+			var urlLinksMessage string
+			for _, incident := range openIncidents {
+				urlLinksMessage = fmt.Sprintf("%s\n%s", urlLinksMessage, incident.HTMLURL)
+			}
+			return fmt.Errorf("Before Removing Service %q You must first resolve the following incidents, or set force_destroy_open_incidents to resolve them automatically... %s", d.Id(), urlLinksMessage)
+		}
+
+		log.Printf("[INFO] Resolving %d open incidents on PagerDuty service %s", len(openIncidents), d.Id())
+		if err := resolveIncidents(client, d.Get("force_destroy_from").(string), openIncidents); err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[INFO] Deleting PagerDuty service %s", d.Id())
 
 	if _, err := client.Services.Delete(d.Id()); err != nil {
@@ -493,11 +661,81 @@ func resourcePagerDutyServiceDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+func resourcePagerDutyServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("force_destroy_open_incidents", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func listIncidentsOpenedOnService(c *pagerduty.Client, serviceID string) ([]*pagerduty.Incident, error) {
+	var incidents []*pagerduty.Incident
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		resp, err := c.Incidents.ListAll(&pagerduty.ListIncidentsOptions{
+			DateRange:  "all",
+			Statuses:   []string{"triggered", "acknowledged"},
+			ServiceIDs: []string{serviceID},
+		})
+		if err != nil {
+			time.Sleep(2 * time.Second)
+			return resource.RetryableError(err)
+		}
+		incidents = resp
+		return nil
+	})
+	if retryErr != nil {
+		return nil, retryErr
+	}
+
+	return incidents, nil
+}
+
+// resolveIncidents resolves the given incidents in batches, as the API limits
+// the number of incidents that can be managed in a single request. The from
+// email is sent as the From header, which account API tokens require.
+func resolveIncidents(c *pagerduty.Client, from string, incidents []*pagerduty.Incident) error {
+	const batchSize = 250
+
+	for start := 0; start < len(incidents); start += batchSize {
+		end := start + batchSize
+		if end > len(incidents) {
+			end = len(incidents)
+		}
+
+		var batch []*pagerduty.Incident
+		for _, incident := range incidents[start:end] {
+			batch = append(batch, &pagerduty.Incident{
+				ID:     incident.ID,
+				Type:   "incident_reference",
+				Status: "resolved",
+			})
+		}
+
+		retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+			if _, _, err := c.Incidents.ManageIncidentsContext(context.Background(), from, batch, &pagerduty.ManageIncidentsOptions{}); err != nil {
+				if isErrCode(err, 400) {
+					return resource.NonRetryableError(err)
+				}
+				// Delaying retry by 30s as recommended by PagerDuty
+				// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+				time.Sleep(30 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return nil
+		})
+		if retryErr != nil {
+			return retryErr
+		}
+	}
+
+	return nil
+}
+
 func flattenService(d *schema.ResourceData, service *pagerduty.Service) error {
 	d.Set("name", service.Name)
 	d.Set("type", service.Type)
 	d.Set("html_url", service.HTMLURL)
 	d.Set("status", service.Status)
+	d.Set("enabled", service.Status != "disabled")
 	d.Set("created_at", service.CreatedAt)
 	d.Set("escalation_policy", service.EscalationPolicy.ID)
 	d.Set("description", service.Description)
@@ -815,7 +1053,12 @@ func flattenScheduledActions(v []*pagerduty.ScheduledAction) []interface{} {
 }
 
 func expandScheduledActionAt(v interface{}) *pagerduty.At {
-	rat := v.([]interface{})[0].(map[string]interface{})
+	ats := v.([]interface{})
+	if len(ats) == 0 || ats[0] == nil {
+		return nil
+	}
+
+	rat := ats[0].(map[string]interface{})
 	return &pagerduty.At{
 		Type: rat["type"].(string),
 		Name: rat["name"].(string),
@@ -823,6 +1066,10 @@ func expandScheduledActionAt(v interface{}) *pagerduty.At {
 }
 
 func flattenScheduledActionAt(v *pagerduty.At) []interface{} {
+	if v == nil {
+		return nil
+	}
+
 	at := map[string]interface{}{"type": v.Type, "name": v.Name}
 	return []interface{}{at}
 }
@@ -0,0 +1,364 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyServiceDependencies() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyServiceDependenciesCreate,
+		Read:   resourcePagerDutyServiceDependenciesRead,
+		Update: resourcePagerDutyServiceDependenciesUpdate,
+		Delete: resourcePagerDutyServiceDependenciesDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyServiceDependenciesImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateValueFunc([]string{
+					"business_service",
+					"service",
+				}),
+			},
+			"supporting_service": serviceDependenciesServiceSchema(),
+			"dependent_service":  serviceDependenciesServiceSchema(),
+		},
+	}
+}
+
+func serviceDependenciesServiceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validateValueFunc([]string{
+						"business_service",
+						"service",
+					}),
+				},
+			},
+		},
+	}
+}
+
+// buildServiceDependenciesStruct returns the dependencies configured for the
+// service: the ones on its supporting services, then the ones of its
+// dependent services.
+func buildServiceDependenciesStruct(d *schema.ResourceData) []*pagerduty.ServiceDependency {
+	self := &pagerduty.ServiceObj{
+		ID:   d.Get("service_id").(string),
+		Type: d.Get("service_type").(string),
+	}
+
+	var dependencies []*pagerduty.ServiceDependency
+	for _, s := range d.Get("supporting_service").(*schema.Set).List() {
+		dependencies = append(dependencies, &pagerduty.ServiceDependency{
+			SupportingService: expandServiceDependenciesService(s),
+			DependentService:  self,
+		})
+	}
+	for _, s := range d.Get("dependent_service").(*schema.Set).List() {
+		dependencies = append(dependencies, &pagerduty.ServiceDependency{
+			SupportingService: self,
+			DependentService:  expandServiceDependenciesService(s),
+		})
+	}
+
+	return dependencies
+}
+
+func expandServiceDependenciesService(v interface{}) *pagerduty.ServiceObj {
+	m := v.(map[string]interface{})
+	return &pagerduty.ServiceObj{
+		ID:   m["id"].(string),
+		Type: m["type"].(string),
+	}
+}
+
+func serviceDependencyKey(dep *pagerduty.ServiceDependency) string {
+	return fmt.Sprintf("%s.%s", dep.SupportingService.ID, dep.DependentService.ID)
+}
+
+// diffServiceDependencies returns the dependencies to associate and the ones
+// to disassociate so that the current dependencies match the desired ones.
+// The dependencies to disassociate are the current ones, as the API needs
+// their IDs.
+func diffServiceDependencies(current, desired []*pagerduty.ServiceDependency) (associate, disassociate []*pagerduty.ServiceDependency) {
+	currentKeys := make(map[string]bool)
+	for _, dep := range current {
+		currentKeys[serviceDependencyKey(dep)] = true
+	}
+	desiredKeys := make(map[string]bool)
+	for _, dep := range desired {
+		desiredKeys[serviceDependencyKey(dep)] = true
+	}
+
+	for _, dep := range desired {
+		if !currentKeys[serviceDependencyKey(dep)] {
+			associate = append(associate, dep)
+		}
+	}
+	for _, dep := range current {
+		if !desiredKeys[serviceDependencyKey(dep)] {
+			disassociate = append(disassociate, dep)
+		}
+	}
+
+	return associate, disassociate
+}
+
+// fetchServiceDependencies returns the immediate dependencies of the service,
+// with the service types the API expects in requests.
+func fetchServiceDependencies(client *pagerduty.Client, serviceID, serviceType string) ([]*pagerduty.ServiceDependency, error) {
+	var dependencies []*pagerduty.ServiceDependency
+
+	retryErr := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, _, err := client.ServiceDependencies.GetServiceDependenciesForType(serviceID, serviceType)
+		if err != nil {
+			if isErrCode(err, 500) || isErrCode(err, 429) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		dependencies = nil
+		for _, rel := range resp.Relationships {
+			if rel.SupportingService == nil || rel.DependentService == nil {
+				continue
+			}
+			rel.SupportingService.Type = convertType(rel.SupportingService.Type)
+			rel.DependentService.Type = convertType(rel.DependentService.Type)
+			dependencies = append(dependencies, rel)
+		}
+		return nil
+	})
+	if retryErr != nil {
+		return nil, retryErr
+	}
+
+	return dependencies, nil
+}
+
+// reconcileServiceDependencies associates, then disassociates, the
+// dependencies of the service in one batch each.
+func reconcileServiceDependencies(client *pagerduty.Client, serviceID, serviceType string, desired []*pagerduty.ServiceDependency, owned func(*pagerduty.ServiceDependency) bool) error {
+	current, err := fetchServiceDependencies(client, serviceID, serviceType)
+	if err != nil {
+		return err
+	}
+
+	associate, disassociate := diffServiceDependencies(current, desired)
+
+	if len(associate) > 0 {
+		log.Printf("[INFO] Associating %d PagerDuty dependencies of %s %s", len(associate), serviceType, serviceID)
+
+		input := &pagerduty.ListServiceDependencies{Relationships: associate}
+		retryErr := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			if _, _, err := client.ServiceDependencies.AssociateServiceDependencies(input); err != nil {
+				if isErrCode(err, 404) || isErrCode(err, 429) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if retryErr != nil {
+			return retryErr
+		}
+	}
+
+	var toDisassociate []*pagerduty.ServiceDependency
+	for _, dep := range disassociate {
+		if owned == nil || owned(dep) {
+			toDisassociate = append(toDisassociate, dep)
+		}
+	}
+
+	if len(toDisassociate) > 0 {
+		log.Printf("[INFO] Disassociating %d PagerDuty dependencies of %s %s", len(toDisassociate), serviceType, serviceID)
+
+		input := &pagerduty.ListServiceDependencies{Relationships: toDisassociate}
+		retryErr := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			if _, _, err := client.ServiceDependencies.DisassociateServiceDependencies(input); err != nil {
+				if isErrCode(err, 429) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if retryErr != nil {
+			return retryErr
+		}
+	}
+
+	return nil
+}
+
+func resourcePagerDutyServiceDependenciesCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	serviceID := d.Get("service_id").(string)
+	serviceType := d.Get("service_type").(string)
+
+	log.Printf("[INFO] Creating PagerDuty dependencies of %s %s", serviceType, serviceID)
+
+	if err := reconcileServiceDependencies(client, serviceID, serviceType, buildServiceDependenciesStruct(d), nil); err != nil {
+		return err
+	}
+
+	d.SetId(serviceID)
+
+	return resourcePagerDutyServiceDependenciesRead(d, meta)
+}
+
+func resourcePagerDutyServiceDependenciesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	serviceID := d.Get("service_id").(string)
+	serviceType := d.Get("service_type").(string)
+
+	log.Printf("[INFO] Reading PagerDuty dependencies of %s %s", serviceType, serviceID)
+
+	// Pausing to let the PD API sync.
+	time.Sleep(1 * time.Second)
+
+	dependencies, err := fetchServiceDependencies(client, serviceID, serviceType)
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	supporting, dependent := flattenServiceDependencies(serviceID, dependencies)
+	if err := d.Set("supporting_service", supporting); err != nil {
+		return err
+	}
+	if err := d.Set("dependent_service", dependent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourcePagerDutyServiceDependenciesUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	serviceID := d.Get("service_id").(string)
+	serviceType := d.Get("service_type").(string)
+
+	log.Printf("[INFO] Updating PagerDuty dependencies of %s %s", serviceType, serviceID)
+
+	if err := reconcileServiceDependencies(client, serviceID, serviceType, buildServiceDependenciesStruct(d), nil); err != nil {
+		return err
+	}
+
+	return resourcePagerDutyServiceDependenciesRead(d, meta)
+}
+
+func resourcePagerDutyServiceDependenciesDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	serviceID := d.Get("service_id").(string)
+	serviceType := d.Get("service_type").(string)
+
+	log.Printf("[INFO] Deleting PagerDuty dependencies of %s %s", serviceType, serviceID)
+
+	// Only the dependencies in the state are removed, so that the ones created
+	// in the meantime by someone else are kept
+	inState := make(map[string]bool)
+	for _, dep := range buildServiceDependenciesStruct(d) {
+		inState[serviceDependencyKey(dep)] = true
+	}
+	owned := func(dep *pagerduty.ServiceDependency) bool {
+		return inState[serviceDependencyKey(dep)]
+	}
+
+	if err := reconcileServiceDependencies(client, serviceID, serviceType, nil, owned); err != nil {
+		if isErrCode(err, 404) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// flattenServiceDependencies splits the dependencies of the service between
+// its supporting services and its dependent services.
+func flattenServiceDependencies(serviceID string, dependencies []*pagerduty.ServiceDependency) ([]interface{}, []interface{}) {
+	supporting := []interface{}{}
+	dependent := []interface{}{}
+
+	for _, dep := range dependencies {
+		switch serviceID {
+		case dep.DependentService.ID:
+			supporting = append(supporting, map[string]interface{}{
+				"id":   dep.SupportingService.ID,
+				"type": dep.SupportingService.Type,
+			})
+		case dep.SupportingService.ID:
+			dependent = append(dependent, map[string]interface{}{
+				"id":   dep.DependentService.ID,
+				"type": dep.DependentService.Type,
+			})
+		}
+	}
+
+	return supporting, dependent
+}
+
+func resourcePagerDutyServiceDependenciesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ids := strings.Split(d.Id(), ".")
+
+	if len(ids) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_service_dependencies. Expecting an importation ID formed as '<service_id>.<service_type>'")
+	}
+	serviceID, serviceType := ids[0], ids[1]
+
+	if serviceType != "business_service" && serviceType != "service" {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_service_dependencies. The service type must be business_service or service, got: %s", serviceType)
+	}
+
+	d.SetId(serviceID)
+	d.Set("service_id", serviceID)
+	d.Set("service_type", serviceType)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,192 @@
+package pagerduty
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestDiffServiceDependencies(t *testing.T) {
+	dep := func(id, supportingID, dependentID string) *pagerduty.ServiceDependency {
+		return &pagerduty.ServiceDependency{
+			ID:                id,
+			SupportingService: &pagerduty.ServiceObj{ID: supportingID, Type: "service"},
+			DependentService:  &pagerduty.ServiceObj{ID: dependentID, Type: "business_service"},
+		}
+	}
+
+	current := []*pagerduty.ServiceDependency{dep("D1", "S1", "B1"), dep("D2", "S2", "B1")}
+	desired := []*pagerduty.ServiceDependency{dep("", "S2", "B1"), dep("", "S3", "B1")}
+
+	associate, disassociate := diffServiceDependencies(current, desired)
+
+	if len(associate) != 1 || serviceDependencyKey(associate[0]) != "S3.B1" {
+		t.Errorf("expected to associate S3.B1, got %v", associate)
+	}
+	// The current dependency is disassociated, as the API needs its ID
+	if len(disassociate) != 1 || disassociate[0].ID != "D1" {
+		t.Errorf("expected to disassociate D1, got %v", disassociate)
+	}
+
+	associate, disassociate = diffServiceDependencies(current, current)
+	if len(associate) != 0 || len(disassociate) != 0 {
+		t.Errorf("expected no change, got %v and %v", associate, disassociate)
+	}
+}
+
+func TestFlattenServiceDependencies(t *testing.T) {
+	dependencies := []*pagerduty.ServiceDependency{
+		{
+			SupportingService: &pagerduty.ServiceObj{ID: "S1", Type: "service"},
+			DependentService:  &pagerduty.ServiceObj{ID: "P1", Type: "service"},
+		},
+		{
+			SupportingService: &pagerduty.ServiceObj{ID: "P1", Type: "service"},
+			DependentService:  &pagerduty.ServiceObj{ID: "B1", Type: "business_service"},
+		},
+	}
+
+	supporting, dependent := flattenServiceDependencies("P1", dependencies)
+
+	expectedSupporting := []interface{}{map[string]interface{}{"id": "S1", "type": "service"}}
+	if !reflect.DeepEqual(supporting, expectedSupporting) {
+		t.Errorf("expected supporting services %v, got %v", expectedSupporting, supporting)
+	}
+	expectedDependent := []interface{}{map[string]interface{}{"id": "B1", "type": "business_service"}}
+	if !reflect.DeepEqual(dependent, expectedDependent) {
+		t.Errorf("expected dependent services %v, got %v", expectedDependent, dependent)
+	}
+}
+
+func TestAccPagerDutyServiceDependencies_Basic(t *testing.T) {
+	businessService := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDependenciesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceDependenciesConfig(businessService, service, username, email, escalationPolicy, "pagerduty_service.one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceDependenciesCount("pagerduty_service_dependencies.foo", 1),
+					resource.TestCheckResourceAttr("pagerduty_service_dependencies.foo", "supporting_service.#", "1"),
+					resource.TestCheckResourceAttr("pagerduty_service_dependencies.foo", "dependent_service.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceDependenciesConfig(businessService, service, username, email, escalationPolicy, "pagerduty_service.one", "pagerduty_service.two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceDependenciesCount("pagerduty_service_dependencies.foo", 2),
+					resource.TestCheckResourceAttr("pagerduty_service_dependencies.foo", "supporting_service.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceDependenciesConfig(businessService, service, username, email, escalationPolicy, "pagerduty_service.two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceDependenciesCount("pagerduty_service_dependencies.foo", 1),
+					resource.TestCheckTypeSetElemAttrPair("pagerduty_service_dependencies.foo", "supporting_service.*.id", "pagerduty_service.two", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyServiceDependenciesCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		dependencies, _, err := client.ServiceDependencies.GetServiceDependenciesForType(rs.Primary.ID, rs.Primary.Attributes["service_type"])
+		if err != nil {
+			return err
+		}
+
+		if len(dependencies.Relationships) != expected {
+			return fmt.Errorf("Expected %d dependencies of %s, got %d", expected, rs.Primary.ID, len(dependencies.Relationships))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyServiceDependenciesDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_service_dependencies" {
+			continue
+		}
+
+		dependencies, _, err := client.ServiceDependencies.GetServiceDependenciesForType(r.Primary.ID, r.Primary.Attributes["service_type"])
+		if err != nil {
+			// if the service doesn't exist, neither do its dependencies
+			continue
+		}
+		if len(dependencies.Relationships) > 0 {
+			return fmt.Errorf("Dependencies of %s still exist", r.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyServiceDependenciesConfig(businessService, service, username, email, escalationPolicy string, supporting ...string) string {
+	var supportingBlocks string
+	for _, s := range supporting {
+		supportingBlocks += fmt.Sprintf(`
+  supporting_service {
+    id   = %[1]s.id
+    type = %[1]s.type
+  }
+`, s)
+	}
+
+	return fmt.Sprintf(`
+resource "pagerduty_business_service" "foo" {
+  name = "%s"
+}
+
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 2
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "one" {
+  name              = "%[5]s-one"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_service" "two" {
+  name              = "%[5]s-two"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_service_dependencies" "foo" {
+  service_id   = pagerduty_business_service.foo.id
+  service_type = "business_service"
+%[6]s}
+`, businessService, username, email, escalationPolicy, service, supportingBlocks)
+}
@@ -15,6 +15,7 @@ func resourcePagerDutyServiceDependency() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePagerDutyServiceDependencyAssociate,
 		Read:   resourcePagerDutyServiceDependencyRead,
+		Update: resourcePagerDutyServiceDependencyUpdate,
 		Delete: resourcePagerDutyServiceDependencyDisassociate,
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyServiceDependencyImport,
@@ -81,6 +82,16 @@ func resourcePagerDutyServiceDependency() *schema.Resource {
 					},
 				},
 			},
+			"impact_guard": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "off",
+				ValidateFunc: validateValueFunc([]string{
+					"off",
+					"warn",
+					"block",
+				}),
+			},
 		},
 	}
 }
@@ -174,6 +185,10 @@ func resourcePagerDutyServiceDependencyDisassociate(d *schema.ResourceData, meta
 		return err
 	}
 
+	if err := checkServiceDependencyImpact(client, dependency, d.Get("impact_guard").(string)); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Disassociating PagerDuty dependency %s", dependency.DependentService.ID)
 
 	var foundDep *pagerduty.ServiceDependency
@@ -236,6 +251,56 @@ func resourcePagerDutyServiceDependencyDisassociate(d *schema.ResourceData, meta
 	return nil
 }
 
+// checkServiceDependencyImpact looks up whether the dependent business service
+// is currently impacted through the supporting service of the dependency, and
+// either logs a warning or refuses to continue depending on the guard mode.
+func checkServiceDependencyImpact(client *pagerduty.Client, dependency *pagerduty.ServiceDependency, guard string) error {
+	if guard == "off" || convertType(dependency.DependentService.Type) != "business_service" {
+		return nil
+	}
+
+	var impacted bool
+	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		impacts, _, err := client.BusinessServices.ListSupportingServiceImpacts(dependency.DependentService.ID)
+		if err != nil {
+			if isErrCode(err, 429) || isErrCode(err, 500) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		for _, impact := range impacts.Services {
+			if impact.ID == dependency.SupportingService.ID && impact.Status == "impacted" {
+				impacted = true
+				break
+			}
+		}
+		return nil
+	})
+	if retryErr != nil {
+		return retryErr
+	}
+
+	if !impacted {
+		return nil
+	}
+
+	msg := fmt.Sprintf("business service %s is currently impacted through supporting service %s", dependency.DependentService.ID, dependency.SupportingService.ID)
+	if guard == "block" {
+		return fmt.Errorf("Refusing to remove dependency while %s. Wait for the impact to clear or set impact_guard to \"warn\" or \"off\"", msg)
+	}
+
+	log.Printf("[WARN] Removing dependency while %s", msg)
+
+	return nil
+}
+
+func resourcePagerDutyServiceDependencyUpdate(d *schema.ResourceData, meta interface{}) error {
+	// impact_guard is the only attribute that can change in place and it is
+	// not sent to the PagerDuty API.
+	return resourcePagerDutyServiceDependencyRead(d, meta)
+}
+
 func resourcePagerDutyServiceDependencyRead(d *schema.ResourceData, meta interface{}) error {
 	serviceDependency, err := buildServiceDependencyStruct(d)
 	if err != nil {
@@ -331,6 +396,7 @@ func resourcePagerDutyServiceDependencyImport(d *schema.ResourceData, meta inter
 	if err := findDependencySetState(id, sid, st, d, meta); err != nil {
 		return []*schema.ResourceData{}, err
 	}
+	d.Set("impact_guard", "off")
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -38,6 +38,39 @@ func TestAccPagerDutyBusinessServiceDependency_Basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPagerDutyBusinessServiceDependency_ImpactGuard(t *testing.T) {
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	businessService := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyBusinessServiceDependencyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyBusinessServiceDependencyImpactGuardConfig(service, businessService, username, email, escalationPolicy, "block"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyBusinessServiceDependencyExists("pagerduty_service_dependency.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_dependency.foo", "impact_guard", "block"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyBusinessServiceDependencyImpactGuardConfig(service, businessService, username, email, escalationPolicy, "warn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyBusinessServiceDependencyExists("pagerduty_service_dependency.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_dependency.foo", "impact_guard", "warn"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyBusinessServiceDependencyExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -147,6 +180,51 @@ resource "pagerduty_service_dependency" "foo" {
 `, businessService, username, email, escalationPolicy, service)
 }
 
+func testAccCheckPagerDutyBusinessServiceDependencyImpactGuardConfig(service, businessService, username, email, escalationPolicy, impactGuard string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_business_service" "foo" {
+	name = "%s"
+}
+
+resource "pagerduty_user" "foo" {
+	name        = "%s"
+	email       = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name        = "%s"
+	description = "bar"
+	num_loops   = 2
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+resource "pagerduty_service" "foo" {
+	name = "%s"
+	description             = "foo"
+	escalation_policy       = pagerduty_escalation_policy.foo.id
+	alert_creation          = "create_incidents"
+}
+resource "pagerduty_service_dependency" "foo" {
+	impact_guard = "%s"
+	dependency {
+		dependent_service {
+			id = pagerduty_business_service.foo.id
+			type = "business_service"
+		}
+		supporting_service {
+			id = pagerduty_service.foo.id
+			type = "service"
+		}
+	}
+}
+`, businessService, username, email, escalationPolicy, service, impactGuard)
+}
+
 // Testing Technical Service Dependencies
 func TestAccPagerDutyTechnicalServiceDependency_Basic(t *testing.T) {
 	dependentService := fmt.Sprintf("tf-%s", acctest.RandString(5))
@@ -28,6 +28,7 @@ func resourcePagerDutyServiceEventRule() *schema.Resource {
 			"position": {
 				Type:     schema.TypeInt,
 				Optional: true,
+				Computed: true,
 			},
 			"disabled": {
 				Type:     schema.TypeBool,
@@ -320,8 +321,11 @@ func buildServiceEventRuleStruct(d *schema.ResourceData) *pagerduty.ServiceEvent
 		rule.Variables = expandRuleVariables(attr.([]interface{}))
 	}
 
-	pos := d.Get("position").(int)
-	rule.Position = &pos
+	// Rules without a configured position are left where PagerDuty puts
+	// them, sending the zero value would move all of them to the top
+	if pos, ok := configuredServiceEventRulePosition(d); ok {
+		rule.Position = &pos
+	}
 
 	if attr, ok := d.GetOk("disabled"); ok {
 		rule.Disabled = attr.(bool)
@@ -329,6 +333,17 @@ func buildServiceEventRuleStruct(d *schema.ResourceData) *pagerduty.ServiceEvent
 
 	return rule
 }
+
+// configuredServiceEventRulePosition returns the position set in the
+// configuration, as position is computed the state can't tell whether it was.
+func configuredServiceEventRulePosition(d *schema.ResourceData) (int, bool) {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() || raw.GetAttr("position").IsNull() {
+		return 0, false
+	}
+	return d.Get("position").(int), true
+}
+
 func resourcePagerDutyServiceEventRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -345,8 +360,7 @@ func resourcePagerDutyServiceEventRuleCreate(d *schema.ResourceData, meta interf
 		} else if rule != nil {
 			d.SetId(rule.ID)
 			// Verifying the position that was defined in terraform is the same position set in PagerDuty
-			pos := d.Get("position").(int)
-			if *rule.Position != pos {
+			if pos, ok := configuredServiceEventRulePosition(d); ok && (rule.Position == nil || *rule.Position != pos) {
 				if err := resourcePagerDutyServiceEventRuleUpdate(d, meta); err != nil {
 					return resource.NonRetryableError(err)
 				}
@@ -409,7 +423,7 @@ func resourcePagerDutyServiceEventRuleUpdate(d *schema.ResourceData, meta interf
 	retryErr := resource.Retry(30*time.Second, func() *resource.RetryError {
 		if updatedRule, _, err := client.Services.UpdateEventRule(serviceID, d.Id(), rule); err != nil {
 			return resource.RetryableError(err)
-		} else if rule.Position != nil && *updatedRule.Position != *rule.Position {
+		} else if rule.Position != nil && updatedRule.Position != nil && *updatedRule.Position != *rule.Position {
 			log.Printf("[INFO] Service Event Rule %s position %v needs to be %v", updatedRule.ID, *updatedRule.Position, *rule.Position)
 			return resource.RetryableError(fmt.Errorf("Error updating service event rule %s position %d needs to be %d", updatedRule.ID, *updatedRule.Position, *rule.Position))
 		}
@@ -455,6 +469,19 @@ func resourcePagerDutyServiceEventRuleImport(d *schema.ResourceData, meta interf
 
 	ids := strings.Split(d.Id(), ".")
 
+	if len(ids) == 1 {
+		resp, _, err := client.Services.ListEventRules(ids[0], &pagerduty.ListServiceEventRuleOptions{})
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		var childIDs []string
+		for _, rule := range resp.EventRules {
+			childIDs = append(childIDs, fmt.Sprintf("%s.%s", ids[0], rule.ID))
+		}
+		return []*schema.ResourceData{}, importByParentIDError("pagerduty_service_event_rule", ids[0], childIDs)
+	}
+
 	if len(ids) != 2 {
 		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_service_event_rule. Expecting an importation ID formed as '<service_id>.<service_event_rule_id>'")
 	}
@@ -0,0 +1,194 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// resourcePagerDutyServiceEventRuleOrder owns the order of all the event
+// rules of a service, so positions don't have to be managed on each
+// pagerduty_service_event_rule.
+func resourcePagerDutyServiceEventRuleOrder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePagerDutyServiceEventRuleOrderUpdate,
+		Read:   resourcePagerDutyServiceEventRuleOrderRead,
+		Update: resourcePagerDutyServiceEventRuleOrderUpdate,
+		Delete: resourcePagerDutyServiceEventRuleOrderDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePagerDutyServiceEventRuleOrderImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// listServiceEventRulesByPosition lists the event rules of a service in the
+// order they are evaluated.
+func listServiceEventRulesByPosition(client *pagerduty.Client, serviceID string) ([]*pagerduty.ServiceEventRule, error) {
+	rules, err := client.Services.ListAllEventRules(serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	position := func(r *pagerduty.ServiceEventRule) int {
+		if r.Position == nil {
+			return len(rules)
+		}
+		return *r.Position
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		return position(rules[i]) < position(rules[j])
+	})
+
+	return rules, nil
+}
+
+func resourcePagerDutyServiceEventRuleOrderUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	serviceID := d.Get("service").(string)
+	ruleIDs := expandStringList(d.Get("rules").([]interface{}))
+
+	log.Printf("[INFO] Ordering PagerDuty service event rules of service: %s", serviceID)
+
+	rules, err := listServiceEventRulesByPosition(client, serviceID)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[string]*pagerduty.ServiceEventRule, len(rules))
+	var order []string
+	for _, r := range rules {
+		byID[r.ID] = r
+		order = append(order, r.ID)
+	}
+
+	// Moving the rules one by one from the top keeps the positions of the ones
+	// already placed, any rule not listed ends up after them. The current
+	// order is tracked locally as each move shifts the rules below it.
+	seen := make(map[string]bool, len(ruleIDs))
+	for i, id := range ruleIDs {
+		rule, ok := byID[id]
+		if !ok {
+			return fmt.Errorf("event rule %s doesn't belong to service %s", id, serviceID)
+		}
+		if seen[id] {
+			return fmt.Errorf("event rule %s is listed more than once", id)
+		}
+		seen[id] = true
+
+		if order[i] == id {
+			continue
+		}
+
+		for j, o := range order {
+			if o == id {
+				order = append(order[:j], order[j+1:]...)
+				break
+			}
+		}
+		order = append(order[:i], append([]string{id}, order[i:]...)...)
+
+		pos := i
+		rule.Position = &pos
+
+		retryErr := resource.Retry(30*time.Second, func() *resource.RetryError {
+			if _, _, err := client.Services.UpdateEventRule(serviceID, id, rule); err != nil {
+				return resource.RetryableError(err)
+			}
+			return nil
+		})
+		if retryErr != nil {
+			time.Sleep(2 * time.Second)
+			return retryErr
+		}
+	}
+
+	d.SetId(serviceID)
+
+	return resourcePagerDutyServiceEventRuleOrderRead(d, meta)
+}
+
+func resourcePagerDutyServiceEventRuleOrderRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty service event rule order of service: %s", d.Id())
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		rules, err := listServiceEventRulesByPosition(client, d.Id())
+		if err != nil {
+			if isErrCode(err, 404) {
+				log.Printf("[WARN] Removing %s because it's gone", d.Id())
+				d.SetId("")
+				return nil
+			}
+			time.Sleep(2 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		// Only the rules already listed are read back, in their current
+		// order, so rules added outside of this resource don't show up as a
+		// diff. On import nothing is listed yet and every rule is kept.
+		managed := make(map[string]bool)
+		for _, id := range expandStringList(d.Get("rules").([]interface{})) {
+			managed[id] = true
+		}
+
+		var ruleIDs []string
+		for _, r := range rules {
+			if len(managed) == 0 || managed[r.ID] {
+				ruleIDs = append(ruleIDs, r.ID)
+			}
+		}
+
+		d.Set("service", d.Id())
+		d.Set("rules", ruleIDs)
+
+		return nil
+	})
+}
+
+func resourcePagerDutyServiceEventRuleOrderDelete(d *schema.ResourceData, meta interface{}) error {
+	// The rules are managed by their own resources, there is nothing to remove
+	log.Printf("[INFO] Removing PagerDuty service event rule order of service %s from state", d.Id())
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePagerDutyServiceEventRuleOrderImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := resourcePagerDutyServiceEventRuleOrderRead(d, meta); err != nil {
+		return []*schema.ResourceData{}, err
+	}
+	if d.Id() == "" {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_service_event_rule_order. Expecting an importation ID of an existing service")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
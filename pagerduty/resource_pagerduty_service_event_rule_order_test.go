@@ -0,0 +1,87 @@
+package pagerduty
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPagerDutyServiceEventRuleOrder_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	rule1 := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	rule2 := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	rule3 := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceEventRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceEventRuleOrderConfig(username, email, escalationPolicy, service, rule1, rule2, rule3, "baz", "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_event_rule_order.foo", "rules.#", "3"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_service_event_rule_order.foo", "rules.0", "pagerduty_service_event_rule.baz", "id"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_service_event_rule_order.foo", "rules.1", "pagerduty_service_event_rule.foo", "id"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_service_event_rule_order.foo", "rules.2", "pagerduty_service_event_rule.bar", "id"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceEventRuleOrderConfig(username, email, escalationPolicy, service, rule1, rule2, rule3, "bar", "baz", "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_service_event_rule_order.foo", "rules.0", "pagerduty_service_event_rule.bar", "id"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_service_event_rule_order.foo", "rules.1", "pagerduty_service_event_rule.baz", "id"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_service_event_rule_order.foo", "rules.2", "pagerduty_service_event_rule.foo", "id"),
+				),
+			},
+			{
+				// The rule left out keeps its position after the listed ones
+				// without showing up as a difference
+				Config: testAccCheckPagerDutyServiceEventRuleOrderConfig(username, email, escalationPolicy, service, rule1, rule2, rule3, "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_event_rule_order.foo", "rules.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_service_event_rule_order.foo", "rules.0", "pagerduty_service_event_rule.foo", "id"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_service_event_rule_order.foo", "rules.1", "pagerduty_service_event_rule.bar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyServiceEventRuleOrderConfig(username, email, escalationPolicy, service, rule1, rule2, rule3 string, order ...string) string {
+	// Positions are owned by the order resource
+	rules := testAccCheckPagerDutyServiceEventRuleConfigMultipleRules(username, email, escalationPolicy, service, rule1, rule2, rule3)
+	rules = strings.Replace(rules, "position = 1", "", 1)
+	rules = strings.Replace(rules, "position = 2", "", 1)
+
+	var ids string
+	for _, name := range order {
+		ids += fmt.Sprintf("\t\tpagerduty_service_event_rule.%s.id,\n", name)
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "pagerduty_service_event_rule_order" "foo" {
+	service = pagerduty_service.foo.id
+	rules = [
+%s	]
+}
+`, rules, ids)
+}
@@ -14,22 +14,18 @@ import (
 )
 
 const (
-	errEmailIntegrationMustHaveEmail = "integration_email attribute must be set for an integration type generic_email_inbound_integration"
+	errEmailIntegrationMustHaveEmail   = "integration_email attribute must be set for an integration type generic_email_inbound_integration"
+	errEmailFilterRequiresRulesMode    = "email_filter blocks are only applied when email_filter_mode is set to or-rules-email or and-rules-email"
+	errEmailParserRequiresUseRulesMode = "email_parser blocks are only applied when email_incident_creation is set to use_rules"
 )
 
 func resourcePagerDutyServiceIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePagerDutyServiceIntegrationCreate,
-		Read:   resourcePagerDutyServiceIntegrationRead,
-		Update: resourcePagerDutyServiceIntegrationUpdate,
-		Delete: resourcePagerDutyServiceIntegrationDelete,
-		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
-			t := diff.Get("type").(string)
-			if t == "generic_email_inbound_integration" && diff.Get("integration_email").(string) == "" && diff.NewValueKnown("integration_email") {
-				return errors.New(errEmailIntegrationMustHaveEmail)
-			}
-			return nil
-		},
+		Create:        resourcePagerDutyServiceIntegrationCreate,
+		Read:          resourcePagerDutyServiceIntegrationRead,
+		Update:        resourcePagerDutyServiceIntegrationUpdate,
+		Delete:        resourcePagerDutyServiceIntegrationDelete,
+		CustomizeDiff: customizePagerDutyServiceIntegrationDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyServiceIntegrationImport,
 		},
@@ -70,9 +66,15 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 				Computed:      true,
 			},
 			"integration_key": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"rotate_trigger": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"integration_key"},
 			},
 			"integration_email": {
 				Type:     schema.TypeString,
@@ -87,16 +89,31 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
+				ValidateFunc: validateValueFunc([]string{
+					"on_new_email",
+					"on_new_email_subject",
+					"only_if_no_open_incidents",
+					"use_rules",
+				}),
 			},
 			"email_filter_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
+				ValidateFunc: validateValueFunc([]string{
+					"all-email",
+					"or-rules-email",
+					"and-rules-email",
+				}),
 			},
 			"email_parsing_fallback": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
+				ValidateFunc: validateValueFunc([]string{
+					"open_new_incident",
+					"discard",
+				}),
 			},
 			"email_parser": {
 				Type:     schema.TypeList,
@@ -246,7 +263,6 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 			"email_filter": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -299,6 +315,33 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 	}
 }
 
+func customizePagerDutyServiceIntegrationDiff(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	t := diff.Get("type").(string)
+	if t == "generic_email_inbound_integration" && diff.Get("integration_email").(string) == "" && diff.NewValueKnown("integration_email") {
+		return errors.New(errEmailIntegrationMustHaveEmail)
+	}
+	if len(diff.Get("email_filter").([]interface{})) > 0 && diff.NewValueKnown("email_filter_mode") {
+		if m := diff.Get("email_filter_mode").(string); m != "or-rules-email" && m != "and-rules-email" {
+			return errors.New(errEmailFilterRequiresRulesMode)
+		}
+	}
+	if len(diff.Get("email_parser").([]interface{})) > 0 && diff.NewValueKnown("email_incident_creation") {
+		if diff.Get("email_incident_creation").(string) != "use_rules" {
+			return errors.New(errEmailParserRequiresUseRulesMode)
+		}
+	}
+
+	// A new integration key is generated by PagerDuty whenever rotate_trigger
+	// changes on an existing integration.
+	if diff.Id() != "" && diff.HasChange("rotate_trigger") {
+		if err := diff.SetNewComputed("integration_key"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func buildServiceIntegrationStruct(d *schema.ResourceData) (*pagerduty.Integration, error) {
 	serviceIntegration := &pagerduty.Integration{
 		Name: d.Get("name").(string),
@@ -698,7 +741,15 @@ func resourcePagerDutyServiceIntegrationUpdate(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	return nil
+	if d.HasChange("rotate_trigger") {
+		log.Printf("[INFO] Rotating integration key of PagerDuty service integration %s", d.Id())
+
+		if _, _, err := client.Services.RegenerateIntegrationKey(service, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return fetchPagerDutyServiceIntegration(d, meta, genError)
 }
 
 func resourcePagerDutyServiceIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
@@ -728,6 +779,19 @@ func resourcePagerDutyServiceIntegrationImport(d *schema.ResourceData, meta inte
 
 	ids := strings.Split(d.Id(), ".")
 
+	if len(ids) == 1 {
+		service, _, err := client.Services.Get(ids[0], &pagerduty.GetServiceOptions{})
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		var childIDs []string
+		for _, integration := range service.Integrations {
+			childIDs = append(childIDs, fmt.Sprintf("%s.%s", ids[0], integration.ID))
+		}
+		return []*schema.ResourceData{}, importByParentIDError("pagerduty_service_integration", ids[0], childIDs)
+	}
+
 	if len(ids) != 2 {
 		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_service_integration. Expecting an importation ID formed as '<service_id>.<integration_id>'")
 	}
@@ -54,6 +54,41 @@ func TestAccPagerDutyServiceIntegration_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyServiceIntegration_RotateKey(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	serviceIntegration := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	var integrationKey string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationRotateKeyConfig(username, email, escalationPolicy, service, serviceIntegration, "2023-01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceIntegrationExists("pagerduty_service_integration.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "rotate_trigger", "2023-01"),
+					testAccCheckPagerDutyServiceIntegrationKey("pagerduty_service_integration.foo", &integrationKey, false),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationRotateKeyConfig(username, email, escalationPolicy, service, serviceIntegration, "2023-02"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceIntegrationExists("pagerduty_service_integration.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "rotate_trigger", "2023-02"),
+					testAccCheckPagerDutyServiceIntegrationKey("pagerduty_service_integration.foo", &integrationKey, true),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyServiceIntegrationGeneric_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -366,6 +401,31 @@ func TestAccPagerDutyServiceIntegrationEmail_Filters(t *testing.T) {
 						"pagerduty_service_integration.foo", "email_parser.1.value_extractor.2.value_name", "FieldName2"),
 				),
 			},
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationEmailFiltersConfigOrRules(username, email, escalationPolicy, service, serviceIntegrationUpdated, testAccGetPagerDutyAccountDomain(t)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceIntegrationExists("pagerduty_service_integration.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "integration_email", fmt.Sprintf("s11@%s", testAccGetPagerDutyAccountDomain(t))),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "email_incident_creation", "on_new_email_subject"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "email_filter_mode", "or-rules-email"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "email_filter.#", "1"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "email_filter.0.body_mode", "match"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "email_filter.0.body_regex", "(FATAL*)"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_service_integration.foo", "email_filter.0.id"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyServiceIntegrationEmailFiltersConfigInvalidMode(username, email, escalationPolicy, service, serviceIntegrationUpdated, testAccGetPagerDutyAccountDomain(t)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(errEmailFilterRequiresRulesMode),
+			},
 		},
 	})
 }
@@ -415,6 +475,28 @@ func testAccCheckPagerDutyServiceIntegrationExists(n string) resource.TestCheckF
 	}
 }
 
+func testAccCheckPagerDutyServiceIntegrationKey(n string, key *string, rotated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		current := rs.Primary.Attributes["integration_key"]
+		if current == "" {
+			return fmt.Errorf("No integration key is set")
+		}
+
+		if rotated && current == *key {
+			return fmt.Errorf("Expected integration key to be rotated, but it is still %s", current)
+		}
+
+		*key = current
+
+		return nil
+	}
+}
+
 func testAccCheckPagerDutyServiceIntegrationConfig(username, email, escalationPolicy, service, serviceIntegration string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -958,3 +1040,149 @@ resource "pagerduty_service_integration" "foo" {
 }
 `, username, email, escalationPolicy, service, serviceIntegration, accountDomain)
 }
+
+func testAccCheckPagerDutyServiceIntegrationEmailFiltersConfigOrRules(username, email, escalationPolicy, service, serviceIntegration string, accountDomain string) string {
+	return fmt.Sprintf(`
+data "pagerduty_vendor" "email" {
+  name = "Email"
+}
+resource "pagerduty_user" "foo" {
+  name        = "%s"
+  email       = "%s"
+}
+resource "pagerduty_escalation_policy" "foo" {
+  name        = "%s"
+  description = "foo"
+  num_loops   = 1
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+resource "pagerduty_service" "foo" {
+  name                    = "%s"
+  description             = "foo"
+  auto_resolve_timeout    = 1800
+  acknowledgement_timeout = 1800
+  escalation_policy       = pagerduty_escalation_policy.foo.id
+  incident_urgency_rule {
+    type = "constant"
+    urgency = "high"
+  }
+}
+resource "pagerduty_service_integration" "foo" {
+  name    = "%s"
+  service = pagerduty_service.foo.id
+  vendor  = data.pagerduty_vendor.email.id
+  integration_email       = "s11@%s"
+  email_incident_creation = "on_new_email_subject"
+  email_filter_mode       = "or-rules-email"
+  email_filter {
+    body_mode        = "match"
+    body_regex       = "(FATAL*)"
+    from_email_mode  = "always"
+    from_email_regex = null
+    subject_mode     = "always"
+    subject_regex    = null
+  }
+}
+`, username, email, escalationPolicy, service, serviceIntegration, accountDomain)
+}
+
+func testAccCheckPagerDutyServiceIntegrationEmailFiltersConfigInvalidMode(username, email, escalationPolicy, service, serviceIntegration string, accountDomain string) string {
+	return fmt.Sprintf(`
+data "pagerduty_vendor" "email" {
+  name = "Email"
+}
+resource "pagerduty_user" "foo" {
+  name        = "%s"
+  email       = "%s"
+}
+resource "pagerduty_escalation_policy" "foo" {
+  name        = "%s"
+  description = "foo"
+  num_loops   = 1
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+resource "pagerduty_service" "foo" {
+  name                    = "%s"
+  description             = "foo"
+  auto_resolve_timeout    = 1800
+  acknowledgement_timeout = 1800
+  escalation_policy       = pagerduty_escalation_policy.foo.id
+  incident_urgency_rule {
+    type = "constant"
+    urgency = "high"
+  }
+}
+resource "pagerduty_service_integration" "foo" {
+  name    = "%s"
+  service = pagerduty_service.foo.id
+  vendor  = data.pagerduty_vendor.email.id
+  integration_email       = "s11@%s"
+  email_incident_creation = "on_new_email_subject"
+  email_filter_mode       = "all-email"
+  email_filter {
+    body_mode        = "match"
+    body_regex       = "(FATAL*)"
+    from_email_mode  = "always"
+    from_email_regex = null
+    subject_mode     = "always"
+    subject_regex    = null
+  }
+}
+`, username, email, escalationPolicy, service, serviceIntegration, accountDomain)
+}
+
+func testAccCheckPagerDutyServiceIntegrationRotateKeyConfig(username, email, escalationPolicy, service, serviceIntegration, rotateTrigger string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name        = "%s"
+  email       = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name        = "%s"
+  description = "foo"
+  num_loops   = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name                    = "%s"
+  description             = "foo"
+  auto_resolve_timeout    = 1800
+  acknowledgement_timeout = 1800
+  escalation_policy       = pagerduty_escalation_policy.foo.id
+
+  incident_urgency_rule {
+    type = "constant"
+    urgency = "high"
+  }
+}
+
+resource "pagerduty_service_integration" "foo" {
+  name           = "%s"
+  service        = pagerduty_service.foo.id
+  type           = "events_api_v2_inbound_integration"
+  rotate_trigger = "%s"
+}
+`, username, email, escalationPolicy, service, serviceIntegration, rotateTrigger)
+}
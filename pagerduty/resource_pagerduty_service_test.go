@@ -1,10 +1,14 @@
 package pagerduty
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -66,6 +70,8 @@ func TestAccPagerDutyService_Basic(t *testing.T) {
 					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_service.foo", "name", service),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_service.foo", "standards_score.#"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_service.foo", "description", "foo"),
 					resource.TestCheckResourceAttr(
@@ -459,6 +465,127 @@ func TestAccPagerDutyService_AutoPauseNotificationsParameters(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyService_Enabled(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceEnabledConfig(username, email, escalationPolicy, service, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "status", "disabled"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceEnabledConfig(username, email, escalationPolicy, service, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyService_ForceDestroyOpenIncidents(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	incidentID := ""
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceForceDestroyConfig(username, email, escalationPolicy, service, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					testAccCheckPagerDutyScheduleOpenIncidentOnService(&incidentID, "pagerduty_service.foo", "pagerduty_escalation_policy.foo"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyServiceForceDestroyConfig(username, email, escalationPolicy, service, false),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("You must first resolve the following incidents"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceForceDestroyConfig(username, email, escalationPolicy, service, true),
+				Check: resource.TestCheckResourceAttr(
+					"pagerduty_service.foo", "force_destroy_open_incidents", "true"),
+			},
+		},
+	})
+}
+
+func TestResolveIncidentsSendsFromHeader(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		from     []string
+		resolved int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != "PUT" || r.URL.Path != "/incidents" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":2100,"message":"Not Found"}}`))
+			return
+		}
+
+		var body pagerduty.ManageIncidentsPayload
+		json.NewDecoder(r.Body).Decode(&body)
+
+		mu.Lock()
+		from = append(from, r.Header.Get("From"))
+		resolved += len(body.Incidents)
+		mu.Unlock()
+		w.Write([]byte(`{"incidents":[]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: server.URL, SkipCredsValidation: true}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var incidents []*pagerduty.Incident
+	for i := 0; i < 300; i++ {
+		incidents = append(incidents, &pagerduty.Incident{ID: fmt.Sprintf("PINC%03d", i)})
+	}
+
+	if err := resolveIncidents(client, "admin@foo.test", incidents); err != nil {
+		t.Fatal(err)
+	}
+
+	if resolved != 300 {
+		t.Errorf("expected 300 resolved incidents, got %d", resolved)
+	}
+	if len(from) != 2 {
+		t.Fatalf("expected the incidents to be resolved in 2 batches, got %d", len(from))
+	}
+	for _, f := range from {
+		if f != "admin@foo.test" {
+			t.Errorf("expected the From header to be admin@foo.test, got %q", f)
+		}
+	}
+}
+
 func TestAccPagerDutyService_BasicWithIncidentUrgencyRules(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -539,6 +666,10 @@ func TestAccPagerDutyService_BasicWithIncidentUrgencyRules(t *testing.T) {
 				Config:      testAccCheckPagerDutyServiceWithIncidentUrgencyRulesConfigError(username, email, escalationPolicy, serviceUpdated),
 				ExpectError: regexp.MustCompile("general urgency cannot be set for a use_support_hours incident urgency rule type"),
 			},
+			{
+				Config:      testAccCheckPagerDutyServiceWithIncidentUrgencyRulesInvalidScheduledActionsConfig(username, email, escalationPolicy, serviceUpdated),
+				ExpectError: regexp.MustCompile(`"support_hours_middle" is an invalid value`),
+			},
 			{
 				Config: testAccCheckPagerDutyServiceWithIncidentUrgencyRulesWithoutScheduledActionsConfig(username, email, escalationPolicy, service),
 				Check: resource.ComposeTestCheckFunc(
@@ -661,6 +792,69 @@ func TestAccPagerDutyService_BasicWithIncidentUrgencyRules(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyService_IncidentUrgencyRuleValidation(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceIncidentUrgencyRuleValidationConfig(username, email, escalationPolicy, service, `
+	incident_urgency_rule {
+		type = "use_support_hours"
+		during_support_hours {
+			type    = "constant"
+			urgency = "high"
+		}
+		outside_support_hours {
+			type    = "constant"
+			urgency = "low"
+		}
+	}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("support_hours must be set when using a use_support_hours incident urgency rule type"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceIncidentUrgencyRuleValidationConfig(username, email, escalationPolicy, service, `
+	incident_urgency_rule {
+		type = "constant"
+	}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("urgency must be set for a constant incident urgency rule type"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceIncidentUrgencyRuleValidationConfig(username, email, escalationPolicy, service, `
+	incident_urgency_rule {
+		type    = "constant"
+		urgency = "high"
+	}
+
+	scheduled_actions {
+		type       = "urgency_change"
+		to_urgency = "high"
+		at {
+			type = "named_time"
+			name = "support_hours_start"
+		}
+	}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("scheduled_actions can only be set for a use_support_hours incident urgency rule type"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceIncidentUrgencyRuleValidationConfig(username, email, escalationPolicy, service, `
+	force_destroy_open_incidents = true`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("force_destroy_from must be set when force_destroy_open_incidents is true"),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyService_FromBasicToCustomIncidentUrgencyRules(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -995,6 +1189,88 @@ resource "pagerduty_service" "foo" {
 `, username, email, escalationPolicy, service)
 }
 
+func testAccCheckPagerDutyServiceIncidentUrgencyRuleValidationConfig(username, email, escalationPolicy, service, urgencyConfig string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name        = "%s"
+	email       = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name        = "%s"
+	num_loops   = 2
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name              = "%s"
+	escalation_policy = pagerduty_escalation_policy.foo.id
+%s
+}
+`, username, email, escalationPolicy, service, urgencyConfig)
+}
+
+func testAccCheckPagerDutyServiceEnabledConfig(username, email, escalationPolicy, service string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name        = "%s"
+	email       = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name        = "%s"
+	num_loops   = 2
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name              = "%s"
+	escalation_policy = pagerduty_escalation_policy.foo.id
+	enabled           = %t
+}
+`, username, email, escalationPolicy, service, enabled)
+}
+
+func testAccCheckPagerDutyServiceForceDestroyConfig(username, email, escalationPolicy, service string, forceDestroy bool) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name        = "%s"
+	email       = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name        = "%s"
+	num_loops   = 2
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name                         = "%s"
+	escalation_policy            = pagerduty_escalation_policy.foo.id
+	force_destroy_open_incidents = %t
+	force_destroy_from           = pagerduty_user.foo.email
+}
+`, username, email, escalationPolicy, service, forceDestroy)
+}
+
 func testAccCheckPagerDutyServiceConfigWithAlertGrouping(username, email, escalationPolicy, service string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -1570,6 +1846,12 @@ resource "pagerduty_service" "foo" {
 `, username, email, escalationPolicy, service)
 }
 
+func testAccCheckPagerDutyServiceWithIncidentUrgencyRulesInvalidScheduledActionsConfig(username, email, escalationPolicy, service string) string {
+	return strings.Replace(
+		testAccCheckPagerDutyServiceWithIncidentUrgencyRulesConfig(username, email, escalationPolicy, service),
+		`name = "support_hours_start"`, `name = "support_hours_middle"`, 1)
+}
+
 func testAccCheckPagerDutyServiceWithIncidentUrgencyRulesConfigError(username, email, escalationPolicy, service string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -51,6 +51,7 @@ func resourcePagerDutySlackConnection() *schema.Resource {
 			"workspace_id": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				DefaultFunc: schema.EnvDefaultFunc("SLACK_CONNECTION_WORKSPACE_ID", nil),
 			},
 			"notification_type": {
@@ -64,6 +65,7 @@ func resourcePagerDutySlackConnection() *schema.Resource {
 			"config": {
 				Type:     schema.TypeList,
 				Required: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"events": {
@@ -151,7 +153,13 @@ func resourcePagerDutySlackConnectionRead(d *schema.ResourceData, meta interface
 
 	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
 		if slackConn, _, err := client.SlackConnections.Get(workspaceID, d.Id()); err != nil {
-			return resource.RetryableError(err)
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if slackConn != nil {
 			d.Set("source_id", slackConn.SourceID)
 			d.Set("source_name", slackConn.SourceName)
@@ -188,7 +196,7 @@ func resourcePagerDutySlackConnectionUpdate(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	return nil
+	return resourcePagerDutySlackConnectionRead(d, meta)
 }
 
 func resourcePagerDutySlackConnectionDelete(d *schema.ResourceData, meta interface{}) error {
@@ -200,7 +208,7 @@ func resourcePagerDutySlackConnectionDelete(d *schema.ResourceData, meta interfa
 	log.Printf("[INFO] Deleting PagerDuty slack connection %s", d.Id())
 	workspaceID := d.Get("workspace_id").(string)
 
-	if _, err := client.SlackConnections.Delete(workspaceID, d.Id()); err != nil {
+	if _, err := client.SlackConnections.Delete(workspaceID, d.Id()); err != nil && !isErrCode(err, 404) {
 		return err
 	}
 
@@ -290,6 +298,19 @@ func resourcePagerDutySlackConnectionImport(d *schema.ResourceData, meta interfa
 
 	ids := strings.Split(d.Id(), ".")
 
+	if len(ids) == 1 {
+		resp, _, err := client.SlackConnections.List(ids[0])
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		var childIDs []string
+		for _, conn := range resp.SlackConnections {
+			childIDs = append(childIDs, fmt.Sprintf("%s.%s", ids[0], conn.ID))
+		}
+		return []*schema.ResourceData{}, importByParentIDError("pagerduty_slack_connection", ids[0], childIDs)
+	}
+
 	if len(ids) != 2 {
 		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_slack_connection. Expecting an importation ID formed as '<workspace_id>.<slack_connection_id>'")
 	}
@@ -20,6 +20,29 @@ var (
 	workspaceID string = "T02ADG9LV1A"
 )
 
+func TestExpandConnectionConfigStarWildcard(t *testing.T) {
+	config := expandConnectionConfig([]interface{}{
+		map[string]interface{}{
+			"events":     []interface{}{"incident.triggered"},
+			"priorities": []interface{}{StarWildcardConfig},
+			"urgency":    "",
+		},
+	})
+
+	if config.Priorities != nil {
+		t.Errorf("expected the star wildcard to be sent as nil priorities, got %v", config.Priorities)
+	}
+	if config.Urgency != nil {
+		t.Errorf("expected no urgency, got %v", *config.Urgency)
+	}
+
+	flattened := flattenConnectionConfig(config)
+	priorities := flattened[0]["priorities"].([]interface{})
+	if len(priorities) != 1 || priorities[0] != StarWildcardConfig {
+		t.Errorf("expected the star wildcard back in the state, got %v", priorities)
+	}
+}
+
 func TestAccPagerDutySlackConnection_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -0,0 +1,202 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyStandard() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePagerDutyStandardCreate,
+		ReadContext:   resourcePagerDutyStandardRead,
+		UpdateContext: resourcePagerDutyStandardUpdate,
+		DeleteContext: resourcePagerDutyStandardDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePagerDutyStandardImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"standard": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"exclusion": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "technical_service_reference",
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Standards always exist, so "creating" one only brings it under the
+// management of Terraform by updating it.
+func resourcePagerDutyStandardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("standard").(string))
+
+	return resourcePagerDutyStandardUpdate(ctx, d, meta)
+}
+
+func resourcePagerDutyStandardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading PagerDuty standard %s", d.Id())
+
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		standard, err := fetchPagerDutyStandard(ctx, client, d.Id())
+		if err != nil {
+			if isErrCode(err, 400) || isErrCode(err, 403) {
+				return resource.NonRetryableError(err)
+			}
+
+			time.Sleep(2 * time.Second)
+			return resource.RetryableError(err)
+		}
+
+		if standard == nil {
+			log.Printf("[WARN] Removing %s because it's gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		d.Set("standard", standard.ID)
+		d.Set("active", standard.Active)
+		d.Set("name", standard.Name)
+		d.Set("description", standard.Description)
+		d.Set("type", standard.Type)
+		d.Set("resource_type", standard.ResourceType)
+		if err := d.Set("exclusion", flattenStandardInclusionsExclusions(standard.Exclusions)); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourcePagerDutyStandardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	standard, err := fetchPagerDutyStandard(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if standard == nil {
+		return diag.FromErr(fmt.Errorf("Unable to locate any standard with ID: %s", d.Id()))
+	}
+
+	// The inclusions aren't managed by this resource, so they are sent back
+	// as they are to keep them in place.
+	p := &pagerduty.UpdateStandardPayload{
+		Active:      d.Get("active").(bool),
+		Description: standard.Description,
+		Exclusions:  expandStandardExclusions(d.Get("exclusion").(*schema.Set)),
+		Inclusions:  standard.Inclusions,
+	}
+	if p.Inclusions == nil {
+		p.Inclusions = []*pagerduty.StandardInclusionExclusion{}
+	}
+
+	log.Printf("[INFO] Updating PagerDuty standard %s", d.Id())
+
+	if _, _, err := client.Standards.UpdateContext(ctx, d.Id(), p); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourcePagerDutyStandardRead(ctx, d, meta)
+}
+
+// Standards can't be deleted, so deleting one only removes it from the state
+// and leaves it as it is.
+func resourcePagerDutyStandardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Removing PagerDuty standard %s from the state", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func resourcePagerDutyStandardImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("standard", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// fetchPagerDutyStandard returns the standard with the given ID, or nil if
+// there is none, as the API has no endpoint to get a single standard.
+func fetchPagerDutyStandard(ctx context.Context, client *pagerduty.Client, id string) (*pagerduty.Standard, error) {
+	resp, _, err := client.Standards.ListContext(ctx, &pagerduty.ListStandardsOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, standard := range resp.Standards {
+		if standard.ID == id {
+			return standard, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func expandStandardExclusions(v *schema.Set) []*pagerduty.StandardInclusionExclusion {
+	exclusions := []*pagerduty.StandardInclusionExclusion{}
+
+	for _, e := range v.List() {
+		m := e.(map[string]interface{})
+		exclusions = append(exclusions, &pagerduty.StandardInclusionExclusion{
+			ID:   m["id"].(string),
+			Type: m["type"].(string),
+		})
+	}
+
+	return exclusions
+}
@@ -0,0 +1,127 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyStandard_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	// Standards can't be deleted, so the last step puts the standard back in
+	// its default state: active and without exclusions.
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyStandardConfigExcluded(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyStandardExists("pagerduty_standard.foo"),
+					resource.TestCheckResourceAttr("pagerduty_standard.foo", "active", "false"),
+					resource.TestCheckResourceAttr("pagerduty_standard.foo", "exclusion.#", "1"),
+					resource.TestCheckResourceAttrSet("pagerduty_standard.foo", "name"),
+				),
+			},
+			{
+				ResourceName:      "pagerduty_standard.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCheckPagerDutyStandardConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyStandardExists("pagerduty_standard.foo"),
+					resource.TestCheckResourceAttr("pagerduty_standard.foo", "active", "true"),
+					resource.TestCheckResourceAttr("pagerduty_standard.foo", "exclusion.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyStandardExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No standard ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, err := fetchPagerDutyStandard(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return fmt.Errorf("Standard not found: %v", rs.Primary.ID)
+		}
+		if fmt.Sprint(found.Active) != rs.Primary.Attributes["active"] {
+			return fmt.Errorf("Expected the standard %s to be active: %s, got: %t", rs.Primary.ID, rs.Primary.Attributes["active"], found.Active)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyStandardConfigExcluded(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]s"
+  email = "%[1]s@foo.test"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%[1]s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[1]s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+data "pagerduty_standards" "services" {
+  resource_type = "technical_service"
+}
+
+resource "pagerduty_standard" "foo" {
+  standard = data.pagerduty_standards.services.standards[0].id
+  active   = false
+
+  exclusion {
+    id = pagerduty_service.foo.id
+  }
+}
+`, name)
+}
+
+func testAccCheckPagerDutyStandardConfig() string {
+	return `
+data "pagerduty_standards" "services" {
+  resource_type = "technical_service"
+}
+
+resource "pagerduty_standard" "foo" {
+  standard = data.pagerduty_standards.services.standards[0].id
+  active   = true
+}
+`
+}
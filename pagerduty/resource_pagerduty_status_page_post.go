@@ -0,0 +1,239 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyStatusPagePost() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourcePagerDutyStatusPagePostRead,
+		UpdateContext: resourcePagerDutyStatusPagePostUpdateContext,
+		DeleteContext: resourcePagerDutyStatusPagePostDelete,
+		CreateContext: resourcePagerDutyStatusPagePostCreate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePagerDutyStatusPagePostImport,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if !d.NewValueKnown("post_type") || d.Get("post_type").(string) != pagerduty.StatusPagePostTypeMaintenance {
+				return nil
+			}
+			// The dates are computed, so the configuration tells apart the
+			// ones left unset from the ones only known at apply time
+			raw := d.GetRawConfig()
+			if raw.IsNull() {
+				return nil
+			}
+			for _, k := range []string{"starts_at", "ends_at"} {
+				if v := raw.GetAttr(k); v.IsKnown() && v.IsNull() {
+					return fmt.Errorf("starts_at and ends_at must be set for maintenance posts")
+				}
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"status_page_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"post_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateValueFunc([]string{
+					pagerduty.StatusPagePostTypeIncident,
+					pagerduty.StatusPagePostTypeMaintenance,
+				}),
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"starts_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateRFC3339,
+				DiffSuppressFunc: suppressRFC3339Diff,
+			},
+			"ends_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateRFC3339,
+				DiffSuppressFunc: suppressRFC3339Diff,
+			},
+			"update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: statusPagePostUpdateSchema(true),
+				},
+			},
+		},
+	}
+}
+
+func resourcePagerDutyStatusPagePostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusPageID := d.Get("status_page_id").(string)
+	post := buildStatusPagePostStruct(d)
+	if v, ok := d.GetOk("update"); ok {
+		post.Updates = []*pagerduty.StatusPagePostUpdate{
+			buildStatusPagePostUpdateStruct(v.([]interface{})[0].(map[string]interface{})),
+		}
+	}
+
+	log.Printf("[INFO] Creating PagerDuty post %s on status page %s", post.Title, statusPageID)
+
+	createdPost, _, err := client.StatusPages.CreatePostContext(ctx, statusPageID, post)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(createdPost.ID)
+
+	return resourcePagerDutyStatusPagePostRead(ctx, d, meta)
+}
+
+func resourcePagerDutyStatusPagePostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusPageID := d.Get("status_page_id").(string)
+
+	log.Printf("[INFO] Reading PagerDuty post %s of status page %s", d.Id(), statusPageID)
+
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		post, _, err := client.StatusPages.GetPostContext(ctx, statusPageID, d.Id())
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		flattenStatusPagePost(d, statusPageID, post)
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourcePagerDutyStatusPagePostUpdateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusPageID := d.Get("status_page_id").(string)
+	post := buildStatusPagePostStruct(d)
+
+	log.Printf("[INFO] Updating PagerDuty post %s of status page %s", d.Id(), statusPageID)
+
+	if _, _, err := client.StatusPages.UpdatePostContext(ctx, statusPageID, d.Id(), post); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourcePagerDutyStatusPagePostRead(ctx, d, meta)
+}
+
+func resourcePagerDutyStatusPagePostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusPageID := d.Get("status_page_id").(string)
+
+	log.Printf("[INFO] Deleting PagerDuty post %s of status page %s", d.Id(), statusPageID)
+
+	if _, err := client.StatusPages.DeletePostContext(ctx, statusPageID, d.Id()); err != nil && !isErrCode(err, 404) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourcePagerDutyStatusPagePostImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	ids := strings.Split(d.Id(), ":")
+
+	if len(ids) == 1 {
+		resp, _, err := client.StatusPages.ListPostsContext(ctx, ids[0])
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		var childIDs []string
+		for _, p := range resp.Posts {
+			childIDs = append(childIDs, fmt.Sprintf("%s:%s", ids[0], p.ID))
+		}
+		return []*schema.ResourceData{}, importByParentIDError("pagerduty_status_page_post", ids[0], childIDs)
+	}
+
+	if len(ids) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_status_page_post. Expecting an importation ID formed as '<status_page_id>:<post_id>'")
+	}
+	statusPageID, id := ids[0], ids[1]
+
+	post, _, err := client.StatusPages.GetPostContext(ctx, statusPageID, id)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(post.ID)
+	flattenStatusPagePost(d, statusPageID, post)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildStatusPagePostStruct(d *schema.ResourceData) *pagerduty.StatusPagePost {
+	return &pagerduty.StatusPagePost{
+		Type:     "status_page_post",
+		PostType: d.Get("post_type").(string),
+		StatusPage: &pagerduty.StatusPageReference{
+			ID:   d.Get("status_page_id").(string),
+			Type: "status_page",
+		},
+		Title:    d.Get("title").(string),
+		StartsAt: d.Get("starts_at").(string),
+		EndsAt:   d.Get("ends_at").(string),
+	}
+}
+
+// flattenStatusPagePost doesn't read the updates of the post back, as they are
+// managed by pagerduty_status_page_post_update once the post is created.
+func flattenStatusPagePost(d *schema.ResourceData, statusPageID string, post *pagerduty.StatusPagePost) {
+	d.Set("status_page_id", statusPageID)
+	d.Set("post_type", post.PostType)
+	d.Set("title", post.Title)
+	d.Set("starts_at", post.StartsAt)
+	d.Set("ends_at", post.EndsAt)
+}
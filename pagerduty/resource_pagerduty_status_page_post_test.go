@@ -0,0 +1,103 @@
+package pagerduty
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPagerDutyStatusPagePost_Maintenance(t *testing.T) {
+	statusPage := os.Getenv("PAGERDUTY_ACC_STATUS_PAGE_NAME")
+	title := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	titleUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	startsAt := timeNowInAccLoc().Add(24 * time.Hour).Truncate(time.Minute).Format(time.RFC3339)
+	endsAt := timeNowInAccLoc().Add(26 * time.Hour).Truncate(time.Minute).Format(time.RFC3339)
+	endsAtUpdated := timeNowInAccLoc().Add(28 * time.Hour).Truncate(time.Minute).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckStatusPages(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPagerDutyStatusPagePostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyStatusPagePostConfig(statusPage, title, startsAt, endsAt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyStatusPagePostExists("pagerduty_status_page_post.test"),
+					resource.TestCheckResourceAttrPair("pagerduty_status_page_post.test", "status_page_id", "data.pagerduty_status_page.test", "id"),
+					resource.TestCheckResourceAttr("pagerduty_status_page_post.test", "post_type", "maintenance"),
+					resource.TestCheckResourceAttr("pagerduty_status_page_post.test", "title", title),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyStatusPagePostConfig(statusPage, titleUpdated, startsAt, endsAtUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyStatusPagePostExists("pagerduty_status_page_post.test"),
+					resource.TestCheckResourceAttr("pagerduty_status_page_post.test", "title", titleUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyStatusPagePostConfig(statusPage, title, startsAt, endsAt string) string {
+	return fmt.Sprintf(`
+data "pagerduty_status_page" "test" {
+  name = "%s"
+}
+
+resource "pagerduty_status_page_post" "test" {
+  status_page_id = data.pagerduty_status_page.test.id
+  post_type      = "maintenance"
+  title          = "%s"
+  starts_at      = "%s"
+  ends_at        = "%s"
+}
+`, statusPage, title, startsAt, endsAt)
+}
+
+func testAccCheckPagerDutyStatusPagePostDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_status_page_post" {
+			continue
+		}
+
+		if _, _, err := client.StatusPages.GetPost(r.Primary.Attributes["status_page_id"], r.Primary.ID); err == nil {
+			return fmt.Errorf("status page post still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyStatusPagePostExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no status page post ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.StatusPages.GetPost(rs.Primary.Attributes["status_page_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("status page post not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,330 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyStatusPagePostUpdate() *schema.Resource {
+	s := statusPagePostUpdateSchema(false)
+	s["status_page_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["post_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["reviewed_status"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext:   resourcePagerDutyStatusPagePostUpdateRead,
+		UpdateContext: resourcePagerDutyStatusPagePostUpdateUpdate,
+		DeleteContext: resourcePagerDutyStatusPagePostUpdateDelete,
+		CreateContext: resourcePagerDutyStatusPagePostUpdateCreate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePagerDutyStatusPagePostUpdateImport,
+		},
+		Schema: s,
+	}
+}
+
+// statusPagePostUpdateSchema returns the arguments of a post update, shared
+// with the initial update of pagerduty_status_page_post, which replaces the
+// post when changed.
+func statusPagePostUpdateSchema(forceNew bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"message": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: forceNew,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: forceNew,
+		},
+		"severity": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: forceNew,
+		},
+		"impacted_service": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: forceNew,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"service": {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: forceNew,
+					},
+					"severity": {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: forceNew,
+					},
+				},
+			},
+		},
+		"notify_subscribers": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+			ForceNew: forceNew,
+		},
+		"update_frequency_ms": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			ForceNew: forceNew,
+		},
+		"reported_at": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ForceNew:         forceNew,
+			ValidateFunc:     validateRFC3339,
+			DiffSuppressFunc: suppressRFC3339Diff,
+		},
+	}
+}
+
+func resourcePagerDutyStatusPagePostUpdateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusPageID := d.Get("status_page_id").(string)
+	postID := d.Get("post_id").(string)
+	update := buildStatusPagePostUpdateStructFromResourceData(d)
+
+	log.Printf("[INFO] Creating PagerDuty update of post %s of status page %s", postID, statusPageID)
+
+	createdUpdate, _, err := client.StatusPages.CreatePostUpdateContext(ctx, statusPageID, postID, update)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(createdUpdate.ID)
+
+	return resourcePagerDutyStatusPagePostUpdateRead(ctx, d, meta)
+}
+
+func resourcePagerDutyStatusPagePostUpdateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusPageID := d.Get("status_page_id").(string)
+	postID := d.Get("post_id").(string)
+
+	log.Printf("[INFO] Reading PagerDuty update %s of post %s of status page %s", d.Id(), postID, statusPageID)
+
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		update, _, err := client.StatusPages.GetPostUpdateContext(ctx, statusPageID, postID, d.Id())
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		if err := flattenStatusPagePostUpdate(d, statusPageID, postID, update); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourcePagerDutyStatusPagePostUpdateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusPageID := d.Get("status_page_id").(string)
+	postID := d.Get("post_id").(string)
+	update := buildStatusPagePostUpdateStructFromResourceData(d)
+
+	log.Printf("[INFO] Updating PagerDuty update %s of post %s of status page %s", d.Id(), postID, statusPageID)
+
+	if _, _, err := client.StatusPages.UpdatePostUpdateContext(ctx, statusPageID, postID, d.Id(), update); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourcePagerDutyStatusPagePostUpdateRead(ctx, d, meta)
+}
+
+func resourcePagerDutyStatusPagePostUpdateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusPageID := d.Get("status_page_id").(string)
+	postID := d.Get("post_id").(string)
+
+	log.Printf("[INFO] Deleting PagerDuty update %s of post %s of status page %s", d.Id(), postID, statusPageID)
+
+	// The updates are deleted along with their post
+	if _, err := client.StatusPages.DeletePostUpdateContext(ctx, statusPageID, postID, d.Id()); err != nil && !isErrCode(err, 404) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourcePagerDutyStatusPagePostUpdateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	ids := strings.Split(d.Id(), ":")
+
+	if len(ids) == 2 {
+		resp, _, err := client.StatusPages.ListPostUpdatesContext(ctx, ids[0], ids[1])
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		var childIDs []string
+		for _, u := range resp.PostUpdates {
+			childIDs = append(childIDs, fmt.Sprintf("%s:%s:%s", ids[0], ids[1], u.ID))
+		}
+		return []*schema.ResourceData{}, importByParentIDError("pagerduty_status_page_post_update", d.Id(), childIDs)
+	}
+
+	if len(ids) != 3 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_status_page_post_update. Expecting an importation ID formed as '<status_page_id>:<post_id>:<post_update_id>'")
+	}
+	statusPageID, postID, id := ids[0], ids[1], ids[2]
+
+	update, _, err := client.StatusPages.GetPostUpdateContext(ctx, statusPageID, postID, id)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(update.ID)
+	if err := flattenStatusPagePostUpdate(d, statusPageID, postID, update); err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildStatusPagePostUpdateStructFromResourceData(d *schema.ResourceData) *pagerduty.StatusPagePostUpdate {
+	update := buildStatusPagePostUpdateStruct(map[string]interface{}{
+		"message":             d.Get("message"),
+		"status":              d.Get("status"),
+		"severity":            d.Get("severity"),
+		"impacted_service":    d.Get("impacted_service"),
+		"notify_subscribers":  d.Get("notify_subscribers"),
+		"update_frequency_ms": d.Get("update_frequency_ms"),
+		"reported_at":         d.Get("reported_at"),
+	})
+	update.Post = &pagerduty.StatusPagePostReference{
+		ID:   d.Get("post_id").(string),
+		Type: "status_page_post",
+	}
+
+	return update
+}
+
+// buildStatusPagePostUpdateStruct builds a post update from its arguments, as
+// found in the resource or in the update block of a post.
+func buildStatusPagePostUpdateStruct(m map[string]interface{}) *pagerduty.StatusPagePostUpdate {
+	update := &pagerduty.StatusPagePostUpdate{
+		Type:    "status_page_post_update",
+		Message: m["message"].(string),
+		Status: &pagerduty.StatusPageStatusReference{
+			ID:   m["status"].(string),
+			Type: "status_page_status",
+		},
+		Severity: &pagerduty.StatusPageSeverityReference{
+			ID:   m["severity"].(string),
+			Type: "status_page_severity",
+		},
+		ImpactedServices:  []*pagerduty.StatusPagePostUpdateImpactedService{},
+		NotifySubscribers: m["notify_subscribers"].(bool),
+		ReportedAt:        m["reported_at"].(string),
+	}
+
+	if v := m["update_frequency_ms"].(int); v > 0 {
+		update.UpdateFrequencyMS = &v
+	}
+
+	for _, v := range m["impacted_service"].(*schema.Set).List() {
+		s := v.(map[string]interface{})
+		update.ImpactedServices = append(update.ImpactedServices, &pagerduty.StatusPagePostUpdateImpactedService{
+			Service: &pagerduty.StatusPageServiceReference{
+				ID:   s["service"].(string),
+				Type: "status_page_service",
+			},
+			Severity: &pagerduty.StatusPageSeverityReference{
+				ID:   s["severity"].(string),
+				Type: "status_page_severity",
+			},
+		})
+	}
+
+	return update
+}
+
+func flattenStatusPagePostUpdate(d *schema.ResourceData, statusPageID, postID string, update *pagerduty.StatusPagePostUpdate) error {
+	d.Set("status_page_id", statusPageID)
+	d.Set("post_id", postID)
+	d.Set("message", update.Message)
+	d.Set("notify_subscribers", update.NotifySubscribers)
+	d.Set("reported_at", update.ReportedAt)
+	d.Set("reviewed_status", update.ReviewedStatus)
+
+	if update.Status != nil {
+		d.Set("status", update.Status.ID)
+	}
+	if update.Severity != nil {
+		d.Set("severity", update.Severity.ID)
+	}
+	if update.UpdateFrequencyMS != nil {
+		d.Set("update_frequency_ms", *update.UpdateFrequencyMS)
+	}
+
+	return d.Set("impacted_service", flattenStatusPagePostUpdateImpactedServices(update.ImpactedServices))
+}
+
+func flattenStatusPagePostUpdateImpactedServices(impacted []*pagerduty.StatusPagePostUpdateImpactedService) []interface{} {
+	var result []interface{}
+	for _, i := range impacted {
+		if i.Service == nil || i.Severity == nil {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"service":  i.Service.ID,
+			"severity": i.Severity.ID,
+		})
+	}
+	return result
+}
@@ -0,0 +1,150 @@
+package pagerduty
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBuildStatusPagePostUpdateStruct(t *testing.T) {
+	r := resourcePagerDutyStatusPagePostUpdate()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"status_page_id":      "PSP1",
+		"post_id":             "PPO1",
+		"message":             "Deploying the fix",
+		"status":              "PST1",
+		"severity":            "PSE1",
+		"notify_subscribers":  true,
+		"update_frequency_ms": 300000,
+		"impacted_service": []interface{}{
+			map[string]interface{}{"service": "PSS1", "severity": "PSE2"},
+		},
+	})
+
+	update := buildStatusPagePostUpdateStructFromResourceData(d)
+
+	if update.Post.ID != "PPO1" || update.Post.Type != "status_page_post" {
+		t.Errorf("unexpected post reference: %v", update.Post)
+	}
+	if update.Status.ID != "PST1" || update.Status.Type != "status_page_status" {
+		t.Errorf("unexpected status reference: %v", update.Status)
+	}
+	if update.Severity.ID != "PSE1" || update.Severity.Type != "status_page_severity" {
+		t.Errorf("unexpected severity reference: %v", update.Severity)
+	}
+	if !update.NotifySubscribers || update.UpdateFrequencyMS == nil || *update.UpdateFrequencyMS != 300000 {
+		t.Errorf("unexpected notification settings: %v, %v", update.NotifySubscribers, update.UpdateFrequencyMS)
+	}
+	if len(update.ImpactedServices) != 1 || update.ImpactedServices[0].Service.ID != "PSS1" || update.ImpactedServices[0].Severity.ID != "PSE2" {
+		t.Errorf("unexpected impacted services: %v", update.ImpactedServices)
+	}
+
+	expected := []interface{}{map[string]interface{}{"service": "PSS1", "severity": "PSE2"}}
+	if flattened := flattenStatusPagePostUpdateImpactedServices(update.ImpactedServices); !reflect.DeepEqual(flattened, expected) {
+		t.Errorf("expected flattened impacted services %v, got %v", expected, flattened)
+	}
+}
+
+func TestAccPagerDutyStatusPagePostUpdate_Basic(t *testing.T) {
+	statusPage := os.Getenv("PAGERDUTY_ACC_STATUS_PAGE_NAME")
+	title := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	startsAt := timeNowInAccLoc().Add(24 * time.Hour).Truncate(time.Minute).Format(time.RFC3339)
+	endsAt := timeNowInAccLoc().Add(26 * time.Hour).Truncate(time.Minute).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckStatusPages(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPagerDutyStatusPagePostUpdateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyStatusPagePostUpdateConfig(statusPage, title, startsAt, endsAt, "The maintenance is scheduled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyStatusPagePostUpdateExists("pagerduty_status_page_post_update.test"),
+					resource.TestCheckResourceAttr("pagerduty_status_page_post_update.test", "message", "The maintenance is scheduled"),
+					resource.TestCheckResourceAttrPair("pagerduty_status_page_post_update.test", "status", "data.pagerduty_status_page_statuses.test", "statuses.0.id"),
+					resource.TestCheckResourceAttrPair("pagerduty_status_page_post_update.test", "severity", "data.pagerduty_status_page_severities.test", "severities.0.id"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyStatusPagePostUpdateConfig(statusPage, title, startsAt, endsAt, "The maintenance is starting soon"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyStatusPagePostUpdateExists("pagerduty_status_page_post_update.test"),
+					resource.TestCheckResourceAttr("pagerduty_status_page_post_update.test", "message", "The maintenance is starting soon"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyStatusPagePostUpdateConfig(statusPage, title, startsAt, endsAt, message string) string {
+	return fmt.Sprintf(`
+%s
+
+data "pagerduty_status_page_statuses" "test" {
+  status_page_id = data.pagerduty_status_page.test.id
+  post_type      = "maintenance"
+}
+
+data "pagerduty_status_page_severities" "test" {
+  status_page_id = data.pagerduty_status_page.test.id
+  post_type      = "maintenance"
+}
+
+resource "pagerduty_status_page_post_update" "test" {
+  status_page_id = pagerduty_status_page_post.test.status_page_id
+  post_id        = pagerduty_status_page_post.test.id
+  message        = "%s"
+  status         = data.pagerduty_status_page_statuses.test.statuses[0].id
+  severity       = data.pagerduty_status_page_severities.test.severities[0].id
+}
+`, testAccCheckPagerDutyStatusPagePostConfig(statusPage, title, startsAt, endsAt), message)
+}
+
+func testAccCheckPagerDutyStatusPagePostUpdateDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_status_page_post_update" {
+			continue
+		}
+
+		if _, _, err := client.StatusPages.GetPostUpdate(r.Primary.Attributes["status_page_id"], r.Primary.Attributes["post_id"], r.Primary.ID); err == nil {
+			return fmt.Errorf("status page post update still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyStatusPagePostUpdateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no status page post update ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.StatusPages.GetPostUpdate(rs.Primary.Attributes["status_page_id"], rs.Primary.Attributes["post_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("status page post update not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
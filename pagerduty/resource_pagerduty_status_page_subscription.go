@@ -0,0 +1,203 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func resourcePagerDutyStatusPageSubscription() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourcePagerDutyStatusPageSubscriptionRead,
+		DeleteContext: resourcePagerDutyStatusPageSubscriptionDelete,
+		CreateContext: resourcePagerDutyStatusPageSubscriptionCreate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePagerDutyStatusPageSubscriptionImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"status_page_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"channel": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateValueFunc([]string{
+					pagerduty.StatusPageSubscriptionChannelEmail,
+					pagerduty.StatusPageSubscriptionChannelWebhook,
+				}),
+			},
+			"contact": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status_page_service_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePagerDutyStatusPageSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusPageID := d.Get("status_page_id").(string)
+	subscription := buildStatusPageSubscriptionStruct(d)
+
+	log.Printf("[INFO] Subscribing %s to PagerDuty status page %s", subscription.Contact, statusPageID)
+
+	createdSubscription, _, err := client.StatusPages.CreateSubscriptionContext(ctx, statusPageID, subscription)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(createdSubscription.ID)
+
+	return resourcePagerDutyStatusPageSubscriptionRead(ctx, d, meta)
+}
+
+func resourcePagerDutyStatusPageSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusPageID := d.Get("status_page_id").(string)
+
+	log.Printf("[INFO] Reading PagerDuty subscription %s to status page %s", d.Id(), statusPageID)
+
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		subscription, _, err := client.StatusPages.GetSubscriptionContext(ctx, statusPageID, d.Id())
+		if err != nil {
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
+		}
+
+		flattenStatusPageSubscription(d, statusPageID, subscription)
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourcePagerDutyStatusPageSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusPageID := d.Get("status_page_id").(string)
+
+	log.Printf("[INFO] Deleting PagerDuty subscription %s to status page %s", d.Id(), statusPageID)
+
+	if _, err := client.StatusPages.DeleteSubscriptionContext(ctx, statusPageID, d.Id()); err != nil && !isErrCode(err, 404) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourcePagerDutyStatusPageSubscriptionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	ids := strings.Split(d.Id(), ":")
+
+	if len(ids) == 1 {
+		resp, _, err := client.StatusPages.ListSubscriptionsContext(ctx, ids[0])
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		var childIDs []string
+		for _, s := range resp.Subscriptions {
+			childIDs = append(childIDs, fmt.Sprintf("%s:%s", ids[0], s.ID))
+		}
+		return []*schema.ResourceData{}, importByParentIDError("pagerduty_status_page_subscription", ids[0], childIDs)
+	}
+
+	if len(ids) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_status_page_subscription. Expecting an importation ID formed as '<status_page_id>:<subscription_id>'")
+	}
+	statusPageID, id := ids[0], ids[1]
+
+	subscription, _, err := client.StatusPages.GetSubscriptionContext(ctx, statusPageID, id)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(subscription.ID)
+	flattenStatusPageSubscription(d, statusPageID, subscription)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// buildStatusPageSubscriptionStruct subscribes to the whole status page,
+// unless a service of the status page is set.
+func buildStatusPageSubscriptionStruct(d *schema.ResourceData) *pagerduty.StatusPageSubscription {
+	statusPageID := d.Get("status_page_id").(string)
+
+	subscription := &pagerduty.StatusPageSubscription{
+		Type:    "status_page_subscription",
+		Channel: d.Get("channel").(string),
+		Contact: d.Get("contact").(string),
+		StatusPage: &pagerduty.StatusPageReference{
+			ID:   statusPageID,
+			Type: "status_page",
+		},
+		SubscribableObject: &pagerduty.StatusPageSubscribableObject{
+			ID:   statusPageID,
+			Type: "status_page",
+		},
+	}
+
+	if v, ok := d.GetOk("status_page_service_id"); ok {
+		subscription.SubscribableObject = &pagerduty.StatusPageSubscribableObject{
+			ID:   v.(string),
+			Type: "status_page_service",
+		}
+	}
+
+	return subscription
+}
+
+func flattenStatusPageSubscription(d *schema.ResourceData, statusPageID string, subscription *pagerduty.StatusPageSubscription) {
+	d.Set("status_page_id", statusPageID)
+	d.Set("channel", subscription.Channel)
+	d.Set("contact", subscription.Contact)
+	d.Set("status", subscription.Status)
+
+	if o := subscription.SubscribableObject; o != nil && o.Type == "status_page_service" {
+		d.Set("status_page_service_id", o.ID)
+	} else {
+		d.Set("status_page_service_id", "")
+	}
+}
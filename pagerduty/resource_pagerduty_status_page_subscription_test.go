@@ -0,0 +1,119 @@
+package pagerduty
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestBuildStatusPageSubscriptionStruct(t *testing.T) {
+	r := resourcePagerDutyStatusPageSubscription()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"status_page_id": "PSP1",
+		"channel":        "email",
+		"contact":        "stakeholder@foo.test",
+	})
+	subscription := buildStatusPageSubscriptionStruct(d)
+	if o := subscription.SubscribableObject; o.ID != "PSP1" || o.Type != "status_page" {
+		t.Errorf("expected a subscription to the status page, got %v", o)
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"status_page_id":         "PSP1",
+		"channel":                "webhook",
+		"contact":                "https://foo.test/status",
+		"status_page_service_id": "PSS1",
+	})
+	subscription = buildStatusPageSubscriptionStruct(d)
+	if o := subscription.SubscribableObject; o.ID != "PSS1" || o.Type != "status_page_service" {
+		t.Errorf("expected a subscription to the status page service, got %v", o)
+	}
+	if subscription.StatusPage.ID != "PSP1" {
+		t.Errorf("expected the status page PSP1, got %v", subscription.StatusPage)
+	}
+}
+
+func TestAccPagerDutyStatusPageSubscription_Basic(t *testing.T) {
+	statusPage := os.Getenv("PAGERDUTY_ACC_STATUS_PAGE_NAME")
+	contact := fmt.Sprintf("tf-%s@foo.test", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckStatusPages(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPagerDutyStatusPageSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyStatusPageSubscriptionConfig(statusPage, contact),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyStatusPageSubscriptionExists("pagerduty_status_page_subscription.test"),
+					resource.TestCheckResourceAttr("pagerduty_status_page_subscription.test", "channel", "email"),
+					resource.TestCheckResourceAttr("pagerduty_status_page_subscription.test", "contact", contact),
+					resource.TestCheckResourceAttr("pagerduty_status_page_subscription.test", "status_page_service_id", ""),
+					resource.TestCheckResourceAttrSet("pagerduty_status_page_subscription.test", "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyStatusPageSubscriptionConfig(statusPage, contact string) string {
+	return fmt.Sprintf(`
+data "pagerduty_status_page" "test" {
+  name = "%s"
+}
+
+resource "pagerduty_status_page_subscription" "test" {
+  status_page_id = data.pagerduty_status_page.test.id
+  channel        = "email"
+  contact        = "%s"
+}
+`, statusPage, contact)
+}
+
+func testAccCheckPagerDutyStatusPageSubscriptionDestroy(s *terraform.State) error {
+	client, _ := testAccProvider.Meta().(*Config).Client()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_status_page_subscription" {
+			continue
+		}
+
+		if _, _, err := client.StatusPages.GetSubscription(r.Primary.Attributes["status_page_id"], r.Primary.ID); err == nil {
+			return fmt.Errorf("status page subscription still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyStatusPageSubscriptionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no status page subscription ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.StatusPages.GetSubscription(rs.Primary.Attributes["status_page_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("status page subscription not found: %v - %v", rs.Primary.ID, found)
+		}
+
+		return nil
+	}
+}
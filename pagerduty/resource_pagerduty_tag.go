@@ -89,10 +89,14 @@ func resourcePagerDutyTagRead(d *schema.ResourceData, meta interface{}) error {
 
 	return resource.Retry(30*time.Second, func() *resource.RetryError {
 		if tag, _, err := client.Tags.Get(d.Id()); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if tag != nil {
-			log.Printf("Tag Type: %v", tag.Type)
 			d.Set("label", tag.Label)
 			d.Set("summary", tag.Summary)
 			d.Set("html_url", tag.HTMLURL)
@@ -110,7 +114,7 @@ func resourcePagerDutyTagDelete(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] Deleting PagerDuty tag %s", d.Id())
 
 	retryErr := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		if _, err := client.Tags.Delete(d.Id()); err != nil {
+		if _, err := client.Tags.Delete(d.Id()); err != nil && !isErrCode(err, 404) {
 			return resource.RetryableError(err)
 		}
 		return nil
@@ -108,8 +108,14 @@ func resourcePagerDutyTagAssignmentRead(d *schema.ResourceData, meta interface{}
 
 	return resource.Retry(30*time.Second, func() *resource.RetryError {
 		if tagResponse, _, err := client.Tags.ListTagsForEntity(assignment.EntityType, assignment.EntityID); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			// The tagged entity has been deleted, along with its tags.
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if tagResponse != nil {
 			var foundTag *pagerduty.Tag
 
@@ -143,6 +149,10 @@ func resourcePagerDutyTagAssignmentDelete(d *schema.ResourceData, meta interface
 
 	retryErr := resource.Retry(10*time.Second, func() *resource.RetryError {
 		if _, err := client.Tags.Assign(assignment.EntityType, assignment.EntityID, assignments); err != nil {
+			if isErrCode(err, 404) {
+				d.SetId("")
+				return nil
+			}
 			if isErrCode(err, 400) || isErrCode(err, 429) {
 				return resource.RetryableError(err)
 			}
@@ -163,16 +173,30 @@ func resourcePagerDutyTagAssignmentDelete(d *schema.ResourceData, meta interface
 
 func resourcePagerDutyTagAssignmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	ids := strings.Split(d.Id(), ".")
-	if len(ids) != 3 {
+	if len(ids) != 2 && len(ids) != 3 {
 		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_tag_assignment. Expecting an importation ID formed as '<entity_type>.<entity_id>.<tag_id>'")
 	}
-	entityType, entityID, tagID := ids[0], ids[1], ids[2]
 
 	client, err := meta.(*Config).Client()
 	if err != nil {
 		return []*schema.ResourceData{}, err
 	}
 
+	if len(ids) == 2 {
+		parentID := fmt.Sprintf("%s.%s", ids[0], ids[1])
+		resp, _, err := client.Tags.ListTagsForEntity(ids[0], ids[1])
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		var childIDs []string
+		for _, tag := range resp.Tags {
+			childIDs = append(childIDs, fmt.Sprintf("%s.%s", parentID, tag.ID))
+		}
+		return []*schema.ResourceData{}, importByParentIDError("pagerduty_tag_assignment", parentID, childIDs)
+	}
+	entityType, entityID, tagID := ids[0], ids[1], ids[2]
+
 	// give PagerDuty 2 seconds to save the assignment correctly
 	time.Sleep(2 * time.Second)
 	tagResponse, _, err := client.Tags.ListTagsForEntity(entityType, entityID)
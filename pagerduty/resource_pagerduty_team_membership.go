@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -17,7 +18,7 @@ func resourcePagerDutyTeamMembership() *schema.Resource {
 		Update: resourcePagerDutyTeamMembershipUpdate,
 		Delete: resourcePagerDutyTeamMembershipDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourcePagerDutyTeamMembershipImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"user_id": {
@@ -285,3 +286,34 @@ func isTeamMember(user *pagerduty.User, teamID string) bool {
 
 	return found
 }
+
+func resourcePagerDutyTeamMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ":") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	// An ID without colon is taken as a team ID, whose memberships are listed
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	teamID := d.Id()
+	var childIDs []string
+	o := &pagerduty.GetMembersOptions{Limit: 100}
+	for {
+		resp, _, err := client.Teams.GetMembers(teamID, o)
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+		for _, member := range resp.Members {
+			childIDs = append(childIDs, fmt.Sprintf("%s:%s", member.User.ID, teamID))
+		}
+		if !resp.More {
+			break
+		}
+		o.Offset += o.Limit
+	}
+
+	return []*schema.ResourceData{}, importByParentIDError("pagerduty_team_membership", teamID, childIDs)
+}
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -17,6 +18,12 @@ func resourcePagerDutyUser() *schema.Resource {
 		Read:   resourcePagerDutyUserRead,
 		Update: resourcePagerDutyUserUpdate,
 		Delete: resourcePagerDutyUserDelete,
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			if !diff.HasChange("email") || !diff.NewValueKnown("email") {
+				return nil
+			}
+			return validateUserEmailDomain(diff.Get("email").(string), meta)
+		},
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -109,6 +116,24 @@ func resourcePagerDutyUser() *schema.Resource {
 	}
 }
 
+// validateUserEmailDomain checks the email against the provider's
+// allowed_email_domains, if any were configured.
+func validateUserEmailDomain(email string, meta interface{}) error {
+	config, ok := meta.(*Config)
+	if !ok || len(config.AllowedEmailDomains) == 0 {
+		return nil
+	}
+
+	domain := email[strings.LastIndex(email, "@")+1:]
+	for _, allowed := range config.AllowedEmailDomains {
+		if strings.EqualFold(domain, allowed) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("email %q is not in one of the allowed email domains: %s", email, strings.Join(config.AllowedEmailDomains, ", "))
+}
+
 func buildUserStruct(d *schema.ResourceData) *pagerduty.User {
 	user := &pagerduty.User{
 		Name:  strings.TrimSpace(d.Get("name").(string)),
@@ -211,6 +211,19 @@ func resourcePagerDutyUserContactMethodImport(d *schema.ResourceData, meta inter
 
 	ids := strings.Split(d.Id(), ":")
 
+	if len(ids) == 1 {
+		resp, _, err := client.Users.ListContactMethods(ids[0])
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		var childIDs []string
+		for _, cm := range resp.ContactMethods {
+			childIDs = append(childIDs, fmt.Sprintf("%s:%s", ids[0], cm.ID))
+		}
+		return []*schema.ResourceData{}, importByParentIDError("pagerduty_user_contact_method", ids[0], childIDs)
+	}
+
 	if len(ids) != 2 {
 		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_user_contact_method. Expecting an ID formed as '<user_id>:<contact_method_id>'")
 	}
@@ -175,6 +175,19 @@ func resourcePagerDutyUserNotificationRuleImport(d *schema.ResourceData, meta in
 
 	ids := strings.Split(d.Id(), ":")
 
+	if len(ids) == 1 {
+		resp, _, err := client.Users.ListNotificationRules(ids[0])
+		if err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		var childIDs []string
+		for _, rule := range resp.NotificationRules {
+			childIDs = append(childIDs, fmt.Sprintf("%s:%s", ids[0], rule.ID))
+		}
+		return []*schema.ResourceData{}, importByParentIDError("pagerduty_user_notification_rule", ids[0], childIDs)
+	}
+
 	if len(ids) != 2 {
 		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_user_notification_rule. Expecting an ID formed as '<user_id>.<notification_rule_id>'")
 	}
@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -106,6 +107,29 @@ func TestAccPagerDutyUser_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyUser_AllowedEmailDomains(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyUserAllowedEmailDomainsConfig(username, fmt.Sprintf("%s@foo.tset", username)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not in one of the allowed email domains: foo.test"),
+			},
+			{
+				Config: testAccCheckPagerDutyUserAllowedEmailDomainsConfig(username, fmt.Sprintf("%s@FOO.test", username)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserExists("pagerduty_user.foo"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyUserWithTeams_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -191,6 +215,19 @@ func testAccCheckPagerDutyUserExists(n string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckPagerDutyUserAllowedEmailDomainsConfig(username, email string) string {
+	return fmt.Sprintf(`
+provider "pagerduty" {
+  allowed_email_domains = ["foo.test"]
+}
+
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+`, username, email)
+}
+
 func testAccCheckPagerDutyUserConfig(username, email string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -1,6 +1,8 @@
 package pagerduty
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -16,12 +18,20 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 		Update: resourcePagerDutyWebhookSubscriptionUpdate,
 		Delete: resourcePagerDutyWebhookSubscriptionDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourcePagerDutyWebhookSubscriptionImport,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			filterType := d.Get("filter.0.type").(string)
+			if filterType != "" && filterType != "account_reference" && d.NewValueKnown("filter.0.id") && d.Get("filter.0.id").(string) == "" {
+				return fmt.Errorf("filter.0.id must be set for the filter type %s", filterType)
+			}
+			return nil
 		},
 		Schema: map[string]*schema.Schema{
 			"delivery_method": {
 				Type:     schema.TypeList,
 				Required: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"temporarily_disabled": {
@@ -52,16 +62,9 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 									},
 
 									"value": {
-										Type:     schema.TypeString,
-										Required: true,
-										ForceNew: true,
-										// Suppress the diff shown if the base_image name are equal when both compared in lower case.
-										DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-											if old == "-- redacted --" {
-												return true
-											}
-											return false
-										},
+										Type:      schema.TypeString,
+										Required:  true,
+										Sensitive: true,
 									},
 								},
 							},
@@ -82,6 +85,16 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"send_test_event": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -96,6 +109,7 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 			"filter": {
 				Type:     schema.TypeList,
 				Required: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -118,10 +132,14 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 	}
 }
 
+// webhookRedactedHeaderValue is returned by the API in place of the values
+// of the custom headers.
+const webhookRedactedHeaderValue = "-- redacted --"
+
 func buildWebhookSubscriptionStruct(d *schema.ResourceData) *pagerduty.WebhookSubscription {
 	webhook := pagerduty.WebhookSubscription{
 		Type:           d.Get("type").(string),
-		Active:         d.Get("active").(bool),
+		Active:         configuredBoolPtr(d, "active"),
 		Description:    d.Get("description").(string),
 		DeliveryMethod: expandDeliveryMethod(d.Get("delivery_method").(interface{})),
 		Events:         expandConfigList(d.Get("events").([]interface{})),
@@ -149,6 +167,8 @@ func resourcePagerDutyWebhookSubscriptionCreate(d *schema.ResourceData, meta int
 			return resource.NonRetryableError(err)
 		} else if webhook != nil {
 			d.SetId(webhook.ID)
+			// The secret is only returned when the subscription is created
+			d.Set("secret", webhook.DeliveryMethod.Secret)
 		}
 		return nil
 	})
@@ -157,6 +177,12 @@ func resourcePagerDutyWebhookSubscriptionCreate(d *schema.ResourceData, meta int
 		return retryErr
 	}
 
+	if d.Get("send_test_event").(bool) {
+		if err := sendWebhookSubscriptionTestEvent(d, meta); err != nil {
+			return err
+		}
+	}
+
 	return resourcePagerDutyWebhookSubscriptionRead(d, meta)
 
 }
@@ -171,8 +197,13 @@ func resourcePagerDutyWebhookSubscriptionRead(d *schema.ResourceData, meta inter
 
 	return resource.Retry(30*time.Second, func() *resource.RetryError {
 		if webhook, _, err := client.WebhookSubscriptions.Get(d.Id()); err != nil {
-			time.Sleep(2 * time.Second)
-			return resource.RetryableError(err)
+			errResp := handleNotFoundError(err, d)
+			if errResp != nil {
+				time.Sleep(2 * time.Second)
+				return resource.RetryableError(errResp)
+			}
+
+			return nil
 		} else if webhook != nil {
 			setWebhookResourceData(d, webhook)
 		}
@@ -195,6 +226,27 @@ func resourcePagerDutyWebhookSubscriptionUpdate(d *schema.ResourceData, meta int
 		setWebhookResourceData(d, webhook)
 	}
 
+	if d.Get("send_test_event").(bool) && d.HasChanges("delivery_method", "send_test_event") {
+		return sendWebhookSubscriptionTestEvent(d, meta)
+	}
+
+	return nil
+}
+
+// sendWebhookSubscriptionTestEvent sends a test event to the subscription.
+// It's delivered asynchronously, so only PagerDuty rejecting it is an error.
+func sendWebhookSubscriptionTestEvent(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Sending a test event to PagerDuty webhook subscription %s", d.Id())
+
+	if _, err := client.WebhookSubscriptions.Ping(d.Id()); err != nil {
+		return fmt.Errorf("error sending a test event to the webhook subscription %s: %w", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -206,7 +258,7 @@ func resourcePagerDutyWebhookSubscriptionDelete(d *schema.ResourceData, meta int
 
 	log.Printf("[INFO] Deleting PagerDuty webhook subscription %s", d.Id())
 
-	if _, err := client.WebhookSubscriptions.Delete(d.Id()); err != nil {
+	if _, err := client.WebhookSubscriptions.Delete(d.Id()); err != nil && !isErrCode(err, 404) {
 		return err
 	}
 
@@ -215,12 +267,21 @@ func resourcePagerDutyWebhookSubscriptionDelete(d *schema.ResourceData, meta int
 	return nil
 }
 
+func resourcePagerDutyWebhookSubscriptionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Imported subscriptions aren't sent a test event
+	d.Set("send_test_event", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func setWebhookResourceData(d *schema.ResourceData, webhook *pagerduty.WebhookSubscription) {
 	d.Set("type", webhook.Type)
-	d.Set("active", webhook.Active)
+	if webhook.Active != nil {
+		d.Set("active", *webhook.Active)
+	}
 	d.Set("description", webhook.Description)
 	d.Set("events", flattenConfigList(webhook.Events))
-	d.Set("delivery_method", flattenDeliveryMethod(webhook.DeliveryMethod))
+	d.Set("delivery_method", flattenDeliveryMethod(webhook.DeliveryMethod, d.Get("delivery_method.0.custom_header").([]interface{})))
 	d.Set("filter", flattenFilter(webhook.Filter))
 }
 
@@ -238,8 +299,10 @@ func expandDeliveryMethod(v interface{}) pagerduty.DeliveryMethod {
 		})
 	}
 
+	temporarilyDisabled := dmMap["temporarily_disabled"].(bool)
+
 	method = pagerduty.DeliveryMethod{
-		TemporarilyDisabled: dmMap["temporarily_disabled"].(bool),
+		TemporarilyDisabled: &temporarilyDisabled,
 		Type:                dmMap["type"].(string),
 		URL:                 dmMap["url"].(string),
 		CustomHeaders:       headers,
@@ -258,13 +321,18 @@ func expandFilter(v interface{}) pagerduty.Filter {
 	return filter
 }
 
-func flattenDeliveryMethod(method pagerduty.DeliveryMethod) []map[string]interface{} {
+func flattenDeliveryMethod(method pagerduty.DeliveryMethod, configuredHeaders []interface{}) []map[string]interface{} {
 	var methods []map[string]interface{}
+	var temporarilyDisabled bool
+	if method.TemporarilyDisabled != nil {
+		temporarilyDisabled = *method.TemporarilyDisabled
+	}
+
 	methodMap := map[string]interface{}{
-		"temporarily_disabled": method.TemporarilyDisabled,
+		"temporarily_disabled": temporarilyDisabled,
 		"type":                 method.Type,
 		"url":                  method.URL,
-		"custom_header":        flattenCustomHeader(method.CustomHeaders),
+		"custom_header":        flattenCustomHeader(method.CustomHeaders, configuredHeaders),
 	}
 	methods = append(methods, methodMap)
 	return methods
@@ -280,13 +348,26 @@ func flattenFilter(filter pagerduty.Filter) []map[string]interface{} {
 	return filters
 }
 
-func flattenCustomHeader(customHeaders []*pagerduty.CustomHeaders) []map[string]interface{} {
+// flattenCustomHeader keeps the configured values of the custom headers,
+// as the API redacts them.
+func flattenCustomHeader(customHeaders []*pagerduty.CustomHeaders, configuredHeaders []interface{}) []map[string]interface{} {
+	configured := make(map[string]string)
+	for _, raw := range configuredHeaders {
+		if h, ok := raw.(map[string]interface{}); ok {
+			configured[h["name"].(string)] = h["value"].(string)
+		}
+	}
+
 	var headers []map[string]interface{}
 
 	for _, ch := range customHeaders {
+		value := ch.Value
+		if v, ok := configured[ch.Name]; ok && value == webhookRedactedHeaderValue {
+			value = v
+		}
 		headerMap := map[string]interface{}{
 			"name":  ch.Name,
-			"value": ch.Value,
+			"value": value,
 		}
 		headers = append(headers, headerMap)
 	}
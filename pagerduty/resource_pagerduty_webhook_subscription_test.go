@@ -3,12 +3,14 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 func init() {
@@ -45,6 +47,25 @@ func testSweepWebhookSubscription(region string) error {
 
 	return nil
 }
+func TestFlattenCustomHeader(t *testing.T) {
+	headers := []*pagerduty.CustomHeaders{
+		{Name: "X-Foo", Value: webhookRedactedHeaderValue},
+		{Name: "X-Bar", Value: webhookRedactedHeaderValue},
+	}
+	configured := []interface{}{
+		map[string]interface{}{"name": "X-Foo", "value": "foo"},
+	}
+
+	flattened := flattenCustomHeader(headers, configured)
+
+	if flattened[0]["value"] != "foo" {
+		t.Errorf("expected the configured value of X-Foo to be kept, got %v", flattened[0]["value"])
+	}
+	if flattened[1]["value"] != webhookRedactedHeaderValue {
+		t.Errorf("expected the redacted value of X-Bar, got %v", flattened[1]["value"])
+	}
+}
+
 func TestAccPagerDutyWebhookSubscription_Basic(t *testing.T) {
 	description := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
@@ -65,12 +86,84 @@ func TestAccPagerDutyWebhookSubscription_Basic(t *testing.T) {
 						"pagerduty_webhook_subscription.foo", "description", description),
 					resource.TestCheckResourceAttr(
 						"pagerduty_webhook_subscription.foo", "events.#", "13"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "active", "true"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "delivery_method.0.custom_header.0.value", "foo"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_webhook_subscription.foo", "secret"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyWebhookSubscriptionConfigActive(username, email, escalationPolicy, service, description, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyWebhookSubscriptionExists("pagerduty_webhook_subscription.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "active", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyWebhookSubscription_SendTestEvent(t *testing.T) {
+	description := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyWebhookSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "pagerduty_webhook_subscription" "foo" {
+  delivery_method {
+    url = "https://example.com/receive_a_pagerduty_webhook"
+  }
+  description     = "%s"
+  events          = ["incident.triggered"]
+  send_test_event = true
+  filter {
+    type = "account_reference"
+  }
+}
+`, description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyWebhookSubscriptionExists("pagerduty_webhook_subscription.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "send_test_event", "true"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "delivery_method.0.temporarily_disabled", "false"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccPagerDutyWebhookSubscription_FilterWithoutID(t *testing.T) {
+	config := `
+resource "pagerduty_webhook_subscription" "foo" {
+  delivery_method {
+    url = "https://example.com/receive_a_pagerduty_webhook"
+  }
+  events = ["incident.triggered"]
+  filter {
+    type = "team_reference"
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("filter.0.id must be set for the filter type team_reference"),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyWebhookSubscriptionDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -107,6 +200,10 @@ func testAccCheckPagerDutyWebhookSubscriptionExists(n string) resource.TestCheck
 }
 
 func testAccCheckPagerDutyWebhookSubscriptionConfig(username, useremail, escalationPolicy, service, description string) string {
+	return testAccCheckPagerDutyWebhookSubscriptionConfigActive(username, useremail, escalationPolicy, service, description, true)
+}
+
+func testAccCheckPagerDutyWebhookSubscriptionConfigActive(username, useremail, escalationPolicy, service, description string, active bool) string {
 	return fmt.Sprintf(`
 	resource "pagerduty_user" "foo" {
 		name        = "%s"
@@ -166,12 +263,12 @@ func testAccCheckPagerDutyWebhookSubscriptionConfig(username, useremail, escalat
             "incident.triggered",
             "incident.unacknowledged"
 		]
-		active = true
+		active = %t
 		filter {
 			id = pagerduty_service.foo.id
 			type = "service_reference"
 		}
 		type = "webhook_subscription"
 	}
-	`, username, useremail, escalationPolicy, service, description)
+	`, username, useremail, escalationPolicy, service, description, active)
 }
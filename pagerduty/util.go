@@ -35,6 +35,14 @@ func validateRFC3339(v interface{}, k string) (we []string, errors []error) {
 	return
 }
 
+// validateTimeZone validates that a time zone is a valid IANA time zone name
+func validateTimeZone(v interface{}, k string) (we []string, errors []error) {
+	if _, err := time.LoadLocation(v.(string)); err != nil {
+		errors = append(errors, err)
+	}
+	return
+}
+
 func suppressRFC3339Diff(k, oldTime, newTime string, d *schema.ResourceData) bool {
 	oldT, newT, err := parseRFC3339Time(k, oldTime, newTime)
 	if err != nil {
@@ -148,6 +156,19 @@ func stringPtrToStringType(v *string) string {
 	return *v
 }
 
+// configuredBoolPtr returns a pointer to the value of a top-level boolean
+// argument only when it's in the configuration, so that the API defaults apply
+// to the optional and computed arguments left out.
+func configuredBoolPtr(d *schema.ResourceData, key string) *bool {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || raw.GetAttr(key).IsNull() {
+		return nil
+	}
+
+	val := d.Get(key).(bool)
+	return &val
+}
+
 func intTypeToIntPtr(v int) *int {
 	if v == 0 {
 		return nil
@@ -192,3 +213,21 @@ func resourcePagerDutyParseColonCompoundID(id string) (string, string) {
 	parts := strings.Split(id, ":")
 	return parts[0], parts[1]
 }
+
+// importByParentIDError guides the import of "parent:child" resources when the
+// import ID only holds the parent ID. Terraform binds a single object to each
+// resource address, so rather than importing every child of the parent at
+// once, the import command of each of them is listed.
+func importByParentIDError(resourceType, parentID string, childIDs []string) error {
+	if len(childIDs) == 0 {
+		return fmt.Errorf("Error importing %s: %s has nothing to import", resourceType, parentID)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Error importing %s: %s is a parent ID and a single object can be imported per resource address. Import each of the %d objects found with:\n", resourceType, parentID, len(childIDs))
+	for i, id := range childIDs {
+		fmt.Fprintf(&b, "\n  terraform import %s.<name_%d> %s", resourceType, i+1, id)
+	}
+
+	return fmt.Errorf("%s", b.String())
+}
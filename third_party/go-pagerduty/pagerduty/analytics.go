@@ -0,0 +1,80 @@
+package pagerduty
+
+// AnalyticsService handles the communication with analytics related methods
+// of the PagerDuty API.
+type AnalyticsService service
+
+// AnalyticsRequest represents the body of an aggregated analytics request.
+type AnalyticsRequest struct {
+	Filters       *AnalyticsFilter `json:"filters,omitempty"`
+	AggregateUnit string           `json:"aggregate_unit,omitempty"`
+	TimeZone      string           `json:"time_zone,omitempty"`
+}
+
+// AnalyticsFilter represents the set of filters applied to an analytics request.
+type AnalyticsFilter struct {
+	CreatedAtStart string   `json:"created_at_start,omitempty"`
+	CreatedAtEnd   string   `json:"created_at_end,omitempty"`
+	Urgency        string   `json:"urgency,omitempty"`
+	Major          bool     `json:"major,omitempty"`
+	ServiceIDs     []string `json:"service_ids,omitempty"`
+	TeamIDs        []string `json:"team_ids,omitempty"`
+	PriorityIDs    []string `json:"priority_ids,omitempty"`
+	PriorityNames  []string `json:"priority_names,omitempty"`
+}
+
+// AnalyticsResponse represents an aggregated analytics response.
+type AnalyticsResponse struct {
+	Data          []*AnalyticsData `json:"data,omitempty"`
+	Filters       *AnalyticsFilter `json:"filters,omitempty"`
+	AggregateUnit string           `json:"aggregate_unit,omitempty"`
+	TimeZone      string           `json:"time_zone,omitempty"`
+}
+
+// AnalyticsData represents the aggregated metrics of a single entity.
+type AnalyticsData struct {
+	ServiceID                      string  `json:"service_id,omitempty"`
+	ServiceName                    string  `json:"service_name,omitempty"`
+	TeamID                         string  `json:"team_id,omitempty"`
+	TeamName                       string  `json:"team_name,omitempty"`
+	MeanSecondsToResolve           int     `json:"mean_seconds_to_resolve,omitempty"`
+	MeanSecondsToFirstAck          int     `json:"mean_seconds_to_first_ack,omitempty"`
+	MeanSecondsToEngage            int     `json:"mean_seconds_to_engage,omitempty"`
+	MeanSecondsToMobilize          int     `json:"mean_seconds_to_mobilize,omitempty"`
+	MeanEngagedSeconds             int     `json:"mean_engaged_seconds,omitempty"`
+	MeanEngagedUserCount           int     `json:"mean_engaged_user_count,omitempty"`
+	TotalEscalationCount           int     `json:"total_escalation_count,omitempty"`
+	MeanAssignmentCount            int     `json:"mean_assignment_count,omitempty"`
+	TotalBusinessHourInterruptions int     `json:"total_business_hour_interruptions,omitempty"`
+	TotalSleepHourInterruptions    int     `json:"total_sleep_hour_interruptions,omitempty"`
+	TotalOffHourInterruptions      int     `json:"total_off_hour_interruptions,omitempty"`
+	TotalSnoozedSeconds            int     `json:"total_snoozed_seconds,omitempty"`
+	TotalEngagedSeconds            int     `json:"total_engaged_seconds,omitempty"`
+	TotalIncidentCount             int     `json:"total_incident_count,omitempty"`
+	TotalIncidentsAcknowledged     int     `json:"total_incidents_acknowledged,omitempty"`
+	TotalIncidentsAutoResolved     int     `json:"total_incidents_auto_resolved,omitempty"`
+	TotalIncidentsManualEscalated  int     `json:"total_incidents_manual_escalated,omitempty"`
+	TotalIncidentsReassigned       int     `json:"total_incidents_reassigned,omitempty"`
+	TotalIncidentsTimeoutEscalated int     `json:"total_incidents_timeout_escalated,omitempty"`
+	TotalInterruptions             int     `json:"total_interruptions,omitempty"`
+	TotalNotifications             int     `json:"total_notifications,omitempty"`
+	UpTimePct                      float64 `json:"up_time_pct,omitempty"`
+	RangeStart                     string  `json:"range_start,omitempty"`
+}
+
+// GetAggregatedServiceData gets the aggregated incident metrics per service.
+func (s *AnalyticsService) GetAggregatedServiceData(analytics *AnalyticsRequest) (*AnalyticsResponse, *Response, error) {
+	u := "/analytics/metrics/incidents/services"
+	v := new(AnalyticsResponse)
+
+	resp, err := s.client.newRequestDoOptions("POST", u, nil, analytics, v, RequestOptions{
+		Type:  "header",
+		Label: "X-EARLY-ACCESS",
+		Value: "analytics-v2",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
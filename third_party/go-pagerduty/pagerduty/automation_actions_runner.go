@@ -0,0 +1,199 @@
+package pagerduty
+
+import "fmt"
+
+// AutomationActionsRunner handles the communication with schedule
+// related methods of the PagerDuty API.
+type AutomationActionsRunnerService service
+
+type AutomationActionsRunner struct {
+	ID             string                       `json:"id"`
+	Name           string                       `json:"name"`
+	Type           string                       `json:"type"`
+	RunnerType     string                       `json:"runner_type"`
+	CreationTime   string                       `json:"creation_time"`
+	LastSeenTime   *string                      `json:"last_seen,omitempty"`
+	Status         *string                      `json:"status,omitempty"`
+	Summary        string                       `json:"summary,omitempty"`
+	Description    *string                      `json:"description,omitempty"`
+	RunbookBaseUri *string                      `json:"runbook_base_uri,omitempty"`
+	RunbookApiKey  *string                      `json:"runbook_api_key,omitempty"`
+	Teams          []*TeamReference             `json:"teams,omitempty"`
+	Privileges     *AutomationActionsPrivileges `json:"privileges,omitempty"`
+}
+
+type AutomationActionsPrivileges struct {
+	Permissions []*string `json:"permissions,omitempty"`
+}
+
+type AutomationActionsRunnerPayload struct {
+	Runner *AutomationActionsRunner `json:"runner,omitempty"`
+}
+
+type AutomationActionsRunnerTeamAssociationPayload struct {
+	Team *TeamReference `json:"team,omitempty"`
+}
+
+// ListAutomationActionsRunnersOptions represents options when listing runners.
+type ListAutomationActionsRunnersOptions struct {
+	Cursor string `url:"cursor,omitempty"`
+	Limit  int    `url:"limit,omitempty"`
+	Name   string `url:"name,omitempty"`
+}
+
+// ListAutomationActionsRunnersResponse represents a list response of runners.
+type ListAutomationActionsRunnersResponse struct {
+	Runners    []*AutomationActionsRunner `json:"runners,omitempty"`
+	NextCursor string                     `json:"next_cursor,omitempty"`
+	Limit      int                        `json:"limit,omitempty"`
+}
+
+type listAutomationActionsRunnersOptionsGen struct {
+	options *ListAutomationActionsRunnersOptions
+}
+
+func (o *listAutomationActionsRunnersOptionsGen) currentCursor() string {
+	return o.options.Cursor
+}
+
+func (o *listAutomationActionsRunnersOptionsGen) changeCursor(s string) {
+	o.options.Cursor = s
+}
+
+func (o *listAutomationActionsRunnersOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+var automationActionsRunnerBaseUrl = "/automation_actions/runners"
+
+// List lists a page of runners.
+func (s *AutomationActionsRunnerService) List(o *ListAutomationActionsRunnersOptions) (*ListAutomationActionsRunnersResponse, *Response, error) {
+	u := automationActionsRunnerBaseUrl
+	v := new(ListAutomationActionsRunnersResponse)
+
+	if o == nil {
+		o = &ListAutomationActionsRunnersOptions{}
+	}
+
+	resp, err := s.client.newRequestDoOptions("GET", u, o, nil, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// ListAll lists all result pages for runners list.
+func (s *AutomationActionsRunnerService) ListAll(o *ListAutomationActionsRunnersOptions) ([]*AutomationActionsRunner, error) {
+	runners := make([]*AutomationActionsRunner, 0)
+
+	if o == nil {
+		o = &ListAutomationActionsRunnersOptions{}
+	}
+
+	responseHandler := func(response *Response) (CursorListResp, *Response, error) {
+		var result ListAutomationActionsRunnersResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return CursorListResp{}, response, err
+		}
+
+		runners = append(runners, result.Runners...)
+
+		return CursorListResp{
+			Limit:      result.Limit,
+			NextCursor: result.NextCursor,
+		}, response, nil
+	}
+	err := s.client.newRequestCursorPagedGetQueryDo(automationActionsRunnerBaseUrl, responseHandler, &listAutomationActionsRunnersOptionsGen{
+		options: o,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return runners, nil
+}
+
+// Create creates a new runner
+func (s *AutomationActionsRunnerService) Create(runner *AutomationActionsRunner) (*AutomationActionsRunner, *Response, error) {
+	u := automationActionsRunnerBaseUrl
+	v := new(AutomationActionsRunnerPayload)
+
+	resp, err := s.client.newRequestDoOptions("POST", u, nil, &AutomationActionsRunnerPayload{Runner: runner}, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Runner, resp, nil
+}
+
+// Get retrieves information about a runner.
+func (s *AutomationActionsRunnerService) Get(id string) (*AutomationActionsRunner, *Response, error) {
+	u := fmt.Sprintf("%s/%s", automationActionsRunnerBaseUrl, id)
+	v := new(AutomationActionsRunnerPayload)
+
+	resp, err := s.client.newRequestDoOptions("GET", u, nil, nil, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Runner, resp, nil
+}
+
+// Update an existing runner
+func (s *AutomationActionsRunnerService) Update(ID string, runner *AutomationActionsRunner) (*AutomationActionsRunner, *Response, error) {
+	u := fmt.Sprintf("%s/%s", automationActionsRunnerBaseUrl, ID)
+	v := new(AutomationActionsRunnerPayload)
+	p := &AutomationActionsRunnerPayload{Runner: runner}
+
+	resp, err := s.client.newRequestDo("PUT", u, nil, p, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Runner, resp, nil
+}
+
+// Delete deletes an existing runner.
+func (s *AutomationActionsRunnerService) Delete(id string) (*Response, error) {
+	u := fmt.Sprintf("%s/%s", automationActionsRunnerBaseUrl, id)
+
+	return s.client.newRequestDoOptions("DELETE", u, nil, nil, nil)
+}
+
+// Associate a runner with a team
+func (s *AutomationActionsRunnerService) AssociateToTeam(runnerID, teamID string) (*AutomationActionsRunnerTeamAssociationPayload, *Response, error) {
+	u := fmt.Sprintf("%s/%s/teams", automationActionsRunnerBaseUrl, runnerID)
+	v := new(AutomationActionsRunnerTeamAssociationPayload)
+	p := &AutomationActionsRunnerTeamAssociationPayload{
+		Team: &TeamReference{ID: teamID, Type: "team_reference"},
+	}
+
+	resp, err := s.client.newRequestDoOptions("POST", u, nil, p, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// Dissociate a runner from a team
+func (s *AutomationActionsRunnerService) DissociateFromTeam(runnerID, teamID string) (*Response, error) {
+	u := fmt.Sprintf("%s/%s/teams/%s", automationActionsRunnerBaseUrl, runnerID, teamID)
+
+	return s.client.newRequestDoOptions("DELETE", u, nil, nil, nil)
+}
+
+// Gets the details of a runner / team relation
+func (s *AutomationActionsRunnerService) GetAssociationToTeam(runnerID, teamID string) (*AutomationActionsRunnerTeamAssociationPayload, *Response, error) {
+	u := fmt.Sprintf("%s/%s/teams/%s", automationActionsRunnerBaseUrl, runnerID, teamID)
+	v := new(AutomationActionsRunnerTeamAssociationPayload)
+
+	resp, err := s.client.newRequestDoOptions("GET", u, nil, nil, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
@@ -0,0 +1,45 @@
+package pagerduty
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNoToken is returned by NewClient if a user
+	// passed an empty/missing token.
+	ErrNoToken = errors.New("an empty token was provided")
+
+	// ErrAuthFailure is returned by NewClient if a user
+	// passed an invalid token and failed validation against the PagerDuty API.
+	ErrAuthFailure = errors.New("failed to authenticate using the provided token")
+)
+
+type errorResponse struct {
+	Error *Error `json:"error"`
+}
+
+// Error represents an error response from the PagerDuty API.
+type Error struct {
+	ErrorResponse *Response
+	Code          int         `json:"code,omitempty"`
+	Errors        interface{} `json:"errors,omitempty"`
+	Message       string      `json:"message,omitempty"`
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("%s API call to %s failed %v. Code: %d, Errors: %v, Message: %s", e.ErrorResponse.Response.Request.Method, e.ErrorResponse.Response.Request.URL.String(), e.ErrorResponse.Response.Status, e.Code, e.Errors, e.Message)
+	if id := e.RequestID(); id != "" {
+		msg = fmt.Sprintf("%s, Request ID: %s", msg, id)
+	}
+	return msg
+}
+
+// RequestID returns the ID PagerDuty assigned to the failed request, which
+// can be used to correlate the failure with PagerDuty support.
+func (e *Error) RequestID() string {
+	if e.ErrorResponse == nil || e.ErrorResponse.Response == nil {
+		return ""
+	}
+	return e.ErrorResponse.Response.Header.Get("X-Request-Id")
+}
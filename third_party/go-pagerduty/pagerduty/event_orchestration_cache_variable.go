@@ -0,0 +1,126 @@
+package pagerduty
+
+import (
+	"fmt"
+)
+
+type EventOrchestrationCacheVariableService service
+
+// Cache variables store event data on an orchestration, to be used in the
+// conditions and actions of its rules. Global orchestrations and service
+// orchestrations each have their own cache variables.
+const (
+	CacheVariableTypeGlobal  string = "global"
+	CacheVariableTypeService string = "service"
+)
+
+type EventOrchestrationCacheVariableCondition struct {
+	// A PCL string: https://developer.pagerduty.com/docs/ZG9jOjM1NTE0MDc0-pcl-overview
+	Expression string `json:"expression,omitempty"`
+}
+
+// Configuration for a cache variable changes depending on the type:
+// - recent_value stores the value extracted by regex from the source of the
+// most recent event
+// - trigger_event_count counts the trigger events received within ttl_seconds
+// - external_data holds a value of data_type set through the API, for
+// ttl_seconds
+type EventOrchestrationCacheVariableConfiguration struct {
+	Type       string `json:"type,omitempty"`
+	Regex      string `json:"regex,omitempty"`
+	Source     string `json:"source,omitempty"`
+	TTLSeconds int    `json:"ttl_seconds,omitempty"`
+	DataType   string `json:"data_type,omitempty"`
+}
+
+type EventOrchestrationCacheVariable struct {
+	ID            string                                        `json:"id,omitempty"`
+	Name          string                                        `json:"name,omitempty"`
+	Disabled      bool                                          `json:"disabled"`
+	Conditions    []*EventOrchestrationCacheVariableCondition   `json:"conditions"`
+	Configuration *EventOrchestrationCacheVariableConfiguration `json:"configuration,omitempty"`
+	CreatedAt     string                                        `json:"created_at,omitempty"`
+	CreatedBy     *EventOrchestrationPathReference              `json:"created_by,omitempty"`
+	UpdatedAt     string                                        `json:"updated_at,omitempty"`
+	UpdatedBy     *EventOrchestrationPathReference              `json:"updated_by,omitempty"`
+}
+
+type EventOrchestrationCacheVariablePayload struct {
+	CacheVariable *EventOrchestrationCacheVariable `json:"cache_variable,omitempty"`
+}
+
+type ListEventOrchestrationCacheVariablesResponse struct {
+	Total          int                                `json:"total,omitempty"`
+	CacheVariables []*EventOrchestrationCacheVariable `json:"cache_variables,omitempty"`
+}
+
+func cacheVariableUrlBuilder(cacheVariableType, orchestrationID string) string {
+	switch cacheVariableType {
+	case CacheVariableTypeGlobal:
+		return fmt.Sprintf("%s/%s/cache_variables", eventOrchestrationBaseUrl, orchestrationID)
+	case CacheVariableTypeService:
+		return fmt.Sprintf("%s/services/%s/cache_variables", eventOrchestrationBaseUrl, orchestrationID)
+	default:
+		return ""
+	}
+}
+
+// List lists the cache variables of a global or service orchestration.
+func (s *EventOrchestrationCacheVariableService) List(cacheVariableType, orchestrationID string) (*ListEventOrchestrationCacheVariablesResponse, *Response, error) {
+	u := cacheVariableUrlBuilder(cacheVariableType, orchestrationID)
+	v := new(ListEventOrchestrationCacheVariablesResponse)
+
+	resp, err := s.client.newRequestDo("GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// Create creates a cache variable on a global or service orchestration.
+func (s *EventOrchestrationCacheVariableService) Create(cacheVariableType, orchestrationID string, cacheVariable *EventOrchestrationCacheVariable) (*EventOrchestrationCacheVariable, *Response, error) {
+	u := cacheVariableUrlBuilder(cacheVariableType, orchestrationID)
+	v := new(EventOrchestrationCacheVariablePayload)
+	p := &EventOrchestrationCacheVariablePayload{CacheVariable: cacheVariable}
+
+	resp, err := s.client.newRequestDo("POST", u, nil, p, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.CacheVariable, resp, nil
+}
+
+// Get gets a cache variable of a global or service orchestration.
+func (s *EventOrchestrationCacheVariableService) Get(cacheVariableType, orchestrationID, id string) (*EventOrchestrationCacheVariable, *Response, error) {
+	u := fmt.Sprintf("%s/%s", cacheVariableUrlBuilder(cacheVariableType, orchestrationID), id)
+	v := new(EventOrchestrationCacheVariablePayload)
+
+	resp, err := s.client.newRequestDo("GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.CacheVariable, resp, nil
+}
+
+// Update updates a cache variable of a global or service orchestration.
+func (s *EventOrchestrationCacheVariableService) Update(cacheVariableType, orchestrationID, id string, cacheVariable *EventOrchestrationCacheVariable) (*EventOrchestrationCacheVariable, *Response, error) {
+	u := fmt.Sprintf("%s/%s", cacheVariableUrlBuilder(cacheVariableType, orchestrationID), id)
+	v := new(EventOrchestrationCacheVariablePayload)
+	p := &EventOrchestrationCacheVariablePayload{CacheVariable: cacheVariable}
+
+	resp, err := s.client.newRequestDo("PUT", u, nil, p, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.CacheVariable, resp, nil
+}
+
+// Delete deletes a cache variable of a global or service orchestration.
+func (s *EventOrchestrationCacheVariableService) Delete(cacheVariableType, orchestrationID, id string) (*Response, error) {
+	u := fmt.Sprintf("%s/%s", cacheVariableUrlBuilder(cacheVariableType, orchestrationID), id)
+	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+}
@@ -0,0 +1,104 @@
+package pagerduty
+
+import (
+	"fmt"
+)
+
+type EventOrchestrationIntegrationPayload struct {
+	Integration *EventOrchestrationIntegration `json:"integration,omitempty"`
+}
+
+type ListEventOrchestrationIntegrationsResponse struct {
+	Total        int                              `json:"total,omitempty"`
+	Integrations []*EventOrchestrationIntegration `json:"integrations,omitempty"`
+}
+
+// EventOrchestrationIntegrationMigration moves an integration, and its routing
+// key, from another Event Orchestration to the one it is sent to.
+type EventOrchestrationIntegrationMigration struct {
+	SourceType    string `json:"source_type,omitempty"`
+	SourceID      string `json:"source_id,omitempty"`
+	IntegrationID string `json:"integration_id,omitempty"`
+}
+
+func eventOrchestrationIntegrationsUrl(orchestrationID string) string {
+	return fmt.Sprintf("%s/%s/integrations", eventOrchestrationBaseUrl, orchestrationID)
+}
+
+// ListIntegrations lists the integrations of an Event Orchestration.
+func (s *EventOrchestrationService) ListIntegrations(orchestrationID string) (*ListEventOrchestrationIntegrationsResponse, *Response, error) {
+	v := new(ListEventOrchestrationIntegrationsResponse)
+
+	resp, err := s.client.newRequestDo("GET", eventOrchestrationIntegrationsUrl(orchestrationID), nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// CreateIntegration creates a new integration, with its own routing key, on an
+// Event Orchestration.
+func (s *EventOrchestrationService) CreateIntegration(orchestrationID string, integration *EventOrchestrationIntegration) (*EventOrchestrationIntegration, *Response, error) {
+	v := new(EventOrchestrationIntegrationPayload)
+	p := &EventOrchestrationIntegrationPayload{Integration: integration}
+
+	resp, err := s.client.newRequestDo("POST", eventOrchestrationIntegrationsUrl(orchestrationID), nil, p, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Integration, resp, nil
+}
+
+// GetIntegration gets an integration of an Event Orchestration.
+func (s *EventOrchestrationService) GetIntegration(orchestrationID, id string) (*EventOrchestrationIntegration, *Response, error) {
+	u := fmt.Sprintf("%s/%s", eventOrchestrationIntegrationsUrl(orchestrationID), id)
+	v := new(EventOrchestrationIntegrationPayload)
+
+	resp, err := s.client.newRequestDo("GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Integration, resp, nil
+}
+
+// UpdateIntegration updates an integration of an Event Orchestration.
+func (s *EventOrchestrationService) UpdateIntegration(orchestrationID, id string, integration *EventOrchestrationIntegration) (*EventOrchestrationIntegration, *Response, error) {
+	u := fmt.Sprintf("%s/%s", eventOrchestrationIntegrationsUrl(orchestrationID), id)
+	v := new(EventOrchestrationIntegrationPayload)
+	p := &EventOrchestrationIntegrationPayload{Integration: integration}
+
+	resp, err := s.client.newRequestDo("PUT", u, nil, p, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Integration, resp, nil
+}
+
+// DeleteIntegration deletes an integration of an Event Orchestration.
+func (s *EventOrchestrationService) DeleteIntegration(orchestrationID, id string) (*Response, error) {
+	u := fmt.Sprintf("%s/%s", eventOrchestrationIntegrationsUrl(orchestrationID), id)
+	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+}
+
+// MigrateIntegration moves an integration from the sourceID Event
+// Orchestration to the orchestrationID one, keeping its ID and routing key.
+func (s *EventOrchestrationService) MigrateIntegration(orchestrationID, sourceID, id string) (*ListEventOrchestrationIntegrationsResponse, *Response, error) {
+	u := fmt.Sprintf("%s/migration", eventOrchestrationIntegrationsUrl(orchestrationID))
+	v := new(ListEventOrchestrationIntegrationsResponse)
+	p := &EventOrchestrationIntegrationMigration{
+		SourceType:    "event_orchestration",
+		SourceID:      sourceID,
+		IntegrationID: id,
+	}
+
+	resp, err := s.client.newRequestDo("POST", u, nil, p, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
@@ -0,0 +1,242 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type EventOrchestrationPathService service
+
+type EventOrchestrationPath struct {
+	Type      string                           `json:"type,omitempty"`
+	Self      string                           `json:"self,omitempty"`
+	Parent    *EventOrchestrationPathReference `json:"parent,omitempty"`
+	Sets      []*EventOrchestrationPathSet     `json:"sets,omitempty"`
+	CatchAll  *EventOrchestrationPathCatchAll  `json:"catch_all,omitempty"`
+	CreatedAt string                           `json:"created_at,omitempty"`
+	CreatedBy *EventOrchestrationPathReference `json:"created_by,omitempty"`
+	UpdatedAt string                           `json:"updated_at,omitempty"`
+	UpdatedBy *EventOrchestrationPathReference `json:"updated_by,omitempty"`
+	Version   string                           `json:"version,omitempty"`
+}
+
+// A reference to a related object (e.g. an EventOrchestration, User, Team, etc)
+type EventOrchestrationPathReference struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+	Self string `json:"self,omitempty"`
+}
+
+type EventOrchestrationPathSet struct {
+	ID    string                        `json:"id,omitempty"`
+	Rules []*EventOrchestrationPathRule `json:"rules"`
+}
+
+type EventOrchestrationPathRule struct {
+	ID         string                                 `json:"id,omitempty"`
+	Label      string                                 `json:"label,omitempty"`
+	Conditions []*EventOrchestrationPathRuleCondition `json:"conditions"`
+	Actions    *EventOrchestrationPathRuleActions     `json:"actions,omitempty"`
+	Disabled   bool                                   `json:"disabled,omitempty"`
+}
+
+type EventOrchestrationPathRuleCondition struct {
+	// A PCL string: https://developer.pagerduty.com/docs/ZG9jOjM1NTE0MDc0-pcl-overview
+	Expression string `json:"expression,omitempty"`
+}
+
+// See the full list of supported actions for path types:
+// Router: https://developer.pagerduty.com/api-reference/f0fae270c70b3-get-the-router-for-a-global-event-orchestration
+// Service: https://developer.pagerduty.com/api-reference/179537b835e2d-get-the-service-orchestration-for-a-service
+// Unrouted: https://developer.pagerduty.com/api-reference/70aa1139e1013-get-the-unrouted-orchestration-for-a-global-event-orchestration
+// Global: https://developer.pagerduty.com/api-reference/b0fd4ba5e4e71-get-the-global-orchestration-for-an-event-orchestration
+type EventOrchestrationPathRuleActions struct {
+	DropEvent                  bool                                               `json:"drop_event,omitempty"`
+	DynamicRouteTo             *EventOrchestrationPathDynamicRouteTo              `json:"dynamic_route_to,omitempty"`
+	RouteTo                    string                                             `json:"route_to"`
+	Suppress                   bool                                               `json:"suppress"`
+	Suspend                    *int                                               `json:"suspend"`
+	Priority                   string                                             `json:"priority"`
+	EscalationPolicy           string                                             `json:"escalation_policy,omitempty"`
+	Annotate                   string                                             `json:"annotate"`
+	PagerdutyAutomationActions []*EventOrchestrationPathPagerdutyAutomationAction `json:"pagerduty_automation_actions"`
+	AutomationActions          []*EventOrchestrationPathAutomationAction          `json:"automation_actions"`
+	Severity                   string                                             `json:"severity"`
+	EventAction                string                                             `json:"event_action"`
+	Variables                  []*EventOrchestrationPathActionVariables           `json:"variables"`
+	Extractions                []*EventOrchestrationPathActionExtractions         `json:"extractions"`
+	IncidentCustomFieldUpdates []*EventOrchestrationPathIncidentCustomFieldUpdate `json:"incident_custom_field_updates,omitempty"`
+}
+
+// EventOrchestrationPathIncidentCustomFieldUpdate sets the value of a custom
+// field on the resulting incident, only supported by global and service
+// orchestrations.
+type EventOrchestrationPathIncidentCustomFieldUpdate struct {
+	ID    string `json:"id,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// EventOrchestrationPathDynamicRouteTo routes events to the service whose
+// name or ID is extracted from the event, only supported on the first rule of
+// a router.
+type EventOrchestrationPathDynamicRouteTo struct {
+	LookupBy string `json:"lookup_by,omitempty"`
+	Regex    string `json:"regex,omitempty"`
+	Source   string `json:"source,omitempty"`
+}
+
+type EventOrchestrationPathPagerdutyAutomationAction struct {
+	ActionId string `json:"action_id,omitempty"`
+}
+
+type EventOrchestrationPathAutomationAction struct {
+	Name       string                                          `json:"name,omitempty"`
+	Url        string                                          `json:"url,omitempty"`
+	AutoSend   bool                                            `json:"auto_send,omitempty"`
+	Headers    []*EventOrchestrationPathAutomationActionObject `json:"headers"`
+	Parameters []*EventOrchestrationPathAutomationActionObject `json:"parameters"`
+}
+
+type EventOrchestrationPathAutomationActionObject struct {
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+type EventOrchestrationPathActionVariables struct {
+	Name  string `json:"name,omitempty"`
+	Path  string `json:"path,omitempty"`
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+type EventOrchestrationPathActionExtractions struct {
+	Target   string `json:"target,omitempty"`
+	Regex    string `json:"regex,omitempty"`
+	Template string `json:"template,omitempty"`
+	Source   string `json:"source,omitempty"`
+}
+
+type EventOrchestrationPathCatchAll struct {
+	Actions *EventOrchestrationPathRuleActions `json:"actions,omitempty"`
+}
+
+type EventOrchestrationPathPayload struct {
+	OrchestrationPath *EventOrchestrationPath `json:"orchestration_path,omitempty"`
+}
+
+const PathTypeRouter string = "router"
+const PathTypeService string = "service"
+const PathTypeUnrouted string = "unrouted"
+const PathTypeGlobal string = "global"
+
+func orchestrationPathUrlBuilder(id string, pathType string) string {
+	switch {
+	case pathType == PathTypeService:
+		return fmt.Sprintf("%s/services/%s", eventOrchestrationBaseUrl, id)
+	case pathType == PathTypeUnrouted:
+		return fmt.Sprintf("%s/%s/unrouted", eventOrchestrationBaseUrl, id)
+	case pathType == PathTypeRouter:
+		return fmt.Sprintf("%s/%s/router", eventOrchestrationBaseUrl, id)
+	case pathType == PathTypeGlobal:
+		return fmt.Sprintf("%s/%s/global", eventOrchestrationBaseUrl, id)
+	default:
+		return ""
+	}
+}
+
+// Get for EventOrchestrationPath
+func (s *EventOrchestrationPathService) Get(id string, pathType string) (*EventOrchestrationPath, *Response, error) {
+	u := orchestrationPathUrlBuilder(id, pathType)
+	v := new(EventOrchestrationPathPayload)
+
+	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.OrchestrationPath, resp, nil
+}
+
+// Update for EventOrchestrationPath
+func (s *EventOrchestrationPathService) Update(id string, pathType string, orchestration_path *EventOrchestrationPath) (*EventOrchestrationPath, *Response, error) {
+	u := orchestrationPathUrlBuilder(id, pathType)
+	v := new(EventOrchestrationPathPayload)
+	p := EventOrchestrationPathPayload{OrchestrationPath: orchestration_path}
+
+	resp, err := s.client.newRequestDo("PUT", u, nil, p, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.OrchestrationPath, resp, nil
+}
+
+// EventOrchestrationPathServiceActiveStatus tells whether events sent to a
+// service are evaluated by its service orchestration or by its event rules.
+type EventOrchestrationPathServiceActiveStatus struct {
+	Active bool `json:"active"`
+}
+
+// GetServiceActiveStatus gets whether the service orchestration of a service
+// is active.
+func (s *EventOrchestrationPathService) GetServiceActiveStatus(id string) (*EventOrchestrationPathServiceActiveStatus, *Response, error) {
+	u := fmt.Sprintf("%s/active", orchestrationPathUrlBuilder(id, PathTypeService))
+	v := new(EventOrchestrationPathServiceActiveStatus)
+
+	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// UpdateServiceActiveStatus activates or deactivates the service orchestration
+// of a service.
+func (s *EventOrchestrationPathService) UpdateServiceActiveStatus(id string, isActive bool) (*EventOrchestrationPathServiceActiveStatus, *Response, error) {
+	u := fmt.Sprintf("%s/active", orchestrationPathUrlBuilder(id, PathTypeService))
+	v := new(EventOrchestrationPathServiceActiveStatus)
+	p := &EventOrchestrationPathServiceActiveStatus{Active: isActive}
+
+	resp, err := s.client.newRequestDo("PUT", u, nil, p, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// EventOrchestrationPathRawPayload holds an orchestration path as the JSON
+// document sent and returned by the API, including fields not yet modelled by
+// EventOrchestrationPath.
+type EventOrchestrationPathRawPayload struct {
+	OrchestrationPath json.RawMessage `json:"orchestration_path,omitempty"`
+}
+
+// GetRaw gets an orchestration path as a JSON document.
+func (s *EventOrchestrationPathService) GetRaw(id string, pathType string) (json.RawMessage, *Response, error) {
+	u := orchestrationPathUrlBuilder(id, pathType)
+	v := new(EventOrchestrationPathRawPayload)
+
+	resp, err := s.client.newRequestDo("GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.OrchestrationPath, resp, nil
+}
+
+// UpdateRaw updates an orchestration path from a JSON document.
+func (s *EventOrchestrationPathService) UpdateRaw(id string, pathType string, orchestrationPath json.RawMessage) (json.RawMessage, *Response, error) {
+	u := orchestrationPathUrlBuilder(id, pathType)
+	v := new(EventOrchestrationPathRawPayload)
+	p := &EventOrchestrationPathRawPayload{OrchestrationPath: orchestrationPath}
+
+	resp, err := s.client.newRequestDo("PUT", u, nil, p, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.OrchestrationPath, resp, nil
+}
@@ -0,0 +1,129 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// ExtensionSchemaService handles the communication with extension schemas related methods
+// of the PagerDuty API.
+type ExtensionSchemaService service
+
+// ExtensionSchema represents an extension schema.
+type ExtensionSchema struct {
+	Description string   `json:"description,omitempty"`
+	GuideURL    string   `json:"guide_url,omitempty"`
+	HTMLURL     string   `json:"html_url,omitempty"`
+	IconURL     string   `json:"icon_url,omitempty"`
+	ID          string   `json:"id,omitempty"`
+	Key         string   `json:"key,omitempty"`
+	Label       string   `json:"label,omitempty"`
+	LogoURL     string   `json:"logo_url,omitempty"`
+	Self        string   `json:"self,omitempty"`
+	SendTypes   []string `json:"send_types,omitempty"`
+	Summary     string   `json:"summary,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	URL         string   `json:"url,omitempty"`
+}
+
+// ListExtensionSchemasResponse represents a list response of extension schemas.
+type ListExtensionSchemasResponse struct {
+	ExtensionSchemas []*ExtensionSchema `json:"extension_schemas,omitempty"`
+	Limit            int                `json:"limit,omitempty"`
+	More             bool               `json:"more,omitempty"`
+	Offset           int                `json:"offset,omitempty"`
+	Total            int                `json:"total,omitempty"`
+}
+
+// ListExtensionSchemasOptions represents options when listing extension schemas.
+type ListExtensionSchemasOptions struct {
+	Limit  int    `url:"limit,omitempty"`
+	Offset int    `url:"offset,omitempty"`
+	Total  int    `url:"total,omitempty"`
+	Query  string `url:"query,omitempty"`
+}
+
+// ExtensionSchemaPayload represents an extension schema.
+type ExtensionSchemaPayload struct {
+	ExtensionSchema *ExtensionSchema `json:"extension_schema"`
+}
+
+type listExtensionSchemasOptionsGen struct {
+	options *ListExtensionSchemasOptions
+}
+
+func (o *listExtensionSchemasOptionsGen) currentOffset() int {
+	return o.options.Offset
+}
+
+func (o *listExtensionSchemasOptionsGen) changeOffset(i int) {
+	o.options.Offset = i
+}
+
+func (o *listExtensionSchemasOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// List lists extension schemas. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, the entire list of extension schemas will be returned.
+func (s *ExtensionSchemaService) List(o *ListExtensionSchemasOptions) (*ListExtensionSchemasResponse, *Response, error) {
+	u := "/extension_schemas"
+	v := new(ListExtensionSchemasResponse)
+
+	if o == nil {
+		o = &ListExtensionSchemasOptions{}
+	}
+
+	if o.Limit != 0 {
+		resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return v, resp, nil
+	}
+
+	extensionSchemas := make([]*ExtensionSchema, 0)
+
+	// Create a handler closure capable of parsing data from the extension schemas endpoint
+	// and appending resultant extension schemas to the return slice.
+	responseHandler := func(response *Response) (ListResp, *Response, error) {
+		var result ListExtensionSchemasResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return ListResp{}, response, err
+		}
+
+		extensionSchemas = append(extensionSchemas, result.ExtensionSchemas...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return ListResp{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+	err := s.client.newRequestPagedGetQueryDoContext(context.Background(), u, responseHandler, &listExtensionSchemasOptionsGen{
+		options: o,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.ExtensionSchemas = extensionSchemas
+
+	return v, nil, nil
+}
+
+// Get retrieves information about an extension schema.
+func (s *ExtensionSchemaService) Get(id string) (*ExtensionSchema, *Response, error) {
+	u := fmt.Sprintf("/extension_schemas/%s", id)
+	v := new(ExtensionSchemaPayload)
+
+	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.ExtensionSchema, resp, nil
+}
@@ -0,0 +1,240 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// IncidentCustomFieldService handles the communication with incident custom field
+// related methods of the PagerDuty API.
+type IncidentCustomFieldService service
+
+// IncidentCustomField represents an incident custom field.
+type IncidentCustomField struct {
+	ID           string                       `json:"id,omitempty"`
+	Type         string                       `json:"type,omitempty"`
+	Name         string                       `json:"name,omitempty"`
+	DisplayName  string                       `json:"display_name,omitempty"`
+	Description  *string                      `json:"description,omitempty"`
+	DataType     string                       `json:"data_type,omitempty"`
+	FieldType    string                       `json:"field_type,omitempty"`
+	DefaultValue interface{}                  `json:"default_value,omitempty"`
+	FieldOptions []*IncidentCustomFieldOption `json:"field_options,omitempty"`
+	Enabled      *bool                        `json:"enabled,omitempty"`
+	Self         string                       `json:"self,omitempty"`
+	Summary      string                       `json:"summary,omitempty"`
+}
+
+// IncidentCustomFieldOption represents an allowed value of a fixed-option incident custom field.
+type IncidentCustomFieldOption struct {
+	ID   string                         `json:"id,omitempty"`
+	Type string                         `json:"type,omitempty"`
+	Data *IncidentCustomFieldOptionData `json:"data,omitempty"`
+}
+
+// IncidentCustomFieldOptionData represents the value of an incident custom field option.
+type IncidentCustomFieldOptionData struct {
+	DataType string `json:"data_type,omitempty"`
+	Value    string `json:"value,omitempty"`
+}
+
+// Data types of incident custom fields.
+const (
+	IncidentCustomFieldDataTypeBool     = "boolean"
+	IncidentCustomFieldDataTypeInt      = "integer"
+	IncidentCustomFieldDataTypeFloat    = "float"
+	IncidentCustomFieldDataTypeString   = "string"
+	IncidentCustomFieldDataTypeDateTime = "datetime"
+	IncidentCustomFieldDataTypeURL      = "url"
+)
+
+// Field types of incident custom fields.
+const (
+	IncidentCustomFieldFieldTypeSingleValue      = "single_value"
+	IncidentCustomFieldFieldTypeSingleValueFixed = "single_value_fixed"
+	IncidentCustomFieldFieldTypeMultiValue       = "multi_value"
+	IncidentCustomFieldFieldTypeMultiValueFixed  = "multi_value_fixed"
+)
+
+// ListIncidentCustomFieldResponse represents a list response of incident custom fields.
+type ListIncidentCustomFieldResponse struct {
+	Fields []*IncidentCustomField `json:"fields,omitempty"`
+}
+
+// ListIncidentCustomFieldOptions represents options when listing incident custom fields.
+type ListIncidentCustomFieldOptions struct {
+	Includes []string `url:"include,brackets,omitempty"`
+}
+
+// IncidentCustomFieldPayload represents payload with an incident custom field object.
+type IncidentCustomFieldPayload struct {
+	Field *IncidentCustomField `json:"field,omitempty"`
+}
+
+var incidentCustomFieldsEarlyAccessHeader = RequestOptions{
+	Type:  "header",
+	Label: "X-EARLY-ACCESS",
+	Value: "incident-custom-fields-early-access",
+}
+
+// List lists existing incident custom fields.
+func (s *IncidentCustomFieldService) List(o *ListIncidentCustomFieldOptions) (*ListIncidentCustomFieldResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing incident custom fields.
+func (s *IncidentCustomFieldService) ListContext(ctx context.Context, o *ListIncidentCustomFieldOptions) (*ListIncidentCustomFieldResponse, *Response, error) {
+	u := "/incidents/custom_fields"
+	v := new(ListIncidentCustomFieldResponse)
+
+	if o == nil {
+		o = &ListIncidentCustomFieldOptions{}
+	}
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v, incidentCustomFieldsEarlyAccessHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// Get gets an incident custom field.
+func (s *IncidentCustomFieldService) Get(id string) (*IncidentCustomField, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext gets an incident custom field.
+func (s *IncidentCustomFieldService) GetContext(ctx context.Context, id string) (*IncidentCustomField, *Response, error) {
+	u := fmt.Sprintf("/incidents/custom_fields/%s", id)
+	v := new(IncidentCustomFieldPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v, incidentCustomFieldsEarlyAccessHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Field, resp, nil
+}
+
+// Create creates a new incident custom field.
+func (s *IncidentCustomFieldService) Create(f *IncidentCustomField) (*IncidentCustomField, *Response, error) {
+	return s.CreateContext(context.Background(), f)
+}
+
+// CreateContext creates a new incident custom field.
+func (s *IncidentCustomFieldService) CreateContext(ctx context.Context, f *IncidentCustomField) (*IncidentCustomField, *Response, error) {
+	u := "/incidents/custom_fields"
+	v := new(IncidentCustomFieldPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &IncidentCustomFieldPayload{Field: f}, &v, incidentCustomFieldsEarlyAccessHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Field, resp, nil
+}
+
+// Update updates an existing incident custom field.
+func (s *IncidentCustomFieldService) Update(id string, f *IncidentCustomField) (*IncidentCustomField, *Response, error) {
+	return s.UpdateContext(context.Background(), id, f)
+}
+
+// UpdateContext updates an existing incident custom field.
+func (s *IncidentCustomFieldService) UpdateContext(ctx context.Context, id string, f *IncidentCustomField) (*IncidentCustomField, *Response, error) {
+	u := fmt.Sprintf("/incidents/custom_fields/%s", id)
+	v := new(IncidentCustomFieldPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &IncidentCustomFieldPayload{Field: f}, &v, incidentCustomFieldsEarlyAccessHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Field, resp, nil
+}
+
+// Delete removes an existing incident custom field.
+func (s *IncidentCustomFieldService) Delete(id string) (*Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext removes an existing incident custom field.
+func (s *IncidentCustomFieldService) DeleteContext(ctx context.Context, id string) (*Response, error) {
+	u := fmt.Sprintf("/incidents/custom_fields/%s", id)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, incidentCustomFieldsEarlyAccessHeader)
+}
+
+// ListIncidentCustomFieldOptionResponse represents a list response of the options of an incident custom field.
+type ListIncidentCustomFieldOptionResponse struct {
+	FieldOptions []*IncidentCustomFieldOption `json:"field_options,omitempty"`
+}
+
+// IncidentCustomFieldOptionPayload represents payload with an incident custom field option object.
+type IncidentCustomFieldOptionPayload struct {
+	FieldOption *IncidentCustomFieldOption `json:"field_option,omitempty"`
+}
+
+// ListFieldOptions lists the options of a fixed-option incident custom field.
+func (s *IncidentCustomFieldService) ListFieldOptions(fieldID string) (*ListIncidentCustomFieldOptionResponse, *Response, error) {
+	return s.ListFieldOptionsContext(context.Background(), fieldID)
+}
+
+// ListFieldOptionsContext lists the options of a fixed-option incident custom field.
+func (s *IncidentCustomFieldService) ListFieldOptionsContext(ctx context.Context, fieldID string) (*ListIncidentCustomFieldOptionResponse, *Response, error) {
+	u := fmt.Sprintf("/incidents/custom_fields/%s/field_options", fieldID)
+	v := new(ListIncidentCustomFieldOptionResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, &v, incidentCustomFieldsEarlyAccessHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// GetFieldOption gets an option of an incident custom field.
+func (s *IncidentCustomFieldService) GetFieldOption(fieldID, optionID string) (*IncidentCustomFieldOption, *Response, error) {
+	return s.GetFieldOptionContext(context.Background(), fieldID, optionID)
+}
+
+// GetFieldOptionContext gets an option of an incident custom field.
+func (s *IncidentCustomFieldService) GetFieldOptionContext(ctx context.Context, fieldID, optionID string) (*IncidentCustomFieldOption, *Response, error) {
+	u := fmt.Sprintf("/incidents/custom_fields/%s/field_options/%s", fieldID, optionID)
+	v := new(IncidentCustomFieldOptionPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v, incidentCustomFieldsEarlyAccessHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.FieldOption, resp, nil
+}
+
+// CreateFieldOption adds an option to a fixed-option incident custom field.
+func (s *IncidentCustomFieldService) CreateFieldOption(fieldID string, o *IncidentCustomFieldOption) (*IncidentCustomFieldOption, *Response, error) {
+	return s.CreateFieldOptionContext(context.Background(), fieldID, o)
+}
+
+// CreateFieldOptionContext adds an option to a fixed-option incident custom field.
+func (s *IncidentCustomFieldService) CreateFieldOptionContext(ctx context.Context, fieldID string, o *IncidentCustomFieldOption) (*IncidentCustomFieldOption, *Response, error) {
+	u := fmt.Sprintf("/incidents/custom_fields/%s/field_options", fieldID)
+	v := new(IncidentCustomFieldOptionPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &IncidentCustomFieldOptionPayload{FieldOption: o}, &v, incidentCustomFieldsEarlyAccessHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.FieldOption, resp, nil
+}
+
+// DeleteFieldOption removes an option of an incident custom field.
+func (s *IncidentCustomFieldService) DeleteFieldOption(fieldID, optionID string) (*Response, error) {
+	return s.DeleteFieldOptionContext(context.Background(), fieldID, optionID)
+}
+
+// DeleteFieldOptionContext removes an option of an incident custom field.
+func (s *IncidentCustomFieldService) DeleteFieldOptionContext(ctx context.Context, fieldID, optionID string) (*Response, error) {
+	u := fmt.Sprintf("/incidents/custom_fields/%s/field_options/%s", fieldID, optionID)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil, incidentCustomFieldsEarlyAccessHeader)
+}
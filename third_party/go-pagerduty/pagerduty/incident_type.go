@@ -0,0 +1,236 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// IncidentTypeService handles the communication with incident type
+// related methods of the PagerDuty API.
+type IncidentTypeService service
+
+// IncidentType represents an incident type.
+type IncidentType struct {
+	ID          string                 `json:"id,omitempty"`
+	Type        string                 `json:"type,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	DisplayName string                 `json:"display_name,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	Enabled     *bool                  `json:"enabled,omitempty"`
+	Parent      *IncidentTypeReference `json:"parent,omitempty"`
+	ParentType  string                 `json:"parent_type,omitempty"`
+	Self        string                 `json:"self,omitempty"`
+	Summary     string                 `json:"summary,omitempty"`
+}
+
+// IncidentTypeReference represents a reference to an incident type.
+type IncidentTypeReference struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// ListIncidentTypeResponse represents a list response of incident types.
+type ListIncidentTypeResponse struct {
+	IncidentTypes []*IncidentType `json:"incident_types,omitempty"`
+}
+
+// ListIncidentTypeOptions represents options when listing incident types.
+type ListIncidentTypeOptions struct {
+	Filter string `url:"filter,omitempty"`
+}
+
+// IncidentTypePayload represents payload with an incident type object.
+type IncidentTypePayload struct {
+	IncidentType *IncidentType `json:"incident_type,omitempty"`
+}
+
+// List lists existing incident types.
+func (s *IncidentTypeService) List(o *ListIncidentTypeOptions) (*ListIncidentTypeResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing incident types.
+func (s *IncidentTypeService) ListContext(ctx context.Context, o *ListIncidentTypeOptions) (*ListIncidentTypeResponse, *Response, error) {
+	u := "/incident_types"
+	v := new(ListIncidentTypeResponse)
+
+	if o == nil {
+		o = &ListIncidentTypeOptions{}
+	}
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// Get gets an incident type by its ID or name.
+func (s *IncidentTypeService) Get(id string) (*IncidentType, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext gets an incident type by its ID or name.
+func (s *IncidentTypeService) GetContext(ctx context.Context, id string) (*IncidentType, *Response, error) {
+	u := fmt.Sprintf("/incident_types/%s", id)
+	v := new(IncidentTypePayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.IncidentType, resp, nil
+}
+
+// Create creates a new incident type.
+func (s *IncidentTypeService) Create(t *IncidentType) (*IncidentType, *Response, error) {
+	return s.CreateContext(context.Background(), t)
+}
+
+// CreateContext creates a new incident type.
+func (s *IncidentTypeService) CreateContext(ctx context.Context, t *IncidentType) (*IncidentType, *Response, error) {
+	u := "/incident_types"
+	v := new(IncidentTypePayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &IncidentTypePayload{IncidentType: t}, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.IncidentType, resp, nil
+}
+
+// Update updates an existing incident type. Incident types can't be deleted,
+// they are disabled instead.
+func (s *IncidentTypeService) Update(id string, t *IncidentType) (*IncidentType, *Response, error) {
+	return s.UpdateContext(context.Background(), id, t)
+}
+
+// UpdateContext updates an existing incident type. Incident types can't be
+// deleted, they are disabled instead.
+func (s *IncidentTypeService) UpdateContext(ctx context.Context, id string, t *IncidentType) (*IncidentType, *Response, error) {
+	u := fmt.Sprintf("/incident_types/%s", id)
+	v := new(IncidentTypePayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, &IncidentTypePayload{IncidentType: t}, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.IncidentType, resp, nil
+}
+
+// ListCustomFields lists the custom fields of an incident type, including the ones inherited from its parent types.
+func (s *IncidentTypeService) ListCustomFields(typeID string) (*ListIncidentCustomFieldResponse, *Response, error) {
+	return s.ListCustomFieldsContext(context.Background(), typeID)
+}
+
+// ListCustomFieldsContext lists the custom fields of an incident type, including the ones inherited from its parent types.
+func (s *IncidentTypeService) ListCustomFieldsContext(ctx context.Context, typeID string) (*ListIncidentCustomFieldResponse, *Response, error) {
+	u := fmt.Sprintf("/incident_types/%s/custom_fields", typeID)
+	v := new(ListIncidentCustomFieldResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// GetCustomField gets a custom field of an incident type.
+func (s *IncidentTypeService) GetCustomField(typeID, fieldID string) (*IncidentCustomField, *Response, error) {
+	return s.GetCustomFieldContext(context.Background(), typeID, fieldID)
+}
+
+// GetCustomFieldContext gets a custom field of an incident type.
+func (s *IncidentTypeService) GetCustomFieldContext(ctx context.Context, typeID, fieldID string) (*IncidentCustomField, *Response, error) {
+	u := fmt.Sprintf("/incident_types/%s/custom_fields/%s", typeID, fieldID)
+	v := new(IncidentCustomFieldPayload)
+	o := &ListIncidentCustomFieldOptions{Includes: []string{"field_options"}}
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Field, resp, nil
+}
+
+// CreateCustomField creates a custom field for an incident type.
+func (s *IncidentTypeService) CreateCustomField(typeID string, f *IncidentCustomField) (*IncidentCustomField, *Response, error) {
+	return s.CreateCustomFieldContext(context.Background(), typeID, f)
+}
+
+// CreateCustomFieldContext creates a custom field for an incident type.
+func (s *IncidentTypeService) CreateCustomFieldContext(ctx context.Context, typeID string, f *IncidentCustomField) (*IncidentCustomField, *Response, error) {
+	u := fmt.Sprintf("/incident_types/%s/custom_fields", typeID)
+	v := new(IncidentCustomFieldPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &IncidentCustomFieldPayload{Field: f}, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Field, resp, nil
+}
+
+// UpdateCustomField updates a custom field of an incident type.
+func (s *IncidentTypeService) UpdateCustomField(typeID, fieldID string, f *IncidentCustomField) (*IncidentCustomField, *Response, error) {
+	return s.UpdateCustomFieldContext(context.Background(), typeID, fieldID, f)
+}
+
+// UpdateCustomFieldContext updates a custom field of an incident type.
+func (s *IncidentTypeService) UpdateCustomFieldContext(ctx context.Context, typeID, fieldID string, f *IncidentCustomField) (*IncidentCustomField, *Response, error) {
+	u := fmt.Sprintf("/incident_types/%s/custom_fields/%s", typeID, fieldID)
+	v := new(IncidentCustomFieldPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, &IncidentCustomFieldPayload{Field: f}, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Field, resp, nil
+}
+
+// DeleteCustomField removes a custom field of an incident type.
+func (s *IncidentTypeService) DeleteCustomField(typeID, fieldID string) (*Response, error) {
+	return s.DeleteCustomFieldContext(context.Background(), typeID, fieldID)
+}
+
+// DeleteCustomFieldContext removes a custom field of an incident type.
+func (s *IncidentTypeService) DeleteCustomFieldContext(ctx context.Context, typeID, fieldID string) (*Response, error) {
+	u := fmt.Sprintf("/incident_types/%s/custom_fields/%s", typeID, fieldID)
+	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+}
+
+// CreateCustomFieldOption adds an option to a fixed-option custom field of an incident type.
+func (s *IncidentTypeService) CreateCustomFieldOption(typeID, fieldID string, o *IncidentCustomFieldOption) (*IncidentCustomFieldOption, *Response, error) {
+	return s.CreateCustomFieldOptionContext(context.Background(), typeID, fieldID, o)
+}
+
+// CreateCustomFieldOptionContext adds an option to a fixed-option custom field of an incident type.
+func (s *IncidentTypeService) CreateCustomFieldOptionContext(ctx context.Context, typeID, fieldID string, o *IncidentCustomFieldOption) (*IncidentCustomFieldOption, *Response, error) {
+	u := fmt.Sprintf("/incident_types/%s/custom_fields/%s/field_options", typeID, fieldID)
+	v := new(IncidentCustomFieldOptionPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &IncidentCustomFieldOptionPayload{FieldOption: o}, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.FieldOption, resp, nil
+}
+
+// DeleteCustomFieldOption removes an option of a custom field of an incident type.
+func (s *IncidentTypeService) DeleteCustomFieldOption(typeID, fieldID, optionID string) (*Response, error) {
+	return s.DeleteCustomFieldOptionContext(context.Background(), typeID, fieldID, optionID)
+}
+
+// DeleteCustomFieldOptionContext removes an option of a custom field of an incident type.
+func (s *IncidentTypeService) DeleteCustomFieldOptionContext(ctx context.Context, typeID, fieldID, optionID string) (*Response, error) {
+	u := fmt.Sprintf("/incident_types/%s/custom_fields/%s/field_options/%s", typeID, fieldID, optionID)
+	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+}
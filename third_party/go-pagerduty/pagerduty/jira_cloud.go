@@ -0,0 +1,284 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// JiraCloudService handles the communication with Jira Cloud integration
+// related methods of the PagerDuty API.
+type JiraCloudService service
+
+// JiraCloudAccountMapping represents the mapping of a PagerDuty account with a Jira Cloud account.
+type JiraCloudAccountMapping struct {
+	ID        string                            `json:"id,omitempty"`
+	PagerDuty *JiraCloudAccountMappingPagerDuty `json:"pagerduty,omitempty"`
+	JiraCloud *JiraCloudAccountMappingJiraCloud `json:"jira_cloud,omitempty"`
+	CreatedAt string                            `json:"created_at,omitempty"`
+	UpdatedAt string                            `json:"updated_at,omitempty"`
+}
+
+// JiraCloudAccountMappingPagerDuty represents the PagerDuty side of a Jira Cloud account mapping.
+type JiraCloudAccountMappingPagerDuty struct {
+	Subdomain string `json:"subdomain,omitempty"`
+}
+
+// JiraCloudAccountMappingJiraCloud represents the Jira Cloud side of a Jira Cloud account mapping.
+type JiraCloudAccountMappingJiraCloud struct {
+	BaseURL string `json:"base_url,omitempty"`
+}
+
+// JiraCloudAccountMappingRule represents a rule of a Jira Cloud account mapping,
+// which syncs the incidents of a service with the issues of a Jira project.
+type JiraCloudAccountMappingRule struct {
+	ID                          string                             `json:"id,omitempty"`
+	Name                        string                             `json:"name,omitempty"`
+	AccountMapping              *JiraCloudAccountMappingReference  `json:"account_mapping,omitempty"`
+	Config                      *JiraCloudAccountMappingRuleConfig `json:"config,omitempty"`
+	AutocreateJQLDisabledReason string                             `json:"autocreate_jql_disabled_reason,omitempty"`
+	AutocreateJQLDisabledUntil  string                             `json:"autocreate_jql_disabled_until,omitempty"`
+}
+
+// JiraCloudAccountMappingReference represents a reference to a Jira Cloud account mapping.
+type JiraCloudAccountMappingReference resourceReference
+
+// JiraCloudAccountMappingRuleConfig represents the configuration of a Jira Cloud account mapping rule.
+type JiraCloudAccountMappingRuleConfig struct {
+	Service *ServiceReference                `json:"service,omitempty"`
+	Jira    *JiraCloudAccountMappingRuleJira `json:"jira,omitempty"`
+}
+
+// JiraCloudAccountMappingRuleJira represents the Jira settings of a Jira Cloud account mapping rule.
+type JiraCloudAccountMappingRuleJira struct {
+	AutocreateJQL                *string                     `json:"autocreate_jql"`
+	CreateIssueOnIncidentTrigger bool                        `json:"create_issue_on_incident_trigger"`
+	CustomFields                 []*JiraCloudCustomField     `json:"custom_fields"`
+	IssueType                    *JiraCloudReference         `json:"issue_type,omitempty"`
+	Priorities                   []*JiraCloudPriorityMapping `json:"priorities"`
+	Project                      *JiraCloudProject           `json:"project,omitempty"`
+	StatusMapping                *JiraCloudStatusMapping     `json:"status_mapping,omitempty"`
+	SyncNotesUser                *UserReference              `json:"sync_notes_user"`
+}
+
+// JiraCloudReference represents a reference to a Jira object, such as an issue type or a status.
+type JiraCloudReference struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// JiraCloudProject represents a Jira project.
+type JiraCloudProject struct {
+	ID   string `json:"id,omitempty"`
+	Key  string `json:"key,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// JiraCloudPriorityMapping represents the mapping of a PagerDuty priority with a Jira priority.
+type JiraCloudPriorityMapping struct {
+	JiraID      string `json:"jira_id,omitempty"`
+	PagerDutyID string `json:"pagerduty_id,omitempty"`
+}
+
+// JiraCloudStatusMapping represents the mapping of the PagerDuty incident statuses with Jira statuses.
+type JiraCloudStatusMapping struct {
+	Triggered    *JiraCloudReference `json:"triggered,omitempty"`
+	Acknowledged *JiraCloudReference `json:"acknowledged"`
+	Resolved     *JiraCloudReference `json:"resolved"`
+}
+
+// JiraCloudCustomField represents the mapping of a Jira issue field with a PagerDuty incident field or a constant value.
+type JiraCloudCustomField struct {
+	SourceIncidentField  string      `json:"source_incident_field,omitempty"`
+	TargetIssueField     string      `json:"target_issue_field,omitempty"`
+	TargetIssueFieldName string      `json:"target_issue_field_name,omitempty"`
+	Type                 string      `json:"type,omitempty"`
+	Value                interface{} `json:"value,omitempty"`
+}
+
+// Types of Jira Cloud custom field mappings.
+const (
+	JiraCloudCustomFieldTypeAttribute = "attribute"
+	JiraCloudCustomFieldTypeConst     = "const"
+	JiraCloudCustomFieldTypeJiraValue = "jira_value"
+)
+
+// ListJiraCloudAccountMappingsResponse represents a list response of Jira Cloud account mappings.
+type ListJiraCloudAccountMappingsResponse struct {
+	Total           int                        `json:"total,omitempty"`
+	AccountMappings []*JiraCloudAccountMapping `json:"accounts_mappings,omitempty"`
+	Offset          int                        `json:"offset,omitempty"`
+	More            bool                       `json:"more,omitempty"`
+	Limit           int                        `json:"limit,omitempty"`
+}
+
+// ListJiraCloudAccountMappingRulesResponse represents a list response of Jira Cloud account mapping rules.
+type ListJiraCloudAccountMappingRulesResponse struct {
+	Total  int                            `json:"total,omitempty"`
+	Rules  []*JiraCloudAccountMappingRule `json:"rules,omitempty"`
+	Offset int                            `json:"offset,omitempty"`
+	More   bool                           `json:"more,omitempty"`
+	Limit  int                            `json:"limit,omitempty"`
+}
+
+// ListAccountMappings lists the Jira Cloud account mappings.
+func (s *JiraCloudService) ListAccountMappings() (*ListJiraCloudAccountMappingsResponse, *Response, error) {
+	return s.ListAccountMappingsContext(context.Background())
+}
+
+// ListAccountMappingsContext lists the Jira Cloud account mappings.
+func (s *JiraCloudService) ListAccountMappingsContext(ctx context.Context) (*ListJiraCloudAccountMappingsResponse, *Response, error) {
+	u := "/integration-jira-cloud/accounts_mappings"
+	v := new(ListJiraCloudAccountMappingsResponse)
+
+	accountMappings := make([]*JiraCloudAccountMapping, 0)
+
+	// Create a handler closure capable of parsing data from the accounts mappings endpoint
+	// and appending resultant account mappings to the return slice.
+	responseHandler := func(response *Response) (ListResp, *Response, error) {
+		var result ListJiraCloudAccountMappingsResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return ListResp{}, response, err
+		}
+
+		accountMappings = append(accountMappings, result.AccountMappings...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return ListResp{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+	err := s.client.newRequestPagedGetQueryDoContext(ctx, u, responseHandler, &simpleOffsetQueryOptionsGen{})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.AccountMappings = accountMappings
+
+	return v, nil, nil
+}
+
+// GetAccountMapping gets a Jira Cloud account mapping.
+func (s *JiraCloudService) GetAccountMapping(id string) (*JiraCloudAccountMapping, *Response, error) {
+	return s.GetAccountMappingContext(context.Background(), id)
+}
+
+// GetAccountMappingContext gets a Jira Cloud account mapping.
+func (s *JiraCloudService) GetAccountMappingContext(ctx context.Context, id string) (*JiraCloudAccountMapping, *Response, error) {
+	u := fmt.Sprintf("/integration-jira-cloud/accounts_mappings/%s", id)
+	v := new(JiraCloudAccountMapping)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// ListAccountMappingRules lists the rules of a Jira Cloud account mapping.
+func (s *JiraCloudService) ListAccountMappingRules(accountMappingID string) (*ListJiraCloudAccountMappingRulesResponse, *Response, error) {
+	return s.ListAccountMappingRulesContext(context.Background(), accountMappingID)
+}
+
+// ListAccountMappingRulesContext lists the rules of a Jira Cloud account mapping.
+func (s *JiraCloudService) ListAccountMappingRulesContext(ctx context.Context, accountMappingID string) (*ListJiraCloudAccountMappingRulesResponse, *Response, error) {
+	u := fmt.Sprintf("/integration-jira-cloud/accounts_mappings/%s/rules", accountMappingID)
+	v := new(ListJiraCloudAccountMappingRulesResponse)
+
+	rules := make([]*JiraCloudAccountMappingRule, 0)
+
+	// Create a handler closure capable of parsing data from the rules endpoint
+	// and appending resultant rules to the return slice.
+	responseHandler := func(response *Response) (ListResp, *Response, error) {
+		var result ListJiraCloudAccountMappingRulesResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return ListResp{}, response, err
+		}
+
+		rules = append(rules, result.Rules...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return ListResp{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+	err := s.client.newRequestPagedGetQueryDoContext(ctx, u, responseHandler, &simpleOffsetQueryOptionsGen{})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.Rules = rules
+
+	return v, nil, nil
+}
+
+// GetAccountMappingRule gets a rule of a Jira Cloud account mapping.
+func (s *JiraCloudService) GetAccountMappingRule(accountMappingID, id string) (*JiraCloudAccountMappingRule, *Response, error) {
+	return s.GetAccountMappingRuleContext(context.Background(), accountMappingID, id)
+}
+
+// GetAccountMappingRuleContext gets a rule of a Jira Cloud account mapping.
+func (s *JiraCloudService) GetAccountMappingRuleContext(ctx context.Context, accountMappingID, id string) (*JiraCloudAccountMappingRule, *Response, error) {
+	u := fmt.Sprintf("/integration-jira-cloud/accounts_mappings/%s/rules/%s", accountMappingID, id)
+	v := new(JiraCloudAccountMappingRule)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// CreateAccountMappingRule creates a rule of a Jira Cloud account mapping.
+func (s *JiraCloudService) CreateAccountMappingRule(accountMappingID string, r *JiraCloudAccountMappingRule) (*JiraCloudAccountMappingRule, *Response, error) {
+	return s.CreateAccountMappingRuleContext(context.Background(), accountMappingID, r)
+}
+
+// CreateAccountMappingRuleContext creates a rule of a Jira Cloud account mapping.
+func (s *JiraCloudService) CreateAccountMappingRuleContext(ctx context.Context, accountMappingID string, r *JiraCloudAccountMappingRule) (*JiraCloudAccountMappingRule, *Response, error) {
+	u := fmt.Sprintf("/integration-jira-cloud/accounts_mappings/%s/rules", accountMappingID)
+	v := new(JiraCloudAccountMappingRule)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, r, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// UpdateAccountMappingRule updates a rule of a Jira Cloud account mapping.
+func (s *JiraCloudService) UpdateAccountMappingRule(accountMappingID, id string, r *JiraCloudAccountMappingRule) (*JiraCloudAccountMappingRule, *Response, error) {
+	return s.UpdateAccountMappingRuleContext(context.Background(), accountMappingID, id, r)
+}
+
+// UpdateAccountMappingRuleContext updates a rule of a Jira Cloud account mapping.
+func (s *JiraCloudService) UpdateAccountMappingRuleContext(ctx context.Context, accountMappingID, id string, r *JiraCloudAccountMappingRule) (*JiraCloudAccountMappingRule, *Response, error) {
+	u := fmt.Sprintf("/integration-jira-cloud/accounts_mappings/%s/rules/%s", accountMappingID, id)
+	v := new(JiraCloudAccountMappingRule)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, r, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// DeleteAccountMappingRule removes a rule of a Jira Cloud account mapping.
+func (s *JiraCloudService) DeleteAccountMappingRule(accountMappingID, id string) (*Response, error) {
+	return s.DeleteAccountMappingRuleContext(context.Background(), accountMappingID, id)
+}
+
+// DeleteAccountMappingRuleContext removes a rule of a Jira Cloud account mapping.
+func (s *JiraCloudService) DeleteAccountMappingRuleContext(ctx context.Context, accountMappingID, id string) (*Response, error) {
+	u := fmt.Sprintf("/integration-jira-cloud/accounts_mappings/%s/rules/%s", accountMappingID, id)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil)
+}
@@ -0,0 +1,45 @@
+package pagerduty
+
+import "context"
+
+// LicenseService handles the communication with license related methods of
+// the PagerDuty API.
+type LicenseService service
+
+// License represents a license of the account.
+type License struct {
+	AllocationsAvailable int      `json:"allocations_available"`
+	CurrentValue         int      `json:"current_value"`
+	Description          string   `json:"description,omitempty"`
+	HTMLURL              string   `json:"html_url,omitempty"`
+	ID                   string   `json:"id,omitempty"`
+	Name                 string   `json:"name,omitempty"`
+	RoleGroup            string   `json:"role_group,omitempty"`
+	Self                 string   `json:"self,omitempty"`
+	Summary              string   `json:"summary,omitempty"`
+	Type                 string   `json:"type,omitempty"`
+	ValidRoles           []string `json:"valid_roles,omitempty"`
+}
+
+// ListLicensesResponse represents a list response of licenses.
+type ListLicensesResponse struct {
+	Licenses []*License `json:"licenses,omitempty"`
+}
+
+// List lists the licenses of the account, with how many of them are allocated.
+func (s *LicenseService) List() ([]*License, *Response, error) {
+	return s.ListContext(context.Background())
+}
+
+// ListContext lists the licenses of the account, with how many of them are
+// allocated.
+func (s *LicenseService) ListContext(ctx context.Context) ([]*License, *Response, error) {
+	v := new(ListLicensesResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", "/licenses", nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Licenses, resp, nil
+}
@@ -0,0 +1,163 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// MaintenanceWindowService handles the communication with add-on related methods
+// of the PagerDuty API.
+type MaintenanceWindowService service
+
+// MaintenanceWindow represents a PagerDuty maintenance window.
+type MaintenanceWindow struct {
+	CreatedBy      *UserReference      `json:"created_by,omitempty"`
+	Description    string              `json:"description,omitempty"`
+	EndTime        string              `json:"end_time,omitempty"`
+	HTMLURL        string              `json:"html_url,omitempty"`
+	ID             string              `json:"id,omitempty"`
+	Self           string              `json:"self,omitempty"`
+	SequenceNumber int                 `json:"sequence_number,omitempty"`
+	Services       []*ServiceReference `json:"services,omitempty"`
+	Src            string              `json:"src,omitempty"`
+	StartTime      string              `json:"start_time,omitempty"`
+	Summary        string              `json:"summary,omitempty"`
+	Teams          []*TeamReference    `json:"teams,omitempty"`
+	Type           string              `json:"type,omitempty"`
+}
+
+// ListMaintenanceWindowsOptions represents options when listing maintenance windows.
+type ListMaintenanceWindowsOptions struct {
+	Filter     string   `url:"filter,omitempty"`
+	Include    []string `url:"include,omitempty,brackets"`
+	Limit      int      `url:"limit,omitempty"`
+	Offset     int      `url:"offset,omitempty"`
+	Query      string   `url:"query,omitempty"`
+	ServiceIDs []string `url:"service_ids,omitempty,brackets"`
+	TeamIDs    []string `url:"team_ids,omitempty,brackets"`
+}
+
+// ListMaintenanceWindowsResponse represents a list response of maintenance windows.
+type ListMaintenanceWindowsResponse struct {
+	Limit              int                  `json:"limit,omitempty"`
+	MaintenanceWindows []*MaintenanceWindow `json:"maintenance_windows,omitempty"`
+	More               bool                 `json:"more,omitempty"`
+	Offset             int                  `json:"offset,omitempty"`
+	Total              int                  `json:"total,omitempty"`
+}
+
+// MaintenanceWindowPayload represents a maintenance window.
+type MaintenanceWindowPayload struct {
+	MaintenanceWindow *MaintenanceWindow `json:"maintenance_window,omitempty"`
+}
+
+type listMaintenanceWindowsOptionsGen struct {
+	options *ListMaintenanceWindowsOptions
+}
+
+func (o *listMaintenanceWindowsOptionsGen) currentOffset() int {
+	return o.options.Offset
+}
+
+func (o *listMaintenanceWindowsOptionsGen) changeOffset(i int) {
+	o.options.Offset = i
+}
+
+func (o *listMaintenanceWindowsOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// List lists existing maintenance windows. If a non-zero Limit is passed as an option, only a single page of results
+// will be returned. Otherwise, the entire list of maintenance windows will be returned.
+func (s *MaintenanceWindowService) List(o *ListMaintenanceWindowsOptions) (*ListMaintenanceWindowsResponse, *Response, error) {
+	u := "/maintenance_windows"
+	v := new(ListMaintenanceWindowsResponse)
+
+	if o == nil {
+		o = &ListMaintenanceWindowsOptions{}
+	}
+
+	if o.Limit != 0 {
+		resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return v, resp, nil
+	}
+
+	maintenanceWindows := make([]*MaintenanceWindow, 0)
+
+	// Create a handler closure capable of parsing data from the maintenance windows endpoint
+	// and appending resultant maintenance windows to the return slice.
+	responseHandler := func(response *Response) (ListResp, *Response, error) {
+		var result ListMaintenanceWindowsResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return ListResp{}, response, err
+		}
+
+		maintenanceWindows = append(maintenanceWindows, result.MaintenanceWindows...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return ListResp{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+	err := s.client.newRequestPagedGetQueryDoContext(context.Background(), u, responseHandler, &listMaintenanceWindowsOptionsGen{
+		options: o,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.MaintenanceWindows = maintenanceWindows
+
+	return v, nil, nil
+}
+
+// Create creates a new maintenancce window.
+func (s *MaintenanceWindowService) Create(maintenanceWindow *MaintenanceWindow) (*MaintenanceWindow, *Response, error) {
+	u := "/maintenance_windows"
+	v := new(MaintenanceWindowPayload)
+
+	resp, err := s.client.newRequestDo("POST", u, nil, &MaintenanceWindowPayload{MaintenanceWindow: maintenanceWindow}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.MaintenanceWindow, resp, nil
+}
+
+// Delete removes an existing maintenance window.
+func (s *MaintenanceWindowService) Delete(id string) (*Response, error) {
+	u := fmt.Sprintf("/maintenance_windows/%s", id)
+	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+}
+
+// Get retrieves information about a maintenance window.
+func (s *MaintenanceWindowService) Get(id string) (*MaintenanceWindow, *Response, error) {
+	u := fmt.Sprintf("/maintenance_windows/%s", id)
+	v := new(MaintenanceWindowPayload)
+
+	resp, err := s.client.newRequestDo("GET", u, nil, nil, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.MaintenanceWindow, resp, nil
+}
+
+// Update updates an existing maintenance window.
+func (s *MaintenanceWindowService) Update(id string, maintenanceWindow *MaintenanceWindow) (*MaintenanceWindow, *Response, error) {
+	u := fmt.Sprintf("/maintenance_windows/%s", id)
+	v := new(MaintenanceWindowPayload)
+	resp, err := s.client.newRequestDo("PUT", u, nil, &MaintenanceWindowPayload{MaintenanceWindow: maintenanceWindow}, &v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.MaintenanceWindow, resp, nil
+}
@@ -0,0 +1,148 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// StandardService handles the communication with resource standards related
+// methods of the PagerDuty API.
+type StandardService service
+
+// Standard represents a resource standard.
+type Standard struct {
+	Active       bool                          `json:"active"`
+	Description  string                        `json:"description,omitempty"`
+	Exclusions   []*StandardInclusionExclusion `json:"exclusions,omitempty"`
+	ID           string                        `json:"id,omitempty"`
+	Inclusions   []*StandardInclusionExclusion `json:"inclusions,omitempty"`
+	Name         string                        `json:"name,omitempty"`
+	ResourceType string                        `json:"resource_type,omitempty"`
+	Type         string                        `json:"type,omitempty"`
+}
+
+// StandardInclusionExclusion represents a resource a standard is explicitly
+// applied to, or not applied to.
+type StandardInclusionExclusion struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// ListStandardsOptions represents options when listing standards.
+type ListStandardsOptions struct {
+	Active       *bool  `url:"active,omitempty"`
+	ResourceType string `url:"resource_type,omitempty"`
+}
+
+// ListStandardsResponse represents a list response of standards.
+type ListStandardsResponse struct {
+	Standards []*Standard `json:"standards"`
+}
+
+// UpdateStandardPayload represents the changes of an update of a standard.
+type UpdateStandardPayload struct {
+	Active      bool                          `json:"active"`
+	Description string                        `json:"description,omitempty"`
+	Exclusions  []*StandardInclusionExclusion `json:"exclusions"`
+	Inclusions  []*StandardInclusionExclusion `json:"inclusions"`
+}
+
+// ListResourceScoresOptions represents options when listing the standards
+// scores of several resources.
+type ListResourceScoresOptions struct {
+	IDs []string `url:"ids,omitempty,brackets"`
+}
+
+// ListResourceScoresResponse represents a list response of standards scores.
+type ListResourceScoresResponse struct {
+	Resources []*ResourceStandardScore `json:"resources"`
+}
+
+// ResourceStandardScore represents the score of a resource against the
+// standards that apply to it.
+type ResourceStandardScore struct {
+	ResourceID   string                  `json:"resource_id,omitempty"`
+	ResourceType string                  `json:"resource_type,omitempty"`
+	Score        *ResourceScore          `json:"score,omitempty"`
+	Standards    []*ResourceStandardPass `json:"standards,omitempty"`
+}
+
+// ResourceScore represents the number of standards a resource passes.
+type ResourceScore struct {
+	Passing int `json:"passing"`
+	Total   int `json:"total"`
+}
+
+// ResourceStandardPass represents the result of a standard for a resource.
+type ResourceStandardPass struct {
+	Standard
+	Pass bool `json:"pass"`
+}
+
+// List lists the standards of the account.
+func (s *StandardService) List(o *ListStandardsOptions) (*ListStandardsResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists the standards of the account.
+func (s *StandardService) ListContext(ctx context.Context, o *ListStandardsOptions) (*ListStandardsResponse, *Response, error) {
+	v := new(ListStandardsResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", "/standards", o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// Update updates a standard. Standards can't be created nor deleted.
+func (s *StandardService) Update(id string, p *UpdateStandardPayload) (*Standard, *Response, error) {
+	return s.UpdateContext(context.Background(), id, p)
+}
+
+// UpdateContext updates a standard. Standards can't be created nor deleted.
+func (s *StandardService) UpdateContext(ctx context.Context, id string, p *UpdateStandardPayload) (*Standard, *Response, error) {
+	u := fmt.Sprintf("/standards/%s", id)
+	v := new(Standard)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// ListResourceScores lists the standards scores of several resources of the
+// same type, at most 100 at a time.
+func (s *StandardService) ListResourceScores(resourceType string, o *ListResourceScoresOptions) (*ListResourceScoresResponse, *Response, error) {
+	return s.ListResourceScoresContext(context.Background(), resourceType, o)
+}
+
+// ListResourceScoresContext lists the standards scores of several resources
+// of the same type, at most 100 at a time.
+func (s *StandardService) ListResourceScoresContext(ctx context.Context, resourceType string, o *ListResourceScoresOptions) (*ListResourceScoresResponse, *Response, error) {
+	u := fmt.Sprintf("/standards/scores/%s", resourceType)
+	v := new(ListResourceScoresResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// GetResourceScore gets the standards score of a single resource.
+func (s *StandardService) GetResourceScore(resourceType, id string) (*ResourceStandardScore, *Response, error) {
+	u := fmt.Sprintf("/standards/scores/%s/%s", resourceType, id)
+	v := new(ResourceStandardScore)
+
+	resp, err := s.client.newRequestDo("GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
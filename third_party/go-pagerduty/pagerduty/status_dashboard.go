@@ -0,0 +1,102 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// StatusDashboardService handles the communication with status dashboard
+// related methods of the PagerDuty API.
+type StatusDashboardService service
+
+// StatusDashboard represents a custom status dashboard.
+type StatusDashboard struct {
+	ID      string `json:"id,omitempty"`
+	URLSlug string `json:"url_slug,omitempty"`
+	Name    string `json:"name,omitempty"`
+}
+
+// StatusDashboardPayload represents payload with a status dashboard object.
+type StatusDashboardPayload struct {
+	StatusDashboard *StatusDashboard `json:"status_dashboard,omitempty"`
+}
+
+// ListStatusDashboardsResponse represents a list response of status dashboards.
+type ListStatusDashboardsResponse struct {
+	StatusDashboards []*StatusDashboard `json:"status_dashboards,omitempty"`
+}
+
+// List lists the custom status dashboards.
+func (s *StatusDashboardService) List() (*ListStatusDashboardsResponse, *Response, error) {
+	return s.ListContext(context.Background())
+}
+
+// ListContext lists the custom status dashboards.
+func (s *StatusDashboardService) ListContext(ctx context.Context) (*ListStatusDashboardsResponse, *Response, error) {
+	u := "/status_dashboards"
+	v := new(ListStatusDashboardsResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// Get gets a custom status dashboard.
+func (s *StatusDashboardService) Get(id string) (*StatusDashboard, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext gets a custom status dashboard.
+func (s *StatusDashboardService) GetContext(ctx context.Context, id string) (*StatusDashboard, *Response, error) {
+	u := fmt.Sprintf("/status_dashboards/%s", id)
+	v := new(StatusDashboardPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.StatusDashboard, resp, nil
+}
+
+// GetByURLSlug gets a custom status dashboard by its URL slug.
+func (s *StatusDashboardService) GetByURLSlug(urlSlug string) (*StatusDashboard, *Response, error) {
+	return s.GetByURLSlugContext(context.Background(), urlSlug)
+}
+
+// GetByURLSlugContext gets a custom status dashboard by its URL slug.
+func (s *StatusDashboardService) GetByURLSlugContext(ctx context.Context, urlSlug string) (*StatusDashboard, *Response, error) {
+	u := fmt.Sprintf("/status_dashboards/url_slugs/%s", url.PathEscape(urlSlug))
+	v := new(StatusDashboardPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.StatusDashboard, resp, nil
+}
+
+// ListServiceImpacts lists the business services in the scope of a custom
+// status dashboard, along with their impact status.
+func (s *StatusDashboardService) ListServiceImpacts(id string) (*ListBusinessServiceImpactsResponse, *Response, error) {
+	return s.ListServiceImpactsContext(context.Background(), id)
+}
+
+// ListServiceImpactsContext lists the business services in the scope of a
+// custom status dashboard, along with their impact status.
+func (s *StatusDashboardService) ListServiceImpactsContext(ctx context.Context, id string) (*ListBusinessServiceImpactsResponse, *Response, error) {
+	u := fmt.Sprintf("/status_dashboards/%s/service_impacts", id)
+	v := new(ListBusinessServiceImpactsResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
@@ -0,0 +1,114 @@
+package pagerduty
+
+import (
+	"context"
+)
+
+// StatusPageService handles the communication with status page
+// related methods of the PagerDuty API.
+type StatusPageService service
+
+// StatusPage represents a status page.
+type StatusPage struct {
+	ID             string `json:"id,omitempty"`
+	Type           string `json:"type,omitempty"`
+	Name           string `json:"name,omitempty"`
+	PublishedAt    string `json:"published_at,omitempty"`
+	StatusPageType string `json:"status_page_type,omitempty"`
+	URL            string `json:"url,omitempty"`
+}
+
+// Types of status pages.
+const (
+	StatusPageTypePublic  = "public"
+	StatusPageTypePrivate = "private"
+)
+
+// ListStatusPagesResponse represents a list response of status pages.
+type ListStatusPagesResponse struct {
+	Total       int           `json:"total,omitempty"`
+	StatusPages []*StatusPage `json:"status_pages,omitempty"`
+	Offset      int           `json:"offset,omitempty"`
+	More        bool          `json:"more,omitempty"`
+	Limit       int           `json:"limit,omitempty"`
+}
+
+// ListStatusPagesOptions represents options when listing status pages.
+type ListStatusPagesOptions struct {
+	Offset         int    `url:"offset,omitempty"`
+	Limit          int    `url:"limit,omitempty"`
+	StatusPageType string `url:"status_page_type,omitempty"`
+}
+
+type listStatusPagesOptionsGen struct {
+	options *ListStatusPagesOptions
+}
+
+func (o *listStatusPagesOptionsGen) currentOffset() int {
+	return o.options.Offset
+}
+
+func (o *listStatusPagesOptionsGen) changeOffset(i int) {
+	o.options.Offset = i
+}
+
+func (o *listStatusPagesOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// List lists existing status pages. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, the entire list of status pages will be returned.
+func (s *StatusPageService) List(o *ListStatusPagesOptions) (*ListStatusPagesResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing status pages. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, the entire list of status pages will be returned.
+func (s *StatusPageService) ListContext(ctx context.Context, o *ListStatusPagesOptions) (*ListStatusPagesResponse, *Response, error) {
+	u := "/status_pages"
+	v := new(ListStatusPagesResponse)
+
+	if o == nil {
+		o = &ListStatusPagesOptions{}
+	}
+
+	if o.Limit != 0 {
+		resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, &v)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return v, resp, nil
+	}
+
+	statusPages := make([]*StatusPage, 0)
+
+	// Create a handler closure capable of parsing data from the status pages endpoint
+	// and appending resultant status pages to the return slice.
+	responseHandler := func(response *Response) (ListResp, *Response, error) {
+		var result ListStatusPagesResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return ListResp{}, response, err
+		}
+
+		statusPages = append(statusPages, result.StatusPages...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return ListResp{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+	err := s.client.newRequestPagedGetQueryDoContext(ctx, u, responseHandler, &listStatusPagesOptionsGen{
+		options: o,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.StatusPages = statusPages
+
+	return v, nil, nil
+}
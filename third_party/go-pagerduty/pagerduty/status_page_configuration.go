@@ -0,0 +1,284 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// StatusPageServiceObj represents a service shown on a status page.
+type StatusPageServiceObj struct {
+	ID              string               `json:"id,omitempty"`
+	Type            string               `json:"type,omitempty"`
+	Self            string               `json:"self,omitempty"`
+	Name            string               `json:"name,omitempty"`
+	StatusPage      *StatusPageReference `json:"status_page,omitempty"`
+	BusinessService *ServiceObj          `json:"business_service,omitempty"`
+}
+
+// ListStatusPageServicesResponse represents a list response of the services of a status page.
+type ListStatusPageServicesResponse struct {
+	Total    int                     `json:"total,omitempty"`
+	Services []*StatusPageServiceObj `json:"services,omitempty"`
+	Offset   int                     `json:"offset,omitempty"`
+	More     bool                    `json:"more,omitempty"`
+	Limit    int                     `json:"limit,omitempty"`
+}
+
+// ListServices lists the services of a status page.
+func (s *StatusPageService) ListServices(statusPageID string) (*ListStatusPageServicesResponse, *Response, error) {
+	return s.ListServicesContext(context.Background(), statusPageID)
+}
+
+// ListServicesContext lists the services of a status page.
+func (s *StatusPageService) ListServicesContext(ctx context.Context, statusPageID string) (*ListStatusPageServicesResponse, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/services", statusPageID)
+	v := new(ListStatusPageServicesResponse)
+
+	services := make([]*StatusPageServiceObj, 0)
+
+	// Create a handler closure capable of parsing data from the services endpoint
+	// and appending resultant services to the return slice.
+	responseHandler := func(response *Response) (ListResp, *Response, error) {
+		var result ListStatusPageServicesResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return ListResp{}, response, err
+		}
+
+		services = append(services, result.Services...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return ListResp{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+	err := s.client.newRequestPagedGetQueryDoContext(ctx, u, responseHandler, &simpleOffsetQueryOptionsGen{})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.Services = services
+
+	return v, nil, nil
+}
+
+// ListStatusPagePostTypeOptions represents options when listing the impacts,
+// severities or statuses of a status page.
+type ListStatusPagePostTypeOptions struct {
+	Offset   int    `url:"offset,omitempty"`
+	Limit    int    `url:"limit,omitempty"`
+	PostType string `url:"post_type,omitempty"`
+}
+
+type listStatusPagePostTypeOptionsGen struct {
+	options *ListStatusPagePostTypeOptions
+}
+
+func (o *listStatusPagePostTypeOptionsGen) currentOffset() int {
+	return o.options.Offset
+}
+
+func (o *listStatusPagePostTypeOptionsGen) changeOffset(i int) {
+	o.options.Offset = i
+}
+
+func (o *listStatusPagePostTypeOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// StatusPageImpact represents an impact of the posts of a status page.
+type StatusPageImpact struct {
+	ID          string               `json:"id,omitempty"`
+	Type        string               `json:"type,omitempty"`
+	Self        string               `json:"self,omitempty"`
+	Description string               `json:"description,omitempty"`
+	PostType    string               `json:"post_type,omitempty"`
+	StatusPage  *StatusPageReference `json:"status_page,omitempty"`
+}
+
+// ListStatusPageImpactsResponse represents a list response of the impacts of a status page.
+type ListStatusPageImpactsResponse struct {
+	Total   int                 `json:"total,omitempty"`
+	Impacts []*StatusPageImpact `json:"impacts,omitempty"`
+	Offset  int                 `json:"offset,omitempty"`
+	More    bool                `json:"more,omitempty"`
+	Limit   int                 `json:"limit,omitempty"`
+}
+
+// ListImpacts lists the impacts of a status page.
+func (s *StatusPageService) ListImpacts(statusPageID string, o *ListStatusPagePostTypeOptions) (*ListStatusPageImpactsResponse, *Response, error) {
+	return s.ListImpactsContext(context.Background(), statusPageID, o)
+}
+
+// ListImpactsContext lists the impacts of a status page.
+func (s *StatusPageService) ListImpactsContext(ctx context.Context, statusPageID string, o *ListStatusPagePostTypeOptions) (*ListStatusPageImpactsResponse, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/impacts", statusPageID)
+	v := new(ListStatusPageImpactsResponse)
+
+	if o == nil {
+		o = &ListStatusPagePostTypeOptions{}
+	}
+
+	impacts := make([]*StatusPageImpact, 0)
+
+	// Create a handler closure capable of parsing data from the impacts endpoint
+	// and appending resultant impacts to the return slice.
+	responseHandler := func(response *Response) (ListResp, *Response, error) {
+		var result ListStatusPageImpactsResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return ListResp{}, response, err
+		}
+
+		impacts = append(impacts, result.Impacts...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return ListResp{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+	err := s.client.newRequestPagedGetQueryDoContext(ctx, u, responseHandler, &listStatusPagePostTypeOptionsGen{
+		options: o,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.Impacts = impacts
+
+	return v, nil, nil
+}
+
+// StatusPageSeverity represents a severity of the posts of a status page.
+type StatusPageSeverity struct {
+	ID          string               `json:"id,omitempty"`
+	Type        string               `json:"type,omitempty"`
+	Self        string               `json:"self,omitempty"`
+	Description string               `json:"description,omitempty"`
+	PostType    string               `json:"post_type,omitempty"`
+	StatusPage  *StatusPageReference `json:"status_page,omitempty"`
+}
+
+// ListStatusPageSeveritiesResponse represents a list response of the severities of a status page.
+type ListStatusPageSeveritiesResponse struct {
+	Total      int                   `json:"total,omitempty"`
+	Severities []*StatusPageSeverity `json:"severities,omitempty"`
+	Offset     int                   `json:"offset,omitempty"`
+	More       bool                  `json:"more,omitempty"`
+	Limit      int                   `json:"limit,omitempty"`
+}
+
+// ListSeverities lists the severities of a status page.
+func (s *StatusPageService) ListSeverities(statusPageID string, o *ListStatusPagePostTypeOptions) (*ListStatusPageSeveritiesResponse, *Response, error) {
+	return s.ListSeveritiesContext(context.Background(), statusPageID, o)
+}
+
+// ListSeveritiesContext lists the severities of a status page.
+func (s *StatusPageService) ListSeveritiesContext(ctx context.Context, statusPageID string, o *ListStatusPagePostTypeOptions) (*ListStatusPageSeveritiesResponse, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/severities", statusPageID)
+	v := new(ListStatusPageSeveritiesResponse)
+
+	if o == nil {
+		o = &ListStatusPagePostTypeOptions{}
+	}
+
+	severities := make([]*StatusPageSeverity, 0)
+
+	// Create a handler closure capable of parsing data from the severities endpoint
+	// and appending resultant severities to the return slice.
+	responseHandler := func(response *Response) (ListResp, *Response, error) {
+		var result ListStatusPageSeveritiesResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return ListResp{}, response, err
+		}
+
+		severities = append(severities, result.Severities...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return ListResp{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+	err := s.client.newRequestPagedGetQueryDoContext(ctx, u, responseHandler, &listStatusPagePostTypeOptionsGen{
+		options: o,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.Severities = severities
+
+	return v, nil, nil
+}
+
+// StatusPageStatus represents a status of the posts of a status page.
+type StatusPageStatus struct {
+	ID          string               `json:"id,omitempty"`
+	Type        string               `json:"type,omitempty"`
+	Self        string               `json:"self,omitempty"`
+	Description string               `json:"description,omitempty"`
+	PostType    string               `json:"post_type,omitempty"`
+	StatusPage  *StatusPageReference `json:"status_page,omitempty"`
+}
+
+// ListStatusPageStatusesResponse represents a list response of the statuses of a status page.
+type ListStatusPageStatusesResponse struct {
+	Total    int                 `json:"total,omitempty"`
+	Statuses []*StatusPageStatus `json:"statuses,omitempty"`
+	Offset   int                 `json:"offset,omitempty"`
+	More     bool                `json:"more,omitempty"`
+	Limit    int                 `json:"limit,omitempty"`
+}
+
+// ListStatuses lists the statuses of a status page.
+func (s *StatusPageService) ListStatuses(statusPageID string, o *ListStatusPagePostTypeOptions) (*ListStatusPageStatusesResponse, *Response, error) {
+	return s.ListStatusesContext(context.Background(), statusPageID, o)
+}
+
+// ListStatusesContext lists the statuses of a status page.
+func (s *StatusPageService) ListStatusesContext(ctx context.Context, statusPageID string, o *ListStatusPagePostTypeOptions) (*ListStatusPageStatusesResponse, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/statuses", statusPageID)
+	v := new(ListStatusPageStatusesResponse)
+
+	if o == nil {
+		o = &ListStatusPagePostTypeOptions{}
+	}
+
+	statuses := make([]*StatusPageStatus, 0)
+
+	// Create a handler closure capable of parsing data from the statuses endpoint
+	// and appending resultant statuses to the return slice.
+	responseHandler := func(response *Response) (ListResp, *Response, error) {
+		var result ListStatusPageStatusesResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return ListResp{}, response, err
+		}
+
+		statuses = append(statuses, result.Statuses...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return ListResp{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+	err := s.client.newRequestPagedGetQueryDoContext(ctx, u, responseHandler, &listStatusPagePostTypeOptionsGen{
+		options: o,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.Statuses = statuses
+
+	return v, nil, nil
+}
@@ -0,0 +1,315 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// StatusPagePost represents a post of a status page.
+type StatusPagePost struct {
+	ID         string                  `json:"id,omitempty"`
+	Type       string                  `json:"type,omitempty"`
+	Self       string                  `json:"self,omitempty"`
+	PostType   string                  `json:"post_type,omitempty"`
+	StatusPage *StatusPageReference    `json:"status_page,omitempty"`
+	Title      string                  `json:"title,omitempty"`
+	StartsAt   string                  `json:"starts_at,omitempty"`
+	EndsAt     string                  `json:"ends_at,omitempty"`
+	Updates    []*StatusPagePostUpdate `json:"updates,omitempty"`
+}
+
+// StatusPagePostUpdate represents an update of a status page post.
+type StatusPagePostUpdate struct {
+	ID                string                                 `json:"id,omitempty"`
+	Type              string                                 `json:"type,omitempty"`
+	Self              string                                 `json:"self,omitempty"`
+	Post              *StatusPagePostReference               `json:"post,omitempty"`
+	Message           string                                 `json:"message,omitempty"`
+	ReviewedStatus    string                                 `json:"reviewed_status,omitempty"`
+	Status            *StatusPageStatusReference             `json:"status,omitempty"`
+	Severity          *StatusPageSeverityReference           `json:"severity,omitempty"`
+	ImpactedServices  []*StatusPagePostUpdateImpactedService `json:"impacted_services"`
+	UpdateFrequencyMS *int                                   `json:"update_frequency_ms,omitempty"`
+	NotifySubscribers bool                                   `json:"notify_subscribers"`
+	ReportedAt        string                                 `json:"reported_at,omitempty"`
+}
+
+// StatusPagePostUpdateImpactedService represents the severity of the impact of a post update on a service.
+type StatusPagePostUpdateImpactedService struct {
+	Service  *StatusPageServiceReference  `json:"service,omitempty"`
+	Severity *StatusPageSeverityReference `json:"severity,omitempty"`
+}
+
+// StatusPageReference represents a reference to a status page.
+type StatusPageReference struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// StatusPagePostReference represents a reference to a status page post.
+type StatusPagePostReference struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// StatusPageStatusReference represents a reference to a status of a status page.
+type StatusPageStatusReference struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// StatusPageSeverityReference represents a reference to a severity of a status page.
+type StatusPageSeverityReference struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// StatusPageServiceReference represents a reference to a service of a status page.
+type StatusPageServiceReference struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// Types of status page posts.
+const (
+	StatusPagePostTypeIncident    = "incident"
+	StatusPagePostTypeMaintenance = "maintenance"
+)
+
+// StatusPagePostPayload represents payload with a status page post object.
+type StatusPagePostPayload struct {
+	Post *StatusPagePost `json:"post,omitempty"`
+}
+
+// StatusPagePostUpdatePayload represents payload with a status page post update object.
+type StatusPagePostUpdatePayload struct {
+	PostUpdate *StatusPagePostUpdate `json:"post_update,omitempty"`
+}
+
+// ListStatusPagePostsResponse represents a list response of status page posts.
+type ListStatusPagePostsResponse struct {
+	Total  int               `json:"total,omitempty"`
+	Posts  []*StatusPagePost `json:"posts,omitempty"`
+	Offset int               `json:"offset,omitempty"`
+	More   bool              `json:"more,omitempty"`
+	Limit  int               `json:"limit,omitempty"`
+}
+
+// ListStatusPagePostUpdatesResponse represents a list response of status page post updates.
+type ListStatusPagePostUpdatesResponse struct {
+	Total       int                     `json:"total,omitempty"`
+	PostUpdates []*StatusPagePostUpdate `json:"post_updates,omitempty"`
+	Offset      int                     `json:"offset,omitempty"`
+	More        bool                    `json:"more,omitempty"`
+	Limit       int                     `json:"limit,omitempty"`
+}
+
+// ListPosts lists the posts of a status page.
+func (s *StatusPageService) ListPosts(statusPageID string) (*ListStatusPagePostsResponse, *Response, error) {
+	return s.ListPostsContext(context.Background(), statusPageID)
+}
+
+// ListPostsContext lists the posts of a status page.
+func (s *StatusPageService) ListPostsContext(ctx context.Context, statusPageID string) (*ListStatusPagePostsResponse, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts", statusPageID)
+	v := new(ListStatusPagePostsResponse)
+
+	posts := make([]*StatusPagePost, 0)
+
+	// Create a handler closure capable of parsing data from the posts endpoint
+	// and appending resultant posts to the return slice.
+	responseHandler := func(response *Response) (ListResp, *Response, error) {
+		var result ListStatusPagePostsResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return ListResp{}, response, err
+		}
+
+		posts = append(posts, result.Posts...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return ListResp{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+	err := s.client.newRequestPagedGetQueryDoContext(ctx, u, responseHandler, &simpleOffsetQueryOptionsGen{})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.Posts = posts
+
+	return v, nil, nil
+}
+
+// GetPost gets a post of a status page.
+func (s *StatusPageService) GetPost(statusPageID, id string) (*StatusPagePost, *Response, error) {
+	return s.GetPostContext(context.Background(), statusPageID, id)
+}
+
+// GetPostContext gets a post of a status page.
+func (s *StatusPageService) GetPostContext(ctx context.Context, statusPageID, id string) (*StatusPagePost, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts/%s", statusPageID, id)
+	v := new(StatusPagePostPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Post, resp, nil
+}
+
+// CreatePost creates a post on a status page.
+func (s *StatusPageService) CreatePost(statusPageID string, p *StatusPagePost) (*StatusPagePost, *Response, error) {
+	return s.CreatePostContext(context.Background(), statusPageID, p)
+}
+
+// CreatePostContext creates a post on a status page.
+func (s *StatusPageService) CreatePostContext(ctx context.Context, statusPageID string, p *StatusPagePost) (*StatusPagePost, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts", statusPageID)
+	v := new(StatusPagePostPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &StatusPagePostPayload{Post: p}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Post, resp, nil
+}
+
+// UpdatePost updates a post of a status page.
+func (s *StatusPageService) UpdatePost(statusPageID, id string, p *StatusPagePost) (*StatusPagePost, *Response, error) {
+	return s.UpdatePostContext(context.Background(), statusPageID, id, p)
+}
+
+// UpdatePostContext updates a post of a status page.
+func (s *StatusPageService) UpdatePostContext(ctx context.Context, statusPageID, id string, p *StatusPagePost) (*StatusPagePost, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts/%s", statusPageID, id)
+	v := new(StatusPagePostPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &StatusPagePostPayload{Post: p}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Post, resp, nil
+}
+
+// DeletePost removes a post of a status page.
+func (s *StatusPageService) DeletePost(statusPageID, id string) (*Response, error) {
+	return s.DeletePostContext(context.Background(), statusPageID, id)
+}
+
+// DeletePostContext removes a post of a status page.
+func (s *StatusPageService) DeletePostContext(ctx context.Context, statusPageID, id string) (*Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts/%s", statusPageID, id)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil)
+}
+
+// ListPostUpdates lists the updates of a status page post.
+func (s *StatusPageService) ListPostUpdates(statusPageID, postID string) (*ListStatusPagePostUpdatesResponse, *Response, error) {
+	return s.ListPostUpdatesContext(context.Background(), statusPageID, postID)
+}
+
+// ListPostUpdatesContext lists the updates of a status page post.
+func (s *StatusPageService) ListPostUpdatesContext(ctx context.Context, statusPageID, postID string) (*ListStatusPagePostUpdatesResponse, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts/%s/post_updates", statusPageID, postID)
+	v := new(ListStatusPagePostUpdatesResponse)
+
+	updates := make([]*StatusPagePostUpdate, 0)
+
+	// Create a handler closure capable of parsing data from the post updates endpoint
+	// and appending resultant post updates to the return slice.
+	responseHandler := func(response *Response) (ListResp, *Response, error) {
+		var result ListStatusPagePostUpdatesResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return ListResp{}, response, err
+		}
+
+		updates = append(updates, result.PostUpdates...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return ListResp{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+	err := s.client.newRequestPagedGetQueryDoContext(ctx, u, responseHandler, &simpleOffsetQueryOptionsGen{})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.PostUpdates = updates
+
+	return v, nil, nil
+}
+
+// GetPostUpdate gets an update of a status page post.
+func (s *StatusPageService) GetPostUpdate(statusPageID, postID, id string) (*StatusPagePostUpdate, *Response, error) {
+	return s.GetPostUpdateContext(context.Background(), statusPageID, postID, id)
+}
+
+// GetPostUpdateContext gets an update of a status page post.
+func (s *StatusPageService) GetPostUpdateContext(ctx context.Context, statusPageID, postID, id string) (*StatusPagePostUpdate, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts/%s/post_updates/%s", statusPageID, postID, id)
+	v := new(StatusPagePostUpdatePayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.PostUpdate, resp, nil
+}
+
+// CreatePostUpdate adds an update to a status page post.
+func (s *StatusPageService) CreatePostUpdate(statusPageID, postID string, p *StatusPagePostUpdate) (*StatusPagePostUpdate, *Response, error) {
+	return s.CreatePostUpdateContext(context.Background(), statusPageID, postID, p)
+}
+
+// CreatePostUpdateContext adds an update to a status page post.
+func (s *StatusPageService) CreatePostUpdateContext(ctx context.Context, statusPageID, postID string, p *StatusPagePostUpdate) (*StatusPagePostUpdate, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts/%s/post_updates", statusPageID, postID)
+	v := new(StatusPagePostUpdatePayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &StatusPagePostUpdatePayload{PostUpdate: p}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.PostUpdate, resp, nil
+}
+
+// UpdatePostUpdate updates an update of a status page post.
+func (s *StatusPageService) UpdatePostUpdate(statusPageID, postID, id string, p *StatusPagePostUpdate) (*StatusPagePostUpdate, *Response, error) {
+	return s.UpdatePostUpdateContext(context.Background(), statusPageID, postID, id, p)
+}
+
+// UpdatePostUpdateContext updates an update of a status page post.
+func (s *StatusPageService) UpdatePostUpdateContext(ctx context.Context, statusPageID, postID, id string, p *StatusPagePostUpdate) (*StatusPagePostUpdate, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts/%s/post_updates/%s", statusPageID, postID, id)
+	v := new(StatusPagePostUpdatePayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &StatusPagePostUpdatePayload{PostUpdate: p}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.PostUpdate, resp, nil
+}
+
+// DeletePostUpdate removes an update of a status page post.
+func (s *StatusPageService) DeletePostUpdate(statusPageID, postID, id string) (*Response, error) {
+	return s.DeletePostUpdateContext(context.Background(), statusPageID, postID, id)
+}
+
+// DeletePostUpdateContext removes an update of a status page post.
+func (s *StatusPageService) DeletePostUpdateContext(ctx context.Context, statusPageID, postID, id string) (*Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts/%s/post_updates/%s", statusPageID, postID, id)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil)
+}
@@ -0,0 +1,132 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// StatusPageSubscription represents a subscription to a status page.
+type StatusPageSubscription struct {
+	ID                 string                        `json:"id,omitempty"`
+	Type               string                        `json:"type,omitempty"`
+	Self               string                        `json:"self,omitempty"`
+	Channel            string                        `json:"channel,omitempty"`
+	Contact            string                        `json:"contact,omitempty"`
+	Status             string                        `json:"status,omitempty"`
+	StatusPage         *StatusPageReference          `json:"status_page,omitempty"`
+	SubscribableObject *StatusPageSubscribableObject `json:"subscribable_object,omitempty"`
+}
+
+// StatusPageSubscribableObject represents the status page, or the service of
+// a status page, a subscription is for.
+type StatusPageSubscribableObject struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// Channels of status page subscriptions.
+const (
+	StatusPageSubscriptionChannelEmail   = "email"
+	StatusPageSubscriptionChannelWebhook = "webhook"
+)
+
+// StatusPageSubscriptionPayload represents payload with a status page subscription object.
+type StatusPageSubscriptionPayload struct {
+	Subscription *StatusPageSubscription `json:"subscription,omitempty"`
+}
+
+// ListStatusPageSubscriptionsResponse represents a list response of status page subscriptions.
+type ListStatusPageSubscriptionsResponse struct {
+	Total         int                       `json:"total,omitempty"`
+	Subscriptions []*StatusPageSubscription `json:"subscriptions,omitempty"`
+	Offset        int                       `json:"offset,omitempty"`
+	More          bool                      `json:"more,omitempty"`
+	Limit         int                       `json:"limit,omitempty"`
+}
+
+// ListSubscriptions lists the subscriptions to a status page.
+func (s *StatusPageService) ListSubscriptions(statusPageID string) (*ListStatusPageSubscriptionsResponse, *Response, error) {
+	return s.ListSubscriptionsContext(context.Background(), statusPageID)
+}
+
+// ListSubscriptionsContext lists the subscriptions to a status page.
+func (s *StatusPageService) ListSubscriptionsContext(ctx context.Context, statusPageID string) (*ListStatusPageSubscriptionsResponse, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/subscriptions", statusPageID)
+	v := new(ListStatusPageSubscriptionsResponse)
+
+	subscriptions := make([]*StatusPageSubscription, 0)
+
+	// Create a handler closure capable of parsing data from the subscriptions endpoint
+	// and appending resultant subscriptions to the return slice.
+	responseHandler := func(response *Response) (ListResp, *Response, error) {
+		var result ListStatusPageSubscriptionsResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return ListResp{}, response, err
+		}
+
+		subscriptions = append(subscriptions, result.Subscriptions...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return ListResp{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+	err := s.client.newRequestPagedGetQueryDoContext(ctx, u, responseHandler, &simpleOffsetQueryOptionsGen{})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.Subscriptions = subscriptions
+
+	return v, nil, nil
+}
+
+// GetSubscription gets a subscription to a status page.
+func (s *StatusPageService) GetSubscription(statusPageID, id string) (*StatusPageSubscription, *Response, error) {
+	return s.GetSubscriptionContext(context.Background(), statusPageID, id)
+}
+
+// GetSubscriptionContext gets a subscription to a status page.
+func (s *StatusPageService) GetSubscriptionContext(ctx context.Context, statusPageID, id string) (*StatusPageSubscription, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/subscriptions/%s", statusPageID, id)
+	v := new(StatusPageSubscriptionPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Subscription, resp, nil
+}
+
+// CreateSubscription subscribes to a status page.
+func (s *StatusPageService) CreateSubscription(statusPageID string, sub *StatusPageSubscription) (*StatusPageSubscription, *Response, error) {
+	return s.CreateSubscriptionContext(context.Background(), statusPageID, sub)
+}
+
+// CreateSubscriptionContext subscribes to a status page.
+func (s *StatusPageService) CreateSubscriptionContext(ctx context.Context, statusPageID string, sub *StatusPageSubscription) (*StatusPageSubscription, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/subscriptions", statusPageID)
+	v := new(StatusPageSubscriptionPayload)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &StatusPageSubscriptionPayload{Subscription: sub}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Subscription, resp, nil
+}
+
+// DeleteSubscription removes a subscription to a status page.
+func (s *StatusPageService) DeleteSubscription(statusPageID, id string) (*Response, error) {
+	return s.DeleteSubscriptionContext(context.Background(), statusPageID, id)
+}
+
+// DeleteSubscriptionContext removes a subscription to a status page.
+func (s *StatusPageService) DeleteSubscriptionContext(ctx context.Context, statusPageID, id string) (*Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/subscriptions/%s", statusPageID, id)
+	return s.client.newRequestDoOptionsContext(ctx, "DELETE", u, nil, nil, nil)
+}